@@ -7,8 +7,11 @@ require (
 	github.com/hashicorp/hc-install v0.6.3
 	github.com/hashicorp/hcl2 v0.0.0-20190618163856-0b64543c968c
 	github.com/hashicorp/terraform-exec v0.20.0
+	github.com/hashicorp/terraform-plugin-go v0.22.0
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.33.0
+	github.com/sony/gobreaker v0.5.0
 	github.com/stretchr/testify v1.8.4
+	github.com/zclconf/go-cty v1.14.2
 	golang.org/x/mod v0.15.0
 	gopkg.in/yaml.v2 v2.3.0
 )
@@ -42,7 +45,6 @@ require (
 	github.com/hashicorp/hcl/v2 v2.19.1 // indirect
 	github.com/hashicorp/logutils v1.0.0 // indirect
 	github.com/hashicorp/terraform-json v0.21.0 // indirect
-	github.com/hashicorp/terraform-plugin-go v0.22.0 // indirect
 	github.com/hashicorp/yamux v0.1.1 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
@@ -54,9 +56,7 @@ require (
 	github.com/oklog/run v1.0.0 // indirect
 	github.com/oracle/oci-go-sdk/v65 v65.73.0
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/sony/gobreaker v0.5.0 // indirect
 	github.com/vmihailenco/msgpack v4.0.4+incompatible // indirect
-	github.com/zclconf/go-cty v1.14.2 // indirect
 	golang.org/x/crypto v0.21.0 // indirect
 	golang.org/x/net v0.23.0 // indirect
 	golang.org/x/sys v0.18.0 // indirect
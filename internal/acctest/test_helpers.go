@@ -675,9 +675,9 @@ const (
 // Provider is the adapter for terraform, that gives access to all the resources
 func ProviderTestCopy(configfn schema.ConfigureFunc) *schema.Provider {
 	result := &schema.Provider{
-		DataSourcesMap: tf_provider.DataSourcesMap(),
+		DataSourcesMap: tf_resource.WithOperationContexts(tf_provider.DataSourcesMap()),
 		Schema:         tf_provider.SchemaMap(),
-		ResourcesMap:   tf_provider.ResourcesMap(),
+		ResourcesMap:   tf_resource.WithOperationContexts(tf_provider.ResourcesMap()),
 		ConfigureFunc:  configfn,
 	}
 
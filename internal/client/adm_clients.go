@@ -30,5 +30,5 @@ func initAdmApplicationDependencyManagementClient(configProvider oci_common.Conf
 }
 
 func (m *OracleClients) ApplicationDependencyManagementClient() *oci_adm.ApplicationDependencyManagementClient {
-	return m.GetClient("oci_adm.ApplicationDependencyManagementClient").(*oci_adm.ApplicationDependencyManagementClient)
+	return m.getClientOrFailing("oci_adm.ApplicationDependencyManagementClient", (*oci_adm.ApplicationDependencyManagementClient)(nil)).(*oci_adm.ApplicationDependencyManagementClient)
 }
@@ -30,5 +30,5 @@ func initAianomalydetectionAnomalyDetectionClient(configProvider oci_common.Conf
 }
 
 func (m *OracleClients) AnomalyDetectionClient() *oci_ai_anomaly_detection.AnomalyDetectionClient {
-	return m.GetClient("oci_ai_anomaly_detection.AnomalyDetectionClient").(*oci_ai_anomaly_detection.AnomalyDetectionClient)
+	return m.getClientOrFailing("oci_ai_anomaly_detection.AnomalyDetectionClient", (*oci_ai_anomaly_detection.AnomalyDetectionClient)(nil)).(*oci_ai_anomaly_detection.AnomalyDetectionClient)
 }
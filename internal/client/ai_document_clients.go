@@ -30,5 +30,5 @@ func initAidocumentAiServiceDocumentClient(configProvider oci_common.Configurati
 }
 
 func (m *OracleClients) AiServiceDocumentClient() *oci_ai_document.AIServiceDocumentClient {
-	return m.GetClient("oci_ai_document.AiServiceDocumentClient").(*oci_ai_document.AIServiceDocumentClient)
+	return m.getClientOrFailing("oci_ai_document.AiServiceDocumentClient", (*oci_ai_document.AIServiceDocumentClient)(nil)).(*oci_ai_document.AIServiceDocumentClient)
 }
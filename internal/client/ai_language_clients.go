@@ -30,5 +30,5 @@ func initAilanguageAiServiceLanguageClient(configProvider oci_common.Configurati
 }
 
 func (m *OracleClients) AiServiceLanguageClient() *oci_ai_language.AIServiceLanguageClient {
-	return m.GetClient("oci_ai_language.AiServiceLanguageClient").(*oci_ai_language.AIServiceLanguageClient)
+	return m.getClientOrFailing("oci_ai_language.AiServiceLanguageClient", (*oci_ai_language.AIServiceLanguageClient)(nil)).(*oci_ai_language.AIServiceLanguageClient)
 }
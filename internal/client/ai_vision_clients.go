@@ -30,5 +30,5 @@ func initAivisionAiServiceVisionClient(configProvider oci_common.ConfigurationPr
 }
 
 func (m *OracleClients) AiServiceVisionClient() *oci_ai_vision.AIServiceVisionClient {
-	return m.GetClient("oci_ai_vision.AiServiceVisionClient").(*oci_ai_vision.AIServiceVisionClient)
+	return m.getClientOrFailing("oci_ai_vision.AiServiceVisionClient", (*oci_ai_vision.AIServiceVisionClient)(nil)).(*oci_ai_vision.AIServiceVisionClient)
 }
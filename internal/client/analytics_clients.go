@@ -30,5 +30,5 @@ func initAnalyticsAnalyticsClient(configProvider oci_common.ConfigurationProvide
 }
 
 func (m *OracleClients) AnalyticsClient() *oci_analytics.AnalyticsClient {
-	return m.GetClient("oci_analytics.AnalyticsClient").(*oci_analytics.AnalyticsClient)
+	return m.getClientOrFailing("oci_analytics.AnalyticsClient", (*oci_analytics.AnalyticsClient)(nil)).(*oci_analytics.AnalyticsClient)
 }
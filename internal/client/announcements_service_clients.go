@@ -31,7 +31,7 @@ func initAnnouncementsserviceAnnouncementSubscriptionClient(configProvider oci_c
 }
 
 func (m *OracleClients) AnnouncementSubscriptionClient() *oci_announcements_service.AnnouncementSubscriptionClient {
-	return m.GetClient("oci_announcements_service.AnnouncementSubscriptionClient").(*oci_announcements_service.AnnouncementSubscriptionClient)
+	return m.getClientOrFailing("oci_announcements_service.AnnouncementSubscriptionClient", (*oci_announcements_service.AnnouncementSubscriptionClient)(nil)).(*oci_announcements_service.AnnouncementSubscriptionClient)
 }
 
 func initAnnouncementsserviceServiceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initAnnouncementsserviceServiceClient(configProvider oci_common.Configurati
 }
 
 func (m *OracleClients) ServiceClient() *oci_announcements_service.ServiceClient {
-	return m.GetClient("oci_announcements_service.ServiceClient").(*oci_announcements_service.ServiceClient)
+	return m.getClientOrFailing("oci_announcements_service.ServiceClient", (*oci_announcements_service.ServiceClient)(nil)).(*oci_announcements_service.ServiceClient)
 }
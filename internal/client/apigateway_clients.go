@@ -35,7 +35,7 @@ func initApigatewayApiGatewayClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) ApiGatewayClient() *oci_apigateway.ApiGatewayClient {
-	return m.GetClient("oci_apigateway.ApiGatewayClient").(*oci_apigateway.ApiGatewayClient)
+	return m.getClientOrFailing("oci_apigateway.ApiGatewayClient", (*oci_apigateway.ApiGatewayClient)(nil)).(*oci_apigateway.ApiGatewayClient)
 }
 
 func initApigatewayWorkRequestsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -55,7 +55,7 @@ func initApigatewayWorkRequestsClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) ApigatewayWorkRequestsClient() *oci_apigateway.WorkRequestsClient {
-	return m.GetClient("oci_apigateway.WorkRequestsClient").(*oci_apigateway.WorkRequestsClient)
+	return m.getClientOrFailing("oci_apigateway.WorkRequestsClient", (*oci_apigateway.WorkRequestsClient)(nil)).(*oci_apigateway.WorkRequestsClient)
 }
 
 func initApigatewayDeploymentClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -75,7 +75,7 @@ func initApigatewayDeploymentClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) DeploymentClient() *oci_apigateway.DeploymentClient {
-	return m.GetClient("oci_apigateway.DeploymentClient").(*oci_apigateway.DeploymentClient)
+	return m.getClientOrFailing("oci_apigateway.DeploymentClient", (*oci_apigateway.DeploymentClient)(nil)).(*oci_apigateway.DeploymentClient)
 }
 
 func initApigatewayGatewayClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -95,7 +95,7 @@ func initApigatewayGatewayClient(configProvider oci_common.ConfigurationProvider
 }
 
 func (m *OracleClients) GatewayClient() *oci_apigateway.GatewayClient {
-	return m.GetClient("oci_apigateway.GatewayClient").(*oci_apigateway.GatewayClient)
+	return m.getClientOrFailing("oci_apigateway.GatewayClient", (*oci_apigateway.GatewayClient)(nil)).(*oci_apigateway.GatewayClient)
 }
 
 func initApigatewaySubscribersClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -115,7 +115,7 @@ func initApigatewaySubscribersClient(configProvider oci_common.ConfigurationProv
 }
 
 func (m *OracleClients) SubscribersClient() *oci_apigateway.SubscribersClient {
-	return m.GetClient("oci_apigateway.SubscribersClient").(*oci_apigateway.SubscribersClient)
+	return m.getClientOrFailing("oci_apigateway.SubscribersClient", (*oci_apigateway.SubscribersClient)(nil)).(*oci_apigateway.SubscribersClient)
 }
 
 func initApigatewayUsagePlansClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -135,5 +135,5 @@ func initApigatewayUsagePlansClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) UsagePlansClient() *oci_apigateway.UsagePlansClient {
-	return m.GetClient("oci_apigateway.UsagePlansClient").(*oci_apigateway.UsagePlansClient)
+	return m.getClientOrFailing("oci_apigateway.UsagePlansClient", (*oci_apigateway.UsagePlansClient)(nil)).(*oci_apigateway.UsagePlansClient)
 }
@@ -30,5 +30,5 @@ func initApmcontrolplaneApmDomainClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) ApmDomainClient() *oci_apm.ApmDomainClient {
-	return m.GetClient("oci_apm.ApmDomainClient").(*oci_apm.ApmDomainClient)
+	return m.getClientOrFailing("oci_apm.ApmDomainClient", (*oci_apm.ApmDomainClient)(nil)).(*oci_apm.ApmDomainClient)
 }
@@ -30,5 +30,5 @@ func initApmconfigConfigClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) ConfigClient() *oci_apm_config.ConfigClient {
-	return m.GetClient("oci_apm_config.ConfigClient").(*oci_apm_config.ConfigClient)
+	return m.getClientOrFailing("oci_apm_config.ConfigClient", (*oci_apm_config.ConfigClient)(nil)).(*oci_apm_config.ConfigClient)
 }
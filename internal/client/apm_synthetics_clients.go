@@ -30,5 +30,5 @@ func initApmsyntheticsApmSyntheticClient(configProvider oci_common.Configuration
 }
 
 func (m *OracleClients) ApmSyntheticClient() *oci_apm_synthetics.ApmSyntheticClient {
-	return m.GetClient("oci_apm_synthetics.ApmSyntheticClient").(*oci_apm_synthetics.ApmSyntheticClient)
+	return m.getClientOrFailing("oci_apm_synthetics.ApmSyntheticClient", (*oci_apm_synthetics.ApmSyntheticClient)(nil)).(*oci_apm_synthetics.ApmSyntheticClient)
 }
@@ -31,7 +31,7 @@ func initApmtracesQueryClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) QueryClient() *oci_apm_traces.QueryClient {
-	return m.GetClient("oci_apm_traces.QueryClient").(*oci_apm_traces.QueryClient)
+	return m.getClientOrFailing("oci_apm_traces.QueryClient", (*oci_apm_traces.QueryClient)(nil)).(*oci_apm_traces.QueryClient)
 }
 
 func initApmtracesTraceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initApmtracesTraceClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) TraceClient() *oci_apm_traces.TraceClient {
-	return m.GetClient("oci_apm_traces.TraceClient").(*oci_apm_traces.TraceClient)
+	return m.getClientOrFailing("oci_apm_traces.TraceClient", (*oci_apm_traces.TraceClient)(nil)).(*oci_apm_traces.TraceClient)
 }
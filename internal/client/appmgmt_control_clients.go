@@ -30,5 +30,5 @@ func initAppmgmtcontrolAppmgmtControlClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) AppmgmtControlClient() *oci_appmgmt_control.AppmgmtControlClient {
-	return m.GetClient("oci_appmgmt_control.AppmgmtControlClient").(*oci_appmgmt_control.AppmgmtControlClient)
+	return m.getClientOrFailing("oci_appmgmt_control.AppmgmtControlClient", (*oci_appmgmt_control.AppmgmtControlClient)(nil)).(*oci_appmgmt_control.AppmgmtControlClient)
 }
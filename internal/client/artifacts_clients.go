@@ -30,5 +30,5 @@ func initArtifactsArtifactsClient(configProvider oci_common.ConfigurationProvide
 }
 
 func (m *OracleClients) ArtifactsClient() *oci_artifacts.ArtifactsClient {
-	return m.GetClient("oci_artifacts.ArtifactsClient").(*oci_artifacts.ArtifactsClient)
+	return m.getClientOrFailing("oci_artifacts.ArtifactsClient", (*oci_artifacts.ArtifactsClient)(nil)).(*oci_artifacts.ArtifactsClient)
 }
@@ -30,5 +30,5 @@ func initAuditAuditClient(configProvider oci_common.ConfigurationProvider, confi
 }
 
 func (m *OracleClients) AuditClient() *oci_audit.AuditClient {
-	return m.GetClient("oci_audit.AuditClient").(*oci_audit.AuditClient)
+	return m.getClientOrFailing("oci_audit.AuditClient", (*oci_audit.AuditClient)(nil)).(*oci_audit.AuditClient)
 }
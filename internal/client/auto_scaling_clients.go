@@ -30,5 +30,5 @@ func initAutoscalingAutoScalingClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) AutoScalingClient() *oci_auto_scaling.AutoScalingClient {
-	return m.GetClient("oci_auto_scaling.AutoScalingClient").(*oci_auto_scaling.AutoScalingClient)
+	return m.getClientOrFailing("oci_auto_scaling.AutoScalingClient", (*oci_auto_scaling.AutoScalingClient)(nil)).(*oci_auto_scaling.AutoScalingClient)
 }
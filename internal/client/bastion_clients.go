@@ -30,5 +30,5 @@ func initBastionBastionClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) BastionClient() *oci_bastion.BastionClient {
-	return m.GetClient("oci_bastion.BastionClient").(*oci_bastion.BastionClient)
+	return m.getClientOrFailing("oci_bastion.BastionClient", (*oci_bastion.BastionClient)(nil)).(*oci_bastion.BastionClient)
 }
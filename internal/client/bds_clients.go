@@ -30,5 +30,5 @@ func initBdsBdsClient(configProvider oci_common.ConfigurationProvider, configure
 }
 
 func (m *OracleClients) BdsClient() *oci_bds.BdsClient {
-	return m.GetClient("oci_bds.BdsClient").(*oci_bds.BdsClient)
+	return m.getClientOrFailing("oci_bds.BdsClient", (*oci_bds.BdsClient)(nil)).(*oci_bds.BdsClient)
 }
@@ -30,5 +30,5 @@ func initBlockchainBlockchainPlatformClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) BlockchainPlatformClient() *oci_blockchain.BlockchainPlatformClient {
-	return m.GetClient("oci_blockchain.BlockchainPlatformClient").(*oci_blockchain.BlockchainPlatformClient)
+	return m.getClientOrFailing("oci_blockchain.BlockchainPlatformClient", (*oci_blockchain.BlockchainPlatformClient)(nil)).(*oci_blockchain.BlockchainPlatformClient)
 }
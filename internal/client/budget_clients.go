@@ -30,5 +30,5 @@ func initBudgetBudgetClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) BudgetClient() *oci_budget.BudgetClient {
-	return m.GetClient("oci_budget.BudgetClient").(*oci_budget.BudgetClient)
+	return m.getClientOrFailing("oci_budget.BudgetClient", (*oci_budget.BudgetClient)(nil)).(*oci_budget.BudgetClient)
 }
@@ -30,5 +30,5 @@ func initCapacitymanagementCapacityManagementClient(configProvider oci_common.Co
 }
 
 func (m *OracleClients) CapacityManagementClient() *oci_capacity_management.CapacityManagementClient {
-	return m.GetClient("oci_capacity_management.CapacityManagementClient").(*oci_capacity_management.CapacityManagementClient)
+	return m.getClientOrFailing("oci_capacity_management.CapacityManagementClient", (*oci_capacity_management.CapacityManagementClient)(nil)).(*oci_capacity_management.CapacityManagementClient)
 }
@@ -30,5 +30,5 @@ func initCertificatesmanagementCertificatesManagementClient(configProvider oci_c
 }
 
 func (m *OracleClients) CertificatesManagementClient() *oci_certificates_management.CertificatesManagementClient {
-	return m.GetClient("oci_certificates_management.CertificatesManagementClient").(*oci_certificates_management.CertificatesManagementClient)
+	return m.getClientOrFailing("oci_certificates_management.CertificatesManagementClient", (*oci_certificates_management.CertificatesManagementClient)(nil)).(*oci_certificates_management.CertificatesManagementClient)
 }
@@ -33,7 +33,7 @@ func initCloudbridgeCommonClient(configProvider oci_common.ConfigurationProvider
 }
 
 func (m *OracleClients) CommonClient() *oci_cloud_bridge.CommonClient {
-	return m.GetClient("oci_cloud_bridge.CommonClient").(*oci_cloud_bridge.CommonClient)
+	return m.getClientOrFailing("oci_cloud_bridge.CommonClient", (*oci_cloud_bridge.CommonClient)(nil)).(*oci_cloud_bridge.CommonClient)
 }
 
 func initCloudbridgeDiscoveryClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -53,7 +53,7 @@ func initCloudbridgeDiscoveryClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) DiscoveryClient() *oci_cloud_bridge.DiscoveryClient {
-	return m.GetClient("oci_cloud_bridge.DiscoveryClient").(*oci_cloud_bridge.DiscoveryClient)
+	return m.getClientOrFailing("oci_cloud_bridge.DiscoveryClient", (*oci_cloud_bridge.DiscoveryClient)(nil)).(*oci_cloud_bridge.DiscoveryClient)
 }
 
 func initCloudbridgeInventoryClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -73,7 +73,7 @@ func initCloudbridgeInventoryClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) InventoryClient() *oci_cloud_bridge.InventoryClient {
-	return m.GetClient("oci_cloud_bridge.InventoryClient").(*oci_cloud_bridge.InventoryClient)
+	return m.getClientOrFailing("oci_cloud_bridge.InventoryClient", (*oci_cloud_bridge.InventoryClient)(nil)).(*oci_cloud_bridge.InventoryClient)
 }
 
 func initCloudbridgeOcbAgentSvcClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -93,5 +93,5 @@ func initCloudbridgeOcbAgentSvcClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) OcbAgentSvcClient() *oci_cloud_bridge.OcbAgentSvcClient {
-	return m.GetClient("oci_cloud_bridge.OcbAgentSvcClient").(*oci_cloud_bridge.OcbAgentSvcClient)
+	return m.getClientOrFailing("oci_cloud_bridge.OcbAgentSvcClient", (*oci_cloud_bridge.OcbAgentSvcClient)(nil)).(*oci_cloud_bridge.OcbAgentSvcClient)
 }
@@ -30,5 +30,5 @@ func initCloudguardCloudGuardClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) CloudGuardClient() *oci_cloud_guard.CloudGuardClient {
-	return m.GetClient("oci_cloud_guard.CloudGuardClient").(*oci_cloud_guard.CloudGuardClient)
+	return m.getClientOrFailing("oci_cloud_guard.CloudGuardClient", (*oci_cloud_guard.CloudGuardClient)(nil)).(*oci_cloud_guard.CloudGuardClient)
 }
@@ -30,5 +30,5 @@ func initCloudmigrationsMigrationClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) MigrationClient() *oci_cloud_migrations.MigrationClient {
-	return m.GetClient("oci_cloud_migrations.MigrationClient").(*oci_cloud_migrations.MigrationClient)
+	return m.getClientOrFailing("oci_cloud_migrations.MigrationClient", (*oci_cloud_migrations.MigrationClient)(nil)).(*oci_cloud_migrations.MigrationClient)
 }
@@ -30,5 +30,5 @@ func initClusterplacementgroupsClusterPlacementGroupsCPClient(configProvider oci
 }
 
 func (m *OracleClients) ClusterPlacementGroupsCPClient() *oci_cluster_placement_groups.ClusterPlacementGroupsCPClient {
-	return m.GetClient("oci_cluster_placement_groups.ClusterPlacementGroupsCPClient").(*oci_cluster_placement_groups.ClusterPlacementGroupsCPClient)
+	return m.getClientOrFailing("oci_cluster_placement_groups.ClusterPlacementGroupsCPClient", (*oci_cluster_placement_groups.ClusterPlacementGroupsCPClient)(nil)).(*oci_cluster_placement_groups.ClusterPlacementGroupsCPClient)
 }
@@ -30,5 +30,5 @@ func initComputecloudatcustomerComputeCloudAtCustomerClient(configProvider oci_c
 }
 
 func (m *OracleClients) ComputeCloudAtCustomerClient() *oci_compute_cloud_at_customer.ComputeCloudAtCustomerClient {
-	return m.GetClient("oci_compute_cloud_at_customer.ComputeCloudAtCustomerClient").(*oci_compute_cloud_at_customer.ComputeCloudAtCustomerClient)
+	return m.getClientOrFailing("oci_compute_cloud_at_customer.ComputeCloudAtCustomerClient", (*oci_compute_cloud_at_customer.ComputeCloudAtCustomerClient)(nil)).(*oci_compute_cloud_at_customer.ComputeCloudAtCustomerClient)
 }
@@ -31,7 +31,7 @@ func initComputeinstanceagentPluginClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) PluginClient() *oci_computeinstanceagent.PluginClient {
-	return m.GetClient("oci_computeinstanceagent.PluginClient").(*oci_computeinstanceagent.PluginClient)
+	return m.getClientOrFailing("oci_computeinstanceagent.PluginClient", (*oci_computeinstanceagent.PluginClient)(nil)).(*oci_computeinstanceagent.PluginClient)
 }
 
 func initComputeinstanceagentPluginconfigClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initComputeinstanceagentPluginconfigClient(configProvider oci_common.Config
 }
 
 func (m *OracleClients) PluginconfigClient() *oci_computeinstanceagent.PluginconfigClient {
-	return m.GetClient("oci_computeinstanceagent.PluginconfigClient").(*oci_computeinstanceagent.PluginconfigClient)
+	return m.getClientOrFailing("oci_computeinstanceagent.PluginconfigClient", (*oci_computeinstanceagent.PluginconfigClient)(nil)).(*oci_computeinstanceagent.PluginconfigClient)
 }
@@ -30,5 +30,5 @@ func initContainerinstancesContainerInstanceClient(configProvider oci_common.Con
 }
 
 func (m *OracleClients) ContainerInstanceClient() *oci_container_instances.ContainerInstanceClient {
-	return m.GetClient("oci_container_instances.ContainerInstanceClient").(*oci_container_instances.ContainerInstanceClient)
+	return m.getClientOrFailing("oci_container_instances.ContainerInstanceClient", (*oci_container_instances.ContainerInstanceClient)(nil)).(*oci_container_instances.ContainerInstanceClient)
 }
@@ -30,5 +30,5 @@ func initContainerengineContainerEngineClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) ContainerEngineClient() *oci_containerengine.ContainerEngineClient {
-	return m.GetClient("oci_containerengine.ContainerEngineClient").(*oci_containerengine.ContainerEngineClient)
+	return m.getClientOrFailing("oci_containerengine.ContainerEngineClient", (*oci_containerengine.ContainerEngineClient)(nil)).(*oci_containerengine.ContainerEngineClient)
 }
@@ -33,7 +33,7 @@ func initCoreBlockstorageClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) BlockstorageClient() *oci_core.BlockstorageClient {
-	return m.GetClient("oci_core.BlockstorageClient").(*oci_core.BlockstorageClient)
+	return m.getClientOrFailing("oci_core.BlockstorageClient", (*oci_core.BlockstorageClient)(nil)).(*oci_core.BlockstorageClient)
 }
 
 func initCoreComputeClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -53,7 +53,7 @@ func initCoreComputeClient(configProvider oci_common.ConfigurationProvider, conf
 }
 
 func (m *OracleClients) ComputeClient() *oci_core.ComputeClient {
-	return m.GetClient("oci_core.ComputeClient").(*oci_core.ComputeClient)
+	return m.getClientOrFailing("oci_core.ComputeClient", (*oci_core.ComputeClient)(nil)).(*oci_core.ComputeClient)
 }
 
 func initCoreComputeManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -73,7 +73,7 @@ func initCoreComputeManagementClient(configProvider oci_common.ConfigurationProv
 }
 
 func (m *OracleClients) ComputeManagementClient() *oci_core.ComputeManagementClient {
-	return m.GetClient("oci_core.ComputeManagementClient").(*oci_core.ComputeManagementClient)
+	return m.getClientOrFailing("oci_core.ComputeManagementClient", (*oci_core.ComputeManagementClient)(nil)).(*oci_core.ComputeManagementClient)
 }
 
 func initCoreVirtualNetworkClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -93,5 +93,5 @@ func initCoreVirtualNetworkClient(configProvider oci_common.ConfigurationProvide
 }
 
 func (m *OracleClients) VirtualNetworkClient() *oci_core.VirtualNetworkClient {
-	return m.GetClient("oci_core.VirtualNetworkClient").(*oci_core.VirtualNetworkClient)
+	return m.getClientOrFailing("oci_core.VirtualNetworkClient", (*oci_core.VirtualNetworkClient)(nil)).(*oci_core.VirtualNetworkClient)
 }
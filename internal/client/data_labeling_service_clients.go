@@ -30,5 +30,5 @@ func initDatalabelingserviceDataLabelingManagementClient(configProvider oci_comm
 }
 
 func (m *OracleClients) DataLabelingManagementClient() *oci_data_labeling_service.DataLabelingManagementClient {
-	return m.GetClient("oci_data_labeling_service.DataLabelingManagementClient").(*oci_data_labeling_service.DataLabelingManagementClient)
+	return m.getClientOrFailing("oci_data_labeling_service.DataLabelingManagementClient", (*oci_data_labeling_service.DataLabelingManagementClient)(nil)).(*oci_data_labeling_service.DataLabelingManagementClient)
 }
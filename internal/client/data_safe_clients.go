@@ -30,5 +30,5 @@ func initDatasafeDataSafeClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) DataSafeClient() *oci_data_safe.DataSafeClient {
-	return m.GetClient("oci_data_safe.DataSafeClient").(*oci_data_safe.DataSafeClient)
+	return m.getClientOrFailing("oci_data_safe.DataSafeClient", (*oci_data_safe.DataSafeClient)(nil)).(*oci_data_safe.DataSafeClient)
 }
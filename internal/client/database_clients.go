@@ -30,5 +30,5 @@ func initDatabaseDatabaseClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) DatabaseClient() *oci_database.DatabaseClient {
-	return m.GetClient("oci_database.DatabaseClient").(*oci_database.DatabaseClient)
+	return m.getClientOrFailing("oci_database.DatabaseClient", (*oci_database.DatabaseClient)(nil)).(*oci_database.DatabaseClient)
 }
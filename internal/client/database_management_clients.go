@@ -33,7 +33,7 @@ func initDatabasemanagementDbManagementClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) DbManagementClient() *oci_database_management.DbManagementClient {
-	return m.GetClient("oci_database_management.DbManagementClient").(*oci_database_management.DbManagementClient)
+	return m.getClientOrFailing("oci_database_management.DbManagementClient", (*oci_database_management.DbManagementClient)(nil)).(*oci_database_management.DbManagementClient)
 }
 
 func initDatabasemanagementDiagnosabilityClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -53,7 +53,7 @@ func initDatabasemanagementDiagnosabilityClient(configProvider oci_common.Config
 }
 
 func (m *OracleClients) DiagnosabilityClient() *oci_database_management.DiagnosabilityClient {
-	return m.GetClient("oci_database_management.DiagnosabilityClient").(*oci_database_management.DiagnosabilityClient)
+	return m.getClientOrFailing("oci_database_management.DiagnosabilityClient", (*oci_database_management.DiagnosabilityClient)(nil)).(*oci_database_management.DiagnosabilityClient)
 }
 
 func initDatabasemanagementManagedMySqlDatabasesClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -73,7 +73,7 @@ func initDatabasemanagementManagedMySqlDatabasesClient(configProvider oci_common
 }
 
 func (m *OracleClients) ManagedMySqlDatabasesClient() *oci_database_management.ManagedMySqlDatabasesClient {
-	return m.GetClient("oci_database_management.ManagedMySqlDatabasesClient").(*oci_database_management.ManagedMySqlDatabasesClient)
+	return m.getClientOrFailing("oci_database_management.ManagedMySqlDatabasesClient", (*oci_database_management.ManagedMySqlDatabasesClient)(nil)).(*oci_database_management.ManagedMySqlDatabasesClient)
 }
 
 func initDatabasemanagementSqlTuningClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -93,5 +93,5 @@ func initDatabasemanagementSqlTuningClient(configProvider oci_common.Configurati
 }
 
 func (m *OracleClients) SqlTuningClient() *oci_database_management.SqlTuningClient {
-	return m.GetClient("oci_database_management.SqlTuningClient").(*oci_database_management.SqlTuningClient)
+	return m.getClientOrFailing("oci_database_management.SqlTuningClient", (*oci_database_management.SqlTuningClient)(nil)).(*oci_database_management.SqlTuningClient)
 }
@@ -30,5 +30,5 @@ func initDatabasemigrationDatabaseMigrationClient(configProvider oci_common.Conf
 }
 
 func (m *OracleClients) DatabaseMigrationClient() *oci_database_migration.DatabaseMigrationClient {
-	return m.GetClient("oci_database_migration.DatabaseMigrationClient").(*oci_database_migration.DatabaseMigrationClient)
+	return m.getClientOrFailing("oci_database_migration.DatabaseMigrationClient", (*oci_database_migration.DatabaseMigrationClient)(nil)).(*oci_database_migration.DatabaseMigrationClient)
 }
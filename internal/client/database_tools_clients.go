@@ -30,5 +30,5 @@ func initDatabasetoolsDatabaseToolsClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) DatabaseToolsClient() *oci_database_tools.DatabaseToolsClient {
-	return m.GetClient("oci_database_tools.DatabaseToolsClient").(*oci_database_tools.DatabaseToolsClient)
+	return m.getClientOrFailing("oci_database_tools.DatabaseToolsClient", (*oci_database_tools.DatabaseToolsClient)(nil)).(*oci_database_tools.DatabaseToolsClient)
 }
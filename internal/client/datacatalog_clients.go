@@ -30,5 +30,5 @@ func initDatacatalogDataCatalogClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) DataCatalogClient() *oci_datacatalog.DataCatalogClient {
-	return m.GetClient("oci_datacatalog.DataCatalogClient").(*oci_datacatalog.DataCatalogClient)
+	return m.getClientOrFailing("oci_datacatalog.DataCatalogClient", (*oci_datacatalog.DataCatalogClient)(nil)).(*oci_datacatalog.DataCatalogClient)
 }
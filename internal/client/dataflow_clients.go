@@ -30,5 +30,5 @@ func initDataflowDataFlowClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) DataFlowClient() *oci_dataflow.DataFlowClient {
-	return m.GetClient("oci_dataflow.DataFlowClient").(*oci_dataflow.DataFlowClient)
+	return m.getClientOrFailing("oci_dataflow.DataFlowClient", (*oci_dataflow.DataFlowClient)(nil)).(*oci_dataflow.DataFlowClient)
 }
@@ -30,5 +30,5 @@ func initDataintegrationDataIntegrationClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) DataIntegrationClient() *oci_dataintegration.DataIntegrationClient {
-	return m.GetClient("oci_dataintegration.DataIntegrationClient").(*oci_dataintegration.DataIntegrationClient)
+	return m.getClientOrFailing("oci_dataintegration.DataIntegrationClient", (*oci_dataintegration.DataIntegrationClient)(nil)).(*oci_dataintegration.DataIntegrationClient)
 }
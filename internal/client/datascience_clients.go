@@ -30,5 +30,5 @@ func initDatascienceDataScienceClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) DataScienceClient() *oci_datascience.DataScienceClient {
-	return m.GetClient("oci_datascience.DataScienceClient").(*oci_datascience.DataScienceClient)
+	return m.getClientOrFailing("oci_datascience.DataScienceClient", (*oci_datascience.DataScienceClient)(nil)).(*oci_datascience.DataScienceClient)
 }
@@ -31,7 +31,7 @@ func initDelegateaccesscontrolDelegateAccessControlClient(configProvider oci_com
 }
 
 func (m *OracleClients) DelegateAccessControlClient() *oci_delegate_access_control.DelegateAccessControlClient {
-	return m.GetClient("oci_delegate_access_control.DelegateAccessControlClient").(*oci_delegate_access_control.DelegateAccessControlClient)
+	return m.getClientOrFailing("oci_delegate_access_control.DelegateAccessControlClient", (*oci_delegate_access_control.DelegateAccessControlClient)(nil)).(*oci_delegate_access_control.DelegateAccessControlClient)
 }
 
 func initDelegateaccesscontrolWorkRequestClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initDelegateaccesscontrolWorkRequestClient(configProvider oci_common.Config
 }
 
 func (m *OracleClients) DelegateAccessControlWorkRequestClient() *oci_delegate_access_control.WorkRequestClient {
-	return m.GetClient("oci_delegate_access_control.WorkRequestClient").(*oci_delegate_access_control.WorkRequestClient)
+	return m.getClientOrFailing("oci_delegate_access_control.WorkRequestClient", (*oci_delegate_access_control.WorkRequestClient)(nil)).(*oci_delegate_access_control.WorkRequestClient)
 }
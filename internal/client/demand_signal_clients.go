@@ -30,5 +30,5 @@ func initDemandsignalOccDemandSignalClient(configProvider oci_common.Configurati
 }
 
 func (m *OracleClients) OccDemandSignalClient() *oci_demand_signal.OccDemandSignalClient {
-	return m.GetClient("oci_demand_signal.OccDemandSignalClient").(*oci_demand_signal.OccDemandSignalClient)
+	return m.getClientOrFailing("oci_demand_signal.OccDemandSignalClient", (*oci_demand_signal.OccDemandSignalClient)(nil)).(*oci_demand_signal.OccDemandSignalClient)
 }
@@ -30,5 +30,5 @@ func initDesktopsDesktopServiceClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) DesktopServiceClient() *oci_desktops.DesktopServiceClient {
-	return m.GetClient("oci_desktops.DesktopServiceClient").(*oci_desktops.DesktopServiceClient)
+	return m.getClientOrFailing("oci_desktops.DesktopServiceClient", (*oci_desktops.DesktopServiceClient)(nil)).(*oci_desktops.DesktopServiceClient)
 }
@@ -30,5 +30,5 @@ func initDevopsDevopsClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) DevopsClient() *oci_devops.DevopsClient {
-	return m.GetClient("oci_devops.DevopsClient").(*oci_devops.DevopsClient)
+	return m.getClientOrFailing("oci_devops.DevopsClient", (*oci_devops.DevopsClient)(nil)).(*oci_devops.DevopsClient)
 }
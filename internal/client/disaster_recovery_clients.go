@@ -30,5 +30,5 @@ func initDisasterrecoveryDisasterRecoveryClient(configProvider oci_common.Config
 }
 
 func (m *OracleClients) DisasterRecoveryClient() *oci_disaster_recovery.DisasterRecoveryClient {
-	return m.GetClient("oci_disaster_recovery.DisasterRecoveryClient").(*oci_disaster_recovery.DisasterRecoveryClient)
+	return m.getClientOrFailing("oci_disaster_recovery.DisasterRecoveryClient", (*oci_disaster_recovery.DisasterRecoveryClient)(nil)).(*oci_disaster_recovery.DisasterRecoveryClient)
 }
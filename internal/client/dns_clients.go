@@ -30,5 +30,5 @@ func initDnsDnsClient(configProvider oci_common.ConfigurationProvider, configure
 }
 
 func (m *OracleClients) DnsClient() *oci_dns.DnsClient {
-	return m.GetClient("oci_dns.DnsClient").(*oci_dns.DnsClient)
+	return m.getClientOrFailing("oci_dns.DnsClient", (*oci_dns.DnsClient)(nil)).(*oci_dns.DnsClient)
 }
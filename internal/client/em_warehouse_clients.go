@@ -30,5 +30,5 @@ func initEmwarehouseEmWarehouseClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) EmWarehouseClient() *oci_em_warehouse.EmWarehouseClient {
-	return m.GetClient("oci_em_warehouse.EmWarehouseClient").(*oci_em_warehouse.EmWarehouseClient)
+	return m.getClientOrFailing("oci_em_warehouse.EmWarehouseClient", (*oci_em_warehouse.EmWarehouseClient)(nil)).(*oci_em_warehouse.EmWarehouseClient)
 }
@@ -30,5 +30,5 @@ func initEmailEmailClient(configProvider oci_common.ConfigurationProvider, confi
 }
 
 func (m *OracleClients) EmailClient() *oci_email.EmailClient {
-	return m.GetClient("oci_email.EmailClient").(*oci_email.EmailClient)
+	return m.getClientOrFailing("oci_email.EmailClient", (*oci_email.EmailClient)(nil)).(*oci_email.EmailClient)
 }
@@ -30,5 +30,5 @@ func initEventsEventsClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) EventsClient() *oci_events.EventsClient {
-	return m.GetClient("oci_events.EventsClient").(*oci_events.EventsClient)
+	return m.getClientOrFailing("oci_events.EventsClient", (*oci_events.EventsClient)(nil)).(*oci_events.EventsClient)
 }
@@ -30,5 +30,5 @@ func initFilestorageFileStorageClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) FileStorageClient() *oci_file_storage.FileStorageClient {
-	return m.GetClient("oci_file_storage.FileStorageClient").(*oci_file_storage.FileStorageClient)
+	return m.getClientOrFailing("oci_file_storage.FileStorageClient", (*oci_file_storage.FileStorageClient)(nil)).(*oci_file_storage.FileStorageClient)
 }
@@ -30,5 +30,5 @@ func initFleetsoftwareupdateFleetSoftwareUpdateClient(configProvider oci_common.
 }
 
 func (m *OracleClients) FleetSoftwareUpdateClient() *oci_fleet_software_update.FleetSoftwareUpdateClient {
-	return m.GetClient("oci_fleet_software_update.FleetSoftwareUpdateClient").(*oci_fleet_software_update.FleetSoftwareUpdateClient)
+	return m.getClientOrFailing("oci_fleet_software_update.FleetSoftwareUpdateClient", (*oci_fleet_software_update.FleetSoftwareUpdateClient)(nil)).(*oci_fleet_software_update.FleetSoftwareUpdateClient)
 }
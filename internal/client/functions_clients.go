@@ -31,7 +31,7 @@ func initFunctionsFunctionsInvokeClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) FunctionsInvokeClient() *oci_functions.FunctionsInvokeClient {
-	return m.GetClient("oci_functions.FunctionsInvokeClient").(*oci_functions.FunctionsInvokeClient)
+	return m.getClientOrFailing("oci_functions.FunctionsInvokeClient", (*oci_functions.FunctionsInvokeClient)(nil)).(*oci_functions.FunctionsInvokeClient)
 }
 
 func initFunctionsFunctionsManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initFunctionsFunctionsManagementClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) FunctionsManagementClient() *oci_functions.FunctionsManagementClient {
-	return m.GetClient("oci_functions.FunctionsManagementClient").(*oci_functions.FunctionsManagementClient)
+	return m.getClientOrFailing("oci_functions.FunctionsManagementClient", (*oci_functions.FunctionsManagementClient)(nil)).(*oci_functions.FunctionsManagementClient)
 }
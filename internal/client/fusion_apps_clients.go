@@ -30,5 +30,5 @@ func initFusionappsFusionApplicationsClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) FusionApplicationsClient() *oci_fusion_apps.FusionApplicationsClient {
-	return m.GetClient("oci_fusion_apps.FusionApplicationsClient").(*oci_fusion_apps.FusionApplicationsClient)
+	return m.getClientOrFailing("oci_fusion_apps.FusionApplicationsClient", (*oci_fusion_apps.FusionApplicationsClient)(nil)).(*oci_fusion_apps.FusionApplicationsClient)
 }
@@ -30,5 +30,5 @@ func initGenerativeaiGenerativeAiClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) GenerativeAiClient() *oci_generative_ai.GenerativeAiClient {
-	return m.GetClient("oci_generative_ai.GenerativeAiClient").(*oci_generative_ai.GenerativeAiClient)
+	return m.getClientOrFailing("oci_generative_ai.GenerativeAiClient", (*oci_generative_ai.GenerativeAiClient)(nil)).(*oci_generative_ai.GenerativeAiClient)
 }
@@ -30,5 +30,5 @@ func initGenericartifactscontentGenericArtifactsContentClient(configProvider oci
 }
 
 func (m *OracleClients) GenericArtifactsContentClient() *oci_generic_artifacts_content.GenericArtifactsContentClient {
-	return m.GetClient("oci_generic_artifacts_content.GenericArtifactsContentClient").(*oci_generic_artifacts_content.GenericArtifactsContentClient)
+	return m.getClientOrFailing("oci_generic_artifacts_content.GenericArtifactsContentClient", (*oci_generic_artifacts_content.GenericArtifactsContentClient)(nil)).(*oci_generic_artifacts_content.GenericArtifactsContentClient)
 }
@@ -30,5 +30,5 @@ func initGloballydistributeddatabaseShardedDatabaseServiceClient(configProvider
 }
 
 func (m *OracleClients) ShardedDatabaseServiceClient() *oci_globally_distributed_database.ShardedDatabaseServiceClient {
-	return m.GetClient("oci_globally_distributed_database.ShardedDatabaseServiceClient").(*oci_globally_distributed_database.ShardedDatabaseServiceClient)
+	return m.getClientOrFailing("oci_globally_distributed_database.ShardedDatabaseServiceClient", (*oci_globally_distributed_database.ShardedDatabaseServiceClient)(nil)).(*oci_globally_distributed_database.ShardedDatabaseServiceClient)
 }
@@ -30,5 +30,5 @@ func initGoldengateGoldenGateClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) GoldenGateClient() *oci_golden_gate.GoldenGateClient {
-	return m.GetClient("oci_golden_gate.GoldenGateClient").(*oci_golden_gate.GoldenGateClient)
+	return m.getClientOrFailing("oci_golden_gate.GoldenGateClient", (*oci_golden_gate.GoldenGateClient)(nil)).(*oci_golden_gate.GoldenGateClient)
 }
@@ -30,5 +30,5 @@ func initHealthchecksHealthChecksClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) HealthChecksClient() *oci_health_checks.HealthChecksClient {
-	return m.GetClient("oci_health_checks.HealthChecksClient").(*oci_health_checks.HealthChecksClient)
+	return m.getClientOrFailing("oci_health_checks.HealthChecksClient", (*oci_health_checks.HealthChecksClient)(nil)).(*oci_health_checks.HealthChecksClient)
 }
@@ -30,5 +30,5 @@ func initIdentityIdentityClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) IdentityClient() *oci_identity.IdentityClient {
-	return m.GetClient("oci_identity.IdentityClient").(*oci_identity.IdentityClient)
+	return m.getClientOrFailing("oci_identity.IdentityClient", (*oci_identity.IdentityClient)(nil)).(*oci_identity.IdentityClient)
 }
@@ -30,5 +30,5 @@ func initIdentitydataplaneDataplaneClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) DataplaneClient() *oci_identity_data_plane.DataplaneClient {
-	return m.GetClient("oci_identity_data_plane.DataplaneClient").(*oci_identity_data_plane.DataplaneClient)
+	return m.getClientOrFailing("oci_identity_data_plane.DataplaneClient", (*oci_identity_data_plane.DataplaneClient)(nil)).(*oci_identity_data_plane.DataplaneClient)
 }
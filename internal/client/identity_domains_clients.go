@@ -30,5 +30,5 @@ func initIdentitydomainsIdentityDomainsClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) IdentityDomainsClient() *oci_identity_domains.IdentityDomainsClient {
-	return m.GetClient("oci_identity_domains.IdentityDomainsClient").(*oci_identity_domains.IdentityDomainsClient)
+	return m.getClientOrFailing("oci_identity_domains.IdentityDomainsClient", (*oci_identity_domains.IdentityDomainsClient)(nil)).(*oci_identity_domains.IdentityDomainsClient)
 }
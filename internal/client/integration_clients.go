@@ -30,5 +30,5 @@ func initIntegrationIntegrationInstanceClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) IntegrationInstanceClient() *oci_integration.IntegrationInstanceClient {
-	return m.GetClient("oci_integration.IntegrationInstanceClient").(*oci_integration.IntegrationInstanceClient)
+	return m.getClientOrFailing("oci_integration.IntegrationInstanceClient", (*oci_integration.IntegrationInstanceClient)(nil)).(*oci_integration.IntegrationInstanceClient)
 }
@@ -30,5 +30,5 @@ func initJmsJavaManagementServiceClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) JavaManagementServiceClient() *oci_jms.JavaManagementServiceClient {
-	return m.GetClient("oci_jms.JavaManagementServiceClient").(*oci_jms.JavaManagementServiceClient)
+	return m.getClientOrFailing("oci_jms.JavaManagementServiceClient", (*oci_jms.JavaManagementServiceClient)(nil)).(*oci_jms.JavaManagementServiceClient)
 }
@@ -30,5 +30,5 @@ func initJmsjavadownloadsJavaDownloadClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) JavaDownloadClient() *oci_jms_java_downloads.JavaDownloadClient {
-	return m.GetClient("oci_jms_java_downloads.JavaDownloadClient").(*oci_jms_java_downloads.JavaDownloadClient)
+	return m.getClientOrFailing("oci_jms_java_downloads.JavaDownloadClient", (*oci_jms_java_downloads.JavaDownloadClient)(nil)).(*oci_jms_java_downloads.JavaDownloadClient)
 }
@@ -33,7 +33,7 @@ func initKeymanagementEkmClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) EkmClient() *oci_kms.EkmClient {
-	return m.GetClient("oci_kms.EkmClient").(*oci_kms.EkmClient)
+	return m.getClientOrFailing("oci_kms.EkmClient", (*oci_kms.EkmClient)(nil)).(*oci_kms.EkmClient)
 }
 
 func initKeymanagementKmsCryptoClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -53,7 +53,7 @@ func initKeymanagementKmsCryptoClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) KmsCryptoClient() *oci_kms.KmsCryptoClient {
-	return m.GetClient("oci_kms.KmsCryptoClient").(*oci_kms.KmsCryptoClient)
+	return m.getClientOrFailing("oci_kms.KmsCryptoClient", (*oci_kms.KmsCryptoClient)(nil)).(*oci_kms.KmsCryptoClient)
 }
 
 func initKeymanagementKmsManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -73,7 +73,7 @@ func initKeymanagementKmsManagementClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) KmsManagementClient() *oci_kms.KmsManagementClient {
-	return m.GetClient("oci_kms.KmsManagementClient").(*oci_kms.KmsManagementClient)
+	return m.getClientOrFailing("oci_kms.KmsManagementClient", (*oci_kms.KmsManagementClient)(nil)).(*oci_kms.KmsManagementClient)
 }
 
 func initKeymanagementKmsVaultClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -93,5 +93,5 @@ func initKeymanagementKmsVaultClient(configProvider oci_common.ConfigurationProv
 }
 
 func (m *OracleClients) KmsVaultClient() *oci_kms.KmsVaultClient {
-	return m.GetClient("oci_kms.KmsVaultClient").(*oci_kms.KmsVaultClient)
+	return m.getClientOrFailing("oci_kms.KmsVaultClient", (*oci_kms.KmsVaultClient)(nil)).(*oci_kms.KmsVaultClient)
 }
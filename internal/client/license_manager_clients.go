@@ -30,5 +30,5 @@ func initLicensemanagerLicenseManagerClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) LicenseManagerClient() *oci_license_manager.LicenseManagerClient {
-	return m.GetClient("oci_license_manager.LicenseManagerClient").(*oci_license_manager.LicenseManagerClient)
+	return m.getClientOrFailing("oci_license_manager.LicenseManagerClient", (*oci_license_manager.LicenseManagerClient)(nil)).(*oci_license_manager.LicenseManagerClient)
 }
@@ -31,7 +31,7 @@ func initLimitsLimitsClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) LimitsClient() *oci_limits.LimitsClient {
-	return m.GetClient("oci_limits.LimitsClient").(*oci_limits.LimitsClient)
+	return m.getClientOrFailing("oci_limits.LimitsClient", (*oci_limits.LimitsClient)(nil)).(*oci_limits.LimitsClient)
 }
 
 func initLimitsQuotasClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initLimitsQuotasClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) QuotasClient() *oci_limits.QuotasClient {
-	return m.GetClient("oci_limits.QuotasClient").(*oci_limits.QuotasClient)
+	return m.getClientOrFailing("oci_limits.QuotasClient", (*oci_limits.QuotasClient)(nil)).(*oci_limits.QuotasClient)
 }
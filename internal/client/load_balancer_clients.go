@@ -30,5 +30,5 @@ func initLoadbalancerLoadBalancerClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) LoadBalancerClient() *oci_load_balancer.LoadBalancerClient {
-	return m.GetClient("oci_load_balancer.LoadBalancerClient").(*oci_load_balancer.LoadBalancerClient)
+	return m.getClientOrFailing("oci_load_balancer.LoadBalancerClient", (*oci_load_balancer.LoadBalancerClient)(nil)).(*oci_load_balancer.LoadBalancerClient)
 }
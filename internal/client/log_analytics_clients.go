@@ -30,5 +30,5 @@ func initLoganalyticsLogAnalyticsClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) LogAnalyticsClient() *oci_log_analytics.LogAnalyticsClient {
-	return m.GetClient("oci_log_analytics.LogAnalyticsClient").(*oci_log_analytics.LogAnalyticsClient)
+	return m.getClientOrFailing("oci_log_analytics.LogAnalyticsClient", (*oci_log_analytics.LogAnalyticsClient)(nil)).(*oci_log_analytics.LogAnalyticsClient)
 }
@@ -30,5 +30,5 @@ func initLoggingLoggingManagementClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) LoggingManagementClient() *oci_logging.LoggingManagementClient {
-	return m.GetClient("oci_logging.LoggingManagementClient").(*oci_logging.LoggingManagementClient)
+	return m.getClientOrFailing("oci_logging.LoggingManagementClient", (*oci_logging.LoggingManagementClient)(nil)).(*oci_logging.LoggingManagementClient)
 }
@@ -30,5 +30,5 @@ func initManagementagentManagementAgentClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) ManagementAgentClient() *oci_management_agent.ManagementAgentClient {
-	return m.GetClient("oci_management_agent.ManagementAgentClient").(*oci_management_agent.ManagementAgentClient)
+	return m.getClientOrFailing("oci_management_agent.ManagementAgentClient", (*oci_management_agent.ManagementAgentClient)(nil)).(*oci_management_agent.ManagementAgentClient)
 }
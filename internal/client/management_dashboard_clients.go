@@ -30,5 +30,5 @@ func initManagementdashboardDashxApisClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) DashxApisClient() *oci_management_dashboard.DashxApisClient {
-	return m.GetClient("oci_management_dashboard.DashxApisClient").(*oci_management_dashboard.DashxApisClient)
+	return m.getClientOrFailing("oci_management_dashboard.DashxApisClient", (*oci_management_dashboard.DashxApisClient)(nil)).(*oci_management_dashboard.DashxApisClient)
 }
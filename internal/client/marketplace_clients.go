@@ -30,5 +30,5 @@ func initMarketplaceMarketplaceClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) MarketplaceClient() *oci_marketplace.MarketplaceClient {
-	return m.GetClient("oci_marketplace.MarketplaceClient").(*oci_marketplace.MarketplaceClient)
+	return m.getClientOrFailing("oci_marketplace.MarketplaceClient", (*oci_marketplace.MarketplaceClient)(nil)).(*oci_marketplace.MarketplaceClient)
 }
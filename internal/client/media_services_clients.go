@@ -30,5 +30,5 @@ func initMediaservicesMediaServicesClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) MediaServicesClient() *oci_media_services.MediaServicesClient {
-	return m.GetClient("oci_media_services.MediaServicesClient").(*oci_media_services.MediaServicesClient)
+	return m.getClientOrFailing("oci_media_services.MediaServicesClient", (*oci_media_services.MediaServicesClient)(nil)).(*oci_media_services.MediaServicesClient)
 }
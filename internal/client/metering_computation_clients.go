@@ -30,5 +30,5 @@ func initUsageapiUsageapiClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) UsageapiClient() *oci_metering_computation.UsageapiClient {
-	return m.GetClient("oci_metering_computation.UsageapiClient").(*oci_metering_computation.UsageapiClient)
+	return m.getClientOrFailing("oci_metering_computation.UsageapiClient", (*oci_metering_computation.UsageapiClient)(nil)).(*oci_metering_computation.UsageapiClient)
 }
@@ -30,5 +30,5 @@ func initMonitoringMonitoringClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) MonitoringClient() *oci_monitoring.MonitoringClient {
-	return m.GetClient("oci_monitoring.MonitoringClient").(*oci_monitoring.MonitoringClient)
+	return m.getClientOrFailing("oci_monitoring.MonitoringClient", (*oci_monitoring.MonitoringClient)(nil)).(*oci_monitoring.MonitoringClient)
 }
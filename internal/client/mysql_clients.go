@@ -34,7 +34,7 @@ func initMysqlChannelsClient(configProvider oci_common.ConfigurationProvider, co
 }
 
 func (m *OracleClients) ChannelsClient() *oci_mysql.ChannelsClient {
-	return m.GetClient("oci_mysql.ChannelsClient").(*oci_mysql.ChannelsClient)
+	return m.getClientOrFailing("oci_mysql.ChannelsClient", (*oci_mysql.ChannelsClient)(nil)).(*oci_mysql.ChannelsClient)
 }
 
 func initMysqlDbBackupsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -54,7 +54,7 @@ func initMysqlDbBackupsClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) DbBackupsClient() *oci_mysql.DbBackupsClient {
-	return m.GetClient("oci_mysql.DbBackupsClient").(*oci_mysql.DbBackupsClient)
+	return m.getClientOrFailing("oci_mysql.DbBackupsClient", (*oci_mysql.DbBackupsClient)(nil)).(*oci_mysql.DbBackupsClient)
 }
 
 func initMysqlDbSystemClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -74,7 +74,7 @@ func initMysqlDbSystemClient(configProvider oci_common.ConfigurationProvider, co
 }
 
 func (m *OracleClients) DbSystemClient() *oci_mysql.DbSystemClient {
-	return m.GetClient("oci_mysql.DbSystemClient").(*oci_mysql.DbSystemClient)
+	return m.getClientOrFailing("oci_mysql.DbSystemClient", (*oci_mysql.DbSystemClient)(nil)).(*oci_mysql.DbSystemClient)
 }
 
 func initMysqlMysqlaasClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -94,7 +94,7 @@ func initMysqlMysqlaasClient(configProvider oci_common.ConfigurationProvider, co
 }
 
 func (m *OracleClients) MysqlaasClient() *oci_mysql.MysqlaasClient {
-	return m.GetClient("oci_mysql.MysqlaasClient").(*oci_mysql.MysqlaasClient)
+	return m.getClientOrFailing("oci_mysql.MysqlaasClient", (*oci_mysql.MysqlaasClient)(nil)).(*oci_mysql.MysqlaasClient)
 }
 
 func initMysqlReplicasClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -114,5 +114,5 @@ func initMysqlReplicasClient(configProvider oci_common.ConfigurationProvider, co
 }
 
 func (m *OracleClients) ReplicasClient() *oci_mysql.ReplicasClient {
-	return m.GetClient("oci_mysql.ReplicasClient").(*oci_mysql.ReplicasClient)
+	return m.getClientOrFailing("oci_mysql.ReplicasClient", (*oci_mysql.ReplicasClient)(nil)).(*oci_mysql.ReplicasClient)
 }
@@ -30,5 +30,5 @@ func initNetworkfirewallNetworkFirewallClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) NetworkFirewallClient() *oci_network_firewall.NetworkFirewallClient {
-	return m.GetClient("oci_network_firewall.NetworkFirewallClient").(*oci_network_firewall.NetworkFirewallClient)
+	return m.getClientOrFailing("oci_network_firewall.NetworkFirewallClient", (*oci_network_firewall.NetworkFirewallClient)(nil)).(*oci_network_firewall.NetworkFirewallClient)
 }
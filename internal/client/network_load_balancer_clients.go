@@ -30,5 +30,5 @@ func initNetworkloadbalancerNetworkLoadBalancerClient(configProvider oci_common.
 }
 
 func (m *OracleClients) NetworkLoadBalancerClient() *oci_network_load_balancer.NetworkLoadBalancerClient {
-	return m.GetClient("oci_network_load_balancer.NetworkLoadBalancerClient").(*oci_network_load_balancer.NetworkLoadBalancerClient)
+	return m.getClientOrFailing("oci_network_load_balancer.NetworkLoadBalancerClient", (*oci_network_load_balancer.NetworkLoadBalancerClient)(nil)).(*oci_network_load_balancer.NetworkLoadBalancerClient)
 }
@@ -30,5 +30,5 @@ func initNosqlNosqlClient(configProvider oci_common.ConfigurationProvider, confi
 }
 
 func (m *OracleClients) NosqlClient() *oci_nosql.NosqlClient {
-	return m.GetClient("oci_nosql.NosqlClient").(*oci_nosql.NosqlClient)
+	return m.getClientOrFailing("oci_nosql.NosqlClient", (*oci_nosql.NosqlClient)(nil)).(*oci_nosql.NosqlClient)
 }
@@ -34,5 +34,5 @@ func initObjectstorageObjectStorageClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) ObjectStorageClient() *oci_object_storage.ObjectStorageClient {
-	return m.GetClient("oci_object_storage.ObjectStorageClient").(*oci_object_storage.ObjectStorageClient)
+	return m.getClientOrFailing("oci_object_storage.ObjectStorageClient", (*oci_object_storage.ObjectStorageClient)(nil)).(*oci_object_storage.ObjectStorageClient)
 }
@@ -30,5 +30,5 @@ func initOceOceInstanceClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) OceInstanceClient() *oci_oce.OceInstanceClient {
-	return m.GetClient("oci_oce.OceInstanceClient").(*oci_oce.OceInstanceClient)
+	return m.getClientOrFailing("oci_oce.OceInstanceClient", (*oci_oce.OceInstanceClient)(nil)).(*oci_oce.OceInstanceClient)
 }
@@ -33,7 +33,7 @@ func initOcvpClusterClient(configProvider oci_common.ConfigurationProvider, conf
 }
 
 func (m *OracleClients) ClusterClient() *oci_ocvp.ClusterClient {
-	return m.GetClient("oci_ocvp.ClusterClient").(*oci_ocvp.ClusterClient)
+	return m.getClientOrFailing("oci_ocvp.ClusterClient", (*oci_ocvp.ClusterClient)(nil)).(*oci_ocvp.ClusterClient)
 }
 
 func initOcvpEsxiHostClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -53,7 +53,7 @@ func initOcvpEsxiHostClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) EsxiHostClient() *oci_ocvp.EsxiHostClient {
-	return m.GetClient("oci_ocvp.EsxiHostClient").(*oci_ocvp.EsxiHostClient)
+	return m.getClientOrFailing("oci_ocvp.EsxiHostClient", (*oci_ocvp.EsxiHostClient)(nil)).(*oci_ocvp.EsxiHostClient)
 }
 
 func initOcvpWorkRequestClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -73,7 +73,7 @@ func initOcvpWorkRequestClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) OcvpWorkRequestClient() *oci_ocvp.WorkRequestClient {
-	return m.GetClient("oci_ocvp.WorkRequestClient").(*oci_ocvp.WorkRequestClient)
+	return m.getClientOrFailing("oci_ocvp.WorkRequestClient", (*oci_ocvp.WorkRequestClient)(nil)).(*oci_ocvp.WorkRequestClient)
 }
 
 func initOcvpSddcClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -93,5 +93,5 @@ func initOcvpSddcClient(configProvider oci_common.ConfigurationProvider, configu
 }
 
 func (m *OracleClients) SddcClient() *oci_ocvp.SddcClient {
-	return m.GetClient("oci_ocvp.SddcClient").(*oci_ocvp.SddcClient)
+	return m.getClientOrFailing("oci_ocvp.SddcClient", (*oci_ocvp.SddcClient)(nil)).(*oci_ocvp.SddcClient)
 }
@@ -31,7 +31,7 @@ func initOdaManagementClient(configProvider oci_common.ConfigurationProvider, co
 }
 
 func (m *OracleClients) ManagementClient() *oci_oda.ManagementClient {
-	return m.GetClient("oci_oda.ManagementClient").(*oci_oda.ManagementClient)
+	return m.getClientOrFailing("oci_oda.ManagementClient", (*oci_oda.ManagementClient)(nil)).(*oci_oda.ManagementClient)
 }
 
 func initOdaOdaClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initOdaOdaClient(configProvider oci_common.ConfigurationProvider, configure
 }
 
 func (m *OracleClients) OdaClient() *oci_oda.OdaClient {
-	return m.GetClient("oci_oda.OdaClient").(*oci_oda.OdaClient)
+	return m.getClientOrFailing("oci_oda.OdaClient", (*oci_oda.OdaClient)(nil)).(*oci_oda.OdaClient)
 }
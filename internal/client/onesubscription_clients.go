@@ -37,7 +37,7 @@ func initOnesubscriptionBillingScheduleClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) BillingScheduleRegionalClient() *oci_onesubscription.BillingScheduleClient {
-	return m.GetClient("oci_onesubscription.BillingScheduleRegionalClient").(*oci_onesubscription.BillingScheduleClient)
+	return m.getClientOrFailing("oci_onesubscription.BillingScheduleRegionalClient", (*oci_onesubscription.BillingScheduleClient)(nil)).(*oci_onesubscription.BillingScheduleClient)
 }
 
 func initOnesubscriptionCommitmentClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -57,7 +57,7 @@ func initOnesubscriptionCommitmentClient(configProvider oci_common.Configuration
 }
 
 func (m *OracleClients) CommitmentRegionalClient() *oci_onesubscription.CommitmentClient {
-	return m.GetClient("oci_onesubscription.CommitmentRegionalClient").(*oci_onesubscription.CommitmentClient)
+	return m.getClientOrFailing("oci_onesubscription.CommitmentRegionalClient", (*oci_onesubscription.CommitmentClient)(nil)).(*oci_onesubscription.CommitmentClient)
 }
 
 func initOnesubscriptionComputedUsageClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -77,7 +77,7 @@ func initOnesubscriptionComputedUsageClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) ComputedUsageRegionalClient() *oci_onesubscription.ComputedUsageClient {
-	return m.GetClient("oci_onesubscription.ComputedUsageRegionalClient").(*oci_onesubscription.ComputedUsageClient)
+	return m.getClientOrFailing("oci_onesubscription.ComputedUsageRegionalClient", (*oci_onesubscription.ComputedUsageClient)(nil)).(*oci_onesubscription.ComputedUsageClient)
 }
 
 func initOnesubscriptionInvoiceSummaryClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -97,7 +97,7 @@ func initOnesubscriptionInvoiceSummaryClient(configProvider oci_common.Configura
 }
 
 func (m *OracleClients) InvoiceSummaryRegionalClient() *oci_onesubscription.InvoiceSummaryClient {
-	return m.GetClient("oci_onesubscription.InvoiceSummaryRegionalClient").(*oci_onesubscription.InvoiceSummaryClient)
+	return m.getClientOrFailing("oci_onesubscription.InvoiceSummaryRegionalClient", (*oci_onesubscription.InvoiceSummaryClient)(nil)).(*oci_onesubscription.InvoiceSummaryClient)
 }
 
 func initOnesubscriptionOrganizationSubscriptionClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -117,7 +117,7 @@ func initOnesubscriptionOrganizationSubscriptionClient(configProvider oci_common
 }
 
 func (m *OracleClients) OrganizationSubscriptionRegionalClient() *oci_onesubscription.OrganizationSubscriptionClient {
-	return m.GetClient("oci_onesubscription.OrganizationSubscriptionRegionalClient").(*oci_onesubscription.OrganizationSubscriptionClient)
+	return m.getClientOrFailing("oci_onesubscription.OrganizationSubscriptionRegionalClient", (*oci_onesubscription.OrganizationSubscriptionClient)(nil)).(*oci_onesubscription.OrganizationSubscriptionClient)
 }
 
 func initOnesubscriptionRatecardClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -137,7 +137,7 @@ func initOnesubscriptionRatecardClient(configProvider oci_common.ConfigurationPr
 }
 
 func (m *OracleClients) RatecardRegionalClient() *oci_onesubscription.RatecardClient {
-	return m.GetClient("oci_onesubscription.RatecardRegionalClient").(*oci_onesubscription.RatecardClient)
+	return m.getClientOrFailing("oci_onesubscription.RatecardRegionalClient", (*oci_onesubscription.RatecardClient)(nil)).(*oci_onesubscription.RatecardClient)
 }
 
 func initOnesubscriptionSubscribedServiceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -157,7 +157,7 @@ func initOnesubscriptionSubscribedServiceClient(configProvider oci_common.Config
 }
 
 func (m *OracleClients) SubscribedServiceRegionalClient() *oci_onesubscription.SubscribedServiceClient {
-	return m.GetClient("oci_onesubscription.SubscribedServiceRegionalClient").(*oci_onesubscription.SubscribedServiceClient)
+	return m.getClientOrFailing("oci_onesubscription.SubscribedServiceRegionalClient", (*oci_onesubscription.SubscribedServiceClient)(nil)).(*oci_onesubscription.SubscribedServiceClient)
 }
 
 func initOnesubscriptionSubscriptionClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -177,5 +177,5 @@ func initOnesubscriptionSubscriptionClient(configProvider oci_common.Configurati
 }
 
 func (m *OracleClients) SubscriptionRegionalClient() *oci_onesubscription.SubscriptionClient {
-	return m.GetClient("oci_onesubscription.SubscriptionRegionalClient").(*oci_onesubscription.SubscriptionClient)
+	return m.getClientOrFailing("oci_onesubscription.SubscriptionRegionalClient", (*oci_onesubscription.SubscriptionClient)(nil)).(*oci_onesubscription.SubscriptionClient)
 }
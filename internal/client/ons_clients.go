@@ -31,7 +31,7 @@ func initOnsNotificationControlPlaneClient(configProvider oci_common.Configurati
 }
 
 func (m *OracleClients) NotificationControlPlaneClient() *oci_ons.NotificationControlPlaneClient {
-	return m.GetClient("oci_ons.NotificationControlPlaneClient").(*oci_ons.NotificationControlPlaneClient)
+	return m.getClientOrFailing("oci_ons.NotificationControlPlaneClient", (*oci_ons.NotificationControlPlaneClient)(nil)).(*oci_ons.NotificationControlPlaneClient)
 }
 
 func initOnsNotificationDataPlaneClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initOnsNotificationDataPlaneClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) NotificationDataPlaneClient() *oci_ons.NotificationDataPlaneClient {
-	return m.GetClient("oci_ons.NotificationDataPlaneClient").(*oci_ons.NotificationDataPlaneClient)
+	return m.getClientOrFailing("oci_ons.NotificationDataPlaneClient", (*oci_ons.NotificationDataPlaneClient)(nil)).(*oci_ons.NotificationDataPlaneClient)
 }
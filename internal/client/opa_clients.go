@@ -30,5 +30,5 @@ func initOpaOpaInstanceClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) OpaInstanceClient() *oci_opa.OpaInstanceClient {
-	return m.GetClient("oci_opa.OpaInstanceClient").(*oci_opa.OpaInstanceClient)
+	return m.getClientOrFailing("oci_opa.OpaInstanceClient", (*oci_opa.OpaInstanceClient)(nil)).(*oci_opa.OpaInstanceClient)
 }
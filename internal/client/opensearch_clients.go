@@ -30,5 +30,5 @@ func initOpensearchOpensearchClusterClient(configProvider oci_common.Configurati
 }
 
 func (m *OracleClients) OpensearchClusterClient() *oci_opensearch.OpensearchClusterClient {
-	return m.GetClient("oci_opensearch.OpensearchClusterClient").(*oci_opensearch.OpensearchClusterClient)
+	return m.getClientOrFailing("oci_opensearch.OpensearchClusterClient", (*oci_opensearch.OpensearchClusterClient)(nil)).(*oci_opensearch.OpensearchClusterClient)
 }
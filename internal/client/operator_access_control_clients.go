@@ -33,7 +33,7 @@ func initOperatoraccesscontrolAccessRequestsClient(configProvider oci_common.Con
 }
 
 func (m *OracleClients) AccessRequestsClient() *oci_operator_access_control.AccessRequestsClient {
-	return m.GetClient("oci_operator_access_control.AccessRequestsClient").(*oci_operator_access_control.AccessRequestsClient)
+	return m.getClientOrFailing("oci_operator_access_control.AccessRequestsClient", (*oci_operator_access_control.AccessRequestsClient)(nil)).(*oci_operator_access_control.AccessRequestsClient)
 }
 
 func initOperatoraccesscontrolOperatorActionsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -53,7 +53,7 @@ func initOperatoraccesscontrolOperatorActionsClient(configProvider oci_common.Co
 }
 
 func (m *OracleClients) OperatorActionsClient() *oci_operator_access_control.OperatorActionsClient {
-	return m.GetClient("oci_operator_access_control.OperatorActionsClient").(*oci_operator_access_control.OperatorActionsClient)
+	return m.getClientOrFailing("oci_operator_access_control.OperatorActionsClient", (*oci_operator_access_control.OperatorActionsClient)(nil)).(*oci_operator_access_control.OperatorActionsClient)
 }
 
 func initOperatoraccesscontrolOperatorControlClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -73,7 +73,7 @@ func initOperatoraccesscontrolOperatorControlClient(configProvider oci_common.Co
 }
 
 func (m *OracleClients) OperatorControlClient() *oci_operator_access_control.OperatorControlClient {
-	return m.GetClient("oci_operator_access_control.OperatorControlClient").(*oci_operator_access_control.OperatorControlClient)
+	return m.getClientOrFailing("oci_operator_access_control.OperatorControlClient", (*oci_operator_access_control.OperatorControlClient)(nil)).(*oci_operator_access_control.OperatorControlClient)
 }
 
 func initOperatoraccesscontrolOperatorControlAssignmentClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -93,5 +93,5 @@ func initOperatoraccesscontrolOperatorControlAssignmentClient(configProvider oci
 }
 
 func (m *OracleClients) OperatorControlAssignmentClient() *oci_operator_access_control.OperatorControlAssignmentClient {
-	return m.GetClient("oci_operator_access_control.OperatorControlAssignmentClient").(*oci_operator_access_control.OperatorControlAssignmentClient)
+	return m.getClientOrFailing("oci_operator_access_control.OperatorControlAssignmentClient", (*oci_operator_access_control.OperatorControlAssignmentClient)(nil)).(*oci_operator_access_control.OperatorControlAssignmentClient)
 }
@@ -29,5 +29,5 @@ func initOperationsinsightsOperationsInsightsClient(configProvider oci_common.Co
 }
 
 func (m *OracleClients) OperationsInsightsClient() *oci_opsi.OperationsInsightsClient {
-	return m.GetClient("oci_opsi.OperationsInsightsClient").(*oci_opsi.OperationsInsightsClient)
+	return m.getClientOrFailing("oci_opsi.OperationsInsightsClient", (*oci_opsi.OperationsInsightsClient)(nil)).(*oci_opsi.OperationsInsightsClient)
 }
@@ -30,5 +30,5 @@ func initOptimizerOptimizerClient(configProvider oci_common.ConfigurationProvide
 }
 
 func (m *OracleClients) OptimizerClient() *oci_optimizer.OptimizerClient {
-	return m.GetClient("oci_optimizer.OptimizerClient").(*oci_optimizer.OptimizerClient)
+	return m.getClientOrFailing("oci_optimizer.OptimizerClient", (*oci_optimizer.OptimizerClient)(nil)).(*oci_optimizer.OptimizerClient)
 }
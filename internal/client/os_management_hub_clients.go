@@ -38,7 +38,7 @@ func initOsmanagementhubEventClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) OsmhEventClient() *oci_os_management_hub.EventClient {
-	return m.GetClient("oci_os_management_hub.EventClient").(*oci_os_management_hub.EventClient)
+	return m.getClientOrFailing("oci_os_management_hub.EventClient", (*oci_os_management_hub.EventClient)(nil)).(*oci_os_management_hub.EventClient)
 }
 
 func initOsmanagementhubLifecycleEnvironmentClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -58,7 +58,7 @@ func initOsmanagementhubLifecycleEnvironmentClient(configProvider oci_common.Con
 }
 
 func (m *OracleClients) LifecycleEnvironmentClient() *oci_os_management_hub.LifecycleEnvironmentClient {
-	return m.GetClient("oci_os_management_hub.LifecycleEnvironmentClient").(*oci_os_management_hub.LifecycleEnvironmentClient)
+	return m.getClientOrFailing("oci_os_management_hub.LifecycleEnvironmentClient", (*oci_os_management_hub.LifecycleEnvironmentClient)(nil)).(*oci_os_management_hub.LifecycleEnvironmentClient)
 }
 
 func initOsmanagementhubManagedInstanceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -78,7 +78,7 @@ func initOsmanagementhubManagedInstanceClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) ManagedInstanceClient() *oci_os_management_hub.ManagedInstanceClient {
-	return m.GetClient("oci_os_management_hub.ManagedInstanceClient").(*oci_os_management_hub.ManagedInstanceClient)
+	return m.getClientOrFailing("oci_os_management_hub.ManagedInstanceClient", (*oci_os_management_hub.ManagedInstanceClient)(nil)).(*oci_os_management_hub.ManagedInstanceClient)
 }
 
 func initOsmanagementhubManagedInstanceGroupClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -98,7 +98,7 @@ func initOsmanagementhubManagedInstanceGroupClient(configProvider oci_common.Con
 }
 
 func (m *OracleClients) ManagedInstanceGroupClient() *oci_os_management_hub.ManagedInstanceGroupClient {
-	return m.GetClient("oci_os_management_hub.ManagedInstanceGroupClient").(*oci_os_management_hub.ManagedInstanceGroupClient)
+	return m.getClientOrFailing("oci_os_management_hub.ManagedInstanceGroupClient", (*oci_os_management_hub.ManagedInstanceGroupClient)(nil)).(*oci_os_management_hub.ManagedInstanceGroupClient)
 }
 
 func initOsmanagementhubManagementStationClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -118,7 +118,7 @@ func initOsmanagementhubManagementStationClient(configProvider oci_common.Config
 }
 
 func (m *OracleClients) ManagementStationClient() *oci_os_management_hub.ManagementStationClient {
-	return m.GetClient("oci_os_management_hub.ManagementStationClient").(*oci_os_management_hub.ManagementStationClient)
+	return m.getClientOrFailing("oci_os_management_hub.ManagementStationClient", (*oci_os_management_hub.ManagementStationClient)(nil)).(*oci_os_management_hub.ManagementStationClient)
 }
 
 func initOsmanagementhubOnboardingClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -138,7 +138,7 @@ func initOsmanagementhubOnboardingClient(configProvider oci_common.Configuration
 }
 
 func (m *OracleClients) OnboardingClient() *oci_os_management_hub.OnboardingClient {
-	return m.GetClient("oci_os_management_hub.OnboardingClient").(*oci_os_management_hub.OnboardingClient)
+	return m.getClientOrFailing("oci_os_management_hub.OnboardingClient", (*oci_os_management_hub.OnboardingClient)(nil)).(*oci_os_management_hub.OnboardingClient)
 }
 
 func initOsmanagementhubWorkRequestClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -158,7 +158,7 @@ func initOsmanagementhubWorkRequestClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) OsManagementHubWorkRequestClient() *oci_os_management_hub.WorkRequestClient {
-	return m.GetClient("oci_os_management_hub.WorkRequestClient").(*oci_os_management_hub.WorkRequestClient)
+	return m.getClientOrFailing("oci_os_management_hub.WorkRequestClient", (*oci_os_management_hub.WorkRequestClient)(nil)).(*oci_os_management_hub.WorkRequestClient)
 }
 
 func initOsmanagementhubScheduledJobClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -178,7 +178,7 @@ func initOsmanagementhubScheduledJobClient(configProvider oci_common.Configurati
 }
 
 func (m *OracleClients) ScheduledJobClient() *oci_os_management_hub.ScheduledJobClient {
-	return m.GetClient("oci_os_management_hub.ScheduledJobClient").(*oci_os_management_hub.ScheduledJobClient)
+	return m.getClientOrFailing("oci_os_management_hub.ScheduledJobClient", (*oci_os_management_hub.ScheduledJobClient)(nil)).(*oci_os_management_hub.ScheduledJobClient)
 }
 
 func initOsmanagementhubSoftwareSourceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -198,5 +198,5 @@ func initOsmanagementhubSoftwareSourceClient(configProvider oci_common.Configura
 }
 
 func (m *OracleClients) SoftwareSourceClient() *oci_os_management_hub.SoftwareSourceClient {
-	return m.GetClient("oci_os_management_hub.SoftwareSourceClient").(*oci_os_management_hub.SoftwareSourceClient)
+	return m.getClientOrFailing("oci_os_management_hub.SoftwareSourceClient", (*oci_os_management_hub.SoftwareSourceClient)(nil)).(*oci_os_management_hub.SoftwareSourceClient)
 }
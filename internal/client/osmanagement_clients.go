@@ -31,7 +31,7 @@ func initOsmanagementEventClient(configProvider oci_common.ConfigurationProvider
 }
 
 func (m *OracleClients) EventClient() *oci_osmanagement.EventClient {
-	return m.GetClient("oci_osmanagement.EventClient").(*oci_osmanagement.EventClient)
+	return m.getClientOrFailing("oci_osmanagement.EventClient", (*oci_osmanagement.EventClient)(nil)).(*oci_osmanagement.EventClient)
 }
 
 func initOsmanagementOsManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initOsmanagementOsManagementClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) OsManagementClient() *oci_osmanagement.OsManagementClient {
-	return m.GetClient("oci_osmanagement.OsManagementClient").(*oci_osmanagement.OsManagementClient)
+	return m.getClientOrFailing("oci_osmanagement.OsManagementClient", (*oci_osmanagement.OsManagementClient)(nil)).(*oci_osmanagement.OsManagementClient)
 }
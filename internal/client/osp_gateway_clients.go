@@ -33,7 +33,7 @@ func initOspgatewayAddressRuleServiceClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) AddressRuleServiceClient() *oci_osp_gateway.AddressRuleServiceClient {
-	return m.GetClient("oci_osp_gateway.AddressRuleServiceClient").(*oci_osp_gateway.AddressRuleServiceClient)
+	return m.getClientOrFailing("oci_osp_gateway.AddressRuleServiceClient", (*oci_osp_gateway.AddressRuleServiceClient)(nil)).(*oci_osp_gateway.AddressRuleServiceClient)
 }
 
 func initOspgatewayAddressServiceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -53,7 +53,7 @@ func initOspgatewayAddressServiceClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) AddressServiceClient() *oci_osp_gateway.AddressServiceClient {
-	return m.GetClient("oci_osp_gateway.AddressServiceClient").(*oci_osp_gateway.AddressServiceClient)
+	return m.getClientOrFailing("oci_osp_gateway.AddressServiceClient", (*oci_osp_gateway.AddressServiceClient)(nil)).(*oci_osp_gateway.AddressServiceClient)
 }
 
 func initOspgatewayInvoiceServiceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -73,7 +73,7 @@ func initOspgatewayInvoiceServiceClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) InvoiceServiceClient() *oci_osp_gateway.InvoiceServiceClient {
-	return m.GetClient("oci_osp_gateway.InvoiceServiceClient").(*oci_osp_gateway.InvoiceServiceClient)
+	return m.getClientOrFailing("oci_osp_gateway.InvoiceServiceClient", (*oci_osp_gateway.InvoiceServiceClient)(nil)).(*oci_osp_gateway.InvoiceServiceClient)
 }
 
 func initOspgatewaySubscriptionServiceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -93,5 +93,5 @@ func initOspgatewaySubscriptionServiceClient(configProvider oci_common.Configura
 }
 
 func (m *OracleClients) SubscriptionServiceClient() *oci_osp_gateway.SubscriptionServiceClient {
-	return m.GetClient("oci_osp_gateway.SubscriptionServiceClient").(*oci_osp_gateway.SubscriptionServiceClient)
+	return m.getClientOrFailing("oci_osp_gateway.SubscriptionServiceClient", (*oci_osp_gateway.SubscriptionServiceClient)(nil)).(*oci_osp_gateway.SubscriptionServiceClient)
 }
@@ -30,5 +30,5 @@ func initOsubbillingscheduleBillingScheduleClient(configProvider oci_common.Conf
 }
 
 func (m *OracleClients) BillingScheduleClient() *oci_osub_billing_schedule.BillingScheduleClient {
-	return m.GetClient("oci_osub_billing_schedule.BillingScheduleClient").(*oci_osub_billing_schedule.BillingScheduleClient)
+	return m.getClientOrFailing("oci_osub_billing_schedule.BillingScheduleClient", (*oci_osub_billing_schedule.BillingScheduleClient)(nil)).(*oci_osub_billing_schedule.BillingScheduleClient)
 }
@@ -30,5 +30,5 @@ func initOsuborganizationsubscriptionOrganizationSubscriptionClient(configProvid
 }
 
 func (m *OracleClients) OrganizationSubscriptionClient() *oci_osub_organization_subscription.OrganizationSubscriptionClient {
-	return m.GetClient("oci_osub_organization_subscription.OrganizationSubscriptionClient").(*oci_osub_organization_subscription.OrganizationSubscriptionClient)
+	return m.getClientOrFailing("oci_osub_organization_subscription.OrganizationSubscriptionClient", (*oci_osub_organization_subscription.OrganizationSubscriptionClient)(nil)).(*oci_osub_organization_subscription.OrganizationSubscriptionClient)
 }
@@ -32,7 +32,7 @@ func initOsubsubscriptionCommitmentClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) CommitmentClient() *oci_osub_subscription.CommitmentClient {
-	return m.GetClient("oci_osub_subscription.CommitmentClient").(*oci_osub_subscription.CommitmentClient)
+	return m.getClientOrFailing("oci_osub_subscription.CommitmentClient", (*oci_osub_subscription.CommitmentClient)(nil)).(*oci_osub_subscription.CommitmentClient)
 }
 
 func initOsubsubscriptionRatecardClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -52,7 +52,7 @@ func initOsubsubscriptionRatecardClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) RatecardClient() *oci_osub_subscription.RatecardClient {
-	return m.GetClient("oci_osub_subscription.RatecardClient").(*oci_osub_subscription.RatecardClient)
+	return m.getClientOrFailing("oci_osub_subscription.RatecardClient", (*oci_osub_subscription.RatecardClient)(nil)).(*oci_osub_subscription.RatecardClient)
 }
 
 func initOsubsubscriptionSubscriptionClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -72,5 +72,5 @@ func initOsubsubscriptionSubscriptionClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) SubscriptionClient() *oci_osub_subscription.SubscriptionClient {
-	return m.GetClient("oci_osub_subscription.SubscriptionClient").(*oci_osub_subscription.SubscriptionClient)
+	return m.getClientOrFailing("oci_osub_subscription.SubscriptionClient", (*oci_osub_subscription.SubscriptionClient)(nil)).(*oci_osub_subscription.SubscriptionClient)
 }
@@ -30,5 +30,5 @@ func initOsubusageComputedUsageClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) ComputedUsageClient() *oci_osub_usage.ComputedUsageClient {
-	return m.GetClient("oci_osub_usage.ComputedUsageClient").(*oci_osub_usage.ComputedUsageClient)
+	return m.getClientOrFailing("oci_osub_usage.ComputedUsageClient", (*oci_osub_usage.ComputedUsageClient)(nil)).(*oci_osub_usage.ComputedUsageClient)
 }
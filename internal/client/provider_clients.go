@@ -4,9 +4,15 @@
 package client
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
@@ -36,10 +42,43 @@ func RegisterOracleClient(name string, client *OracleClient) {
 	OracleClientRegistrationsVar.RegisteredClients[name] = client
 }
 
+// ServiceNames returns the names of the services of the registered clients, e.g. `core` or `objectstorage`, along with
+// the work requests service
+func ServiceNames() []string {
+	serviceNames := map[string]bool{globalvar.WorkRequest: true}
+	if OracleClientRegistrationsVar != nil {
+		for name := range OracleClientRegistrationsVar.RegisteredClients {
+			serviceNames[utils.GetSDKServiceName(name)] = true
+		}
+	}
+	result := make([]string, 0, len(serviceNames))
+	for serviceName := range serviceNames {
+		result = append(result, serviceName)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// ValidateServiceName returns an error if the service is not one of ServiceNames, which key the settings of the
+// provider block that apply to the requests of a service, e.g. its `endpoints`
+func ValidateServiceName(serviceName string) error {
+	serviceNames := ServiceNames()
+	for _, name := range serviceNames {
+		if serviceName == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown service '%s', the services are: %s", serviceName, strings.Join(serviceNames, ", "))
+}
+
 type ConfigureClient func(client *oci_common.BaseClient) error
 
 var ConfigureClientVar ConfigureClient // global fn ref used to configure all clients initially and others later on
 
+// DescribeConfigurationSourcesVar describes where the configuration was looked up from, and why each source failed. It is
+// set by the provider, and added to the errors of the clients that could not be created.
+var DescribeConfigurationSourcesVar func(configSources []ConfigurationSource) string
+
 type InitSdkClientFn func(oci_common.ConfigurationProvider, ConfigureClient, ServiceClientOverrides) (interface{}, error)
 
 type OracleClientRegistrations struct {
@@ -58,30 +97,387 @@ type OracleClients struct {
 	Configuration     map[string]string
 	SdkClientMap      map[string]interface{}
 	WorkRequestClient *oci_work_requests.WorkRequestClient
+	AdditionalRegions []string // Regions, other than the provider's region, in which resources may be managed
+
+	// Endpoints set in the provider block, keyed by service name. They take precedence over CLIENT_HOST_OVERRIDES, and
+	// are not used by the clients of AdditionalRegions since they are specific to the provider's region.
+	EndpointOverrides map[string]string
+
+	// Where the configuration was looked up from, in order, and where each provider setting was taken from.
+	// Used to report why the configuration could not be found, since ComposingConfigurationProvider swallows the errors.
+	ConfigurationSources  []ConfigurationSource
+	ConfigurationSettings []ConfigurationSetting
+
+	// Used to build clients on demand, the first time they are requested through GetClient
+	configProvider      oci_common.ConfigurationProvider
+	configureClient     ConfigureClient
+	clientHostOverrides map[string]string
+	sdkClientMapMutex   sync.RWMutex
+	// Errors of the clients that could not be created, so that every operation using them fails the same way
+	sdkClientErrors map[string]error
+
+	regionalClients      map[string]*OracleClients
+	regionalClientsMutex sync.Mutex
+
+	// Set on the clients returned by ClientsWithContext: the context of the resource operation, and the clients of
+	// the provider the SDK clients are copied from
+	ctx    context.Context
+	parent *OracleClients
+}
+
+// ConfigurationSource is a ConfigurationProvider the configuration is looked up from, e.g. the provider block or a
+// profile of the OCI config file
+type ConfigurationSource struct {
+	Name     string
+	Provider oci_common.ConfigurationProvider
+}
+
+// ConfigurationSetting is a provider setting, along with where its value was taken from. The source is empty if the
+// setting was not set and its default value is used.
+type ConfigurationSetting struct {
+	Name   string
+	Value  string
+	Source string
+}
+
+// regionConfigurationProvider overrides the region of the ConfigurationProvider it wraps, so that clients
+// for another region can be created with the same credentials
+type regionConfigurationProvider struct {
+	oci_common.ConfigurationProvider
+	region string
+}
+
+func (p regionConfigurationProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+// GetClient returns the client registered under the given name. Clients are created lazily and cached,
+// so that only the clients used by the current configuration are ever built. The error of a client that could not be
+// created is cached as well, and returned every time the client is requested.
+func (m *OracleClients) GetClient(name string) (interface{}, error) {
+	if m.parent != nil {
+		return m.getClientWithContext(name)
+	}
+
+	m.sdkClientMapMutex.RLock()
+	client, ok := m.SdkClientMap[name]
+	err := m.sdkClientErrors[name]
+	m.sdkClientMapMutex.RUnlock()
+	if ok || err != nil {
+		return client, err
+	}
+
+	m.sdkClientMapMutex.Lock()
+	defer m.sdkClientMapMutex.Unlock()
+
+	// Another goroutine may have created the client while we were waiting for the lock
+	if client, ok := m.SdkClientMap[name]; ok {
+		return client, nil
+	}
+	if err := m.sdkClientErrors[name]; err != nil {
+		return nil, err
+	}
+
+	client, err = m.createSDKClient(name)
+	if err != nil {
+		if DescribeConfigurationSourcesVar != nil && len(m.ConfigurationSources) > 0 {
+			err = fmt.Errorf("%v\n%s", err, DescribeConfigurationSourcesVar(m.ConfigurationSources))
+		}
+		err = &tfresource.ClientError{ClientName: name, Err: err}
+		log.Printf("[ERROR] %v", err)
+		if m.sdkClientErrors == nil {
+			m.sdkClientErrors = make(map[string]error)
+		}
+		m.sdkClientErrors[name] = err
+		return nil, err
+	}
+	if m.SdkClientMap == nil {
+		m.SdkClientMap = make(map[string]interface{})
+	}
+	m.SdkClientMap[name] = client
+	return client, nil
 }
 
-func (m *OracleClients) GetClient(name string) interface{} {
-	return m.SdkClientMap[name]
+// getClientOrFailing returns the client registered under the given name, for the typed accessors of the clients, which
+// pass a nil pointer of its type. If the client could not be created, it returns a client of that type whose requests
+// all fail with the *tfresource.ClientError instead, so that the operations using it report the error like the errors
+// of their requests. The callers that need the error before sending any request get it from GetClient.
+func (m *OracleClients) getClientOrFailing(name string, clientType interface{}) interface{} {
+	client, err := m.GetClient(name)
+	if err == nil {
+		return client
+	}
+	failing := reflect.New(reflect.TypeOf(clientType).Elem())
+	if baseClientField := failing.Elem().FieldByName("BaseClient"); baseClientField.IsValid() {
+		baseClientField.Set(reflect.ValueOf(failingBaseClient(err)))
+	}
+	return failing.Interface()
+}
+
+// failingBaseClient returns a BaseClient whose requests fail with the error before they are signed and sent
+func failingBaseClient(err error) oci_common.BaseClient {
+	return oci_common.BaseClient{
+		UserAgent: globalvar.DefaultUserAgentProviderName,
+		Interceptor: func(*http.Request) error {
+			return err
+		},
+	}
+}
+
+// ClientsWithContext returns the clients to use for a resource operation. Their SDK clients send the requests made
+// with context.Background(), as the resources do, with the context of the operation instead, so that the requests are
+// cancelled when Terraform is interrupted. The SDK clients are created by the provider's clients, and copied the first
+// time they are requested; the copies share their signer, circuit breaker and dispatchers.
+func (m *OracleClients) ClientsWithContext(ctx context.Context) interface{} {
+	provider := m
+	if m.parent != nil {
+		provider = m.parent
+	}
+	clients := &OracleClients{
+		Configuration:         provider.Configuration,
+		SdkClientMap:          make(map[string]interface{}),
+		AdditionalRegions:     provider.AdditionalRegions,
+		EndpointOverrides:     provider.EndpointOverrides,
+		ConfigurationSources:  provider.ConfigurationSources,
+		ConfigurationSettings: provider.ConfigurationSettings,
+		configProvider:        provider.configProvider,
+		configureClient:       provider.configureClient,
+		clientHostOverrides:   provider.clientHostOverrides,
+		ctx:                   ctx,
+		parent:                provider,
+	}
+	if provider.WorkRequestClient != nil {
+		workRequestClient := *provider.WorkRequestClient
+		clients.bindContext(&workRequestClient.BaseClient)
+		clients.WorkRequestClient = &workRequestClient
+	}
+	return clients
+}
+
+func (m *OracleClients) getClientWithContext(name string) (interface{}, error) {
+	m.sdkClientMapMutex.Lock()
+	defer m.sdkClientMapMutex.Unlock()
+	if client, ok := m.SdkClientMap[name]; ok {
+		return client, nil
+	}
+
+	client, err := m.parent.GetClient(name)
+	if err != nil {
+		return nil, err
+	}
+	client = m.copyClientWithContext(client)
+	m.SdkClientMap[name] = client
+	return client, nil
+}
+
+// copyClientWithContext returns a copy of the SDK client that sends its requests with the context of the clients.
+// All the SDK clients are structs embedding common.BaseClient, and are copied as such.
+func (m *OracleClients) copyClientWithContext(client interface{}) interface{} {
+	value := reflect.ValueOf(client)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return client
+	}
+	copied := reflect.New(value.Elem().Type())
+	copied.Elem().Set(value.Elem())
+	baseClientField := copied.Elem().FieldByName("BaseClient")
+	if !baseClientField.IsValid() {
+		return client
+	}
+	baseClient, ok := baseClientField.Addr().Interface().(*oci_common.BaseClient)
+	if !ok {
+		return client
+	}
+	m.bindContext(baseClient)
+	return copied.Interface()
+}
+
+// bindContext makes the client send its requests with the context of the clients, if they have one
+func (m *OracleClients) bindContext(client *oci_common.BaseClient) {
+	if m.ctx != nil && client.HTTPClient != nil {
+		client.HTTPClient = &contextDispatcher{ctx: m.ctx, dispatcher: client.HTTPClient}
+	}
+}
+
+// contextDispatcher is an HTTPRequestDispatcher that sends the requests made with a context that can not be cancelled,
+// e.g. context.Background(), with the context of the resource operation instead. It records the requests that change
+// the resource, after which the operation no longer sends the etag the resource was read with.
+type contextDispatcher struct {
+	ctx        context.Context
+	dispatcher oci_common.HTTPRequestDispatcher
+}
+
+func (d *contextDispatcher) Do(r *http.Request) (*http.Response, error) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		tfresource.ResourceChanged(d.ctx)
+	}
+	if r.Context().Done() == nil {
+		r = r.WithContext(d.ctx)
+	}
+	return d.dispatcher.Do(r)
+}
+
+// OperationContext returns the context of the resource operation, for the waiters that are given the client only
+func (d *contextDispatcher) OperationContext() context.Context {
+	return d.ctx
+}
+
+type serviceNameContextKey struct{}
+
+// serviceNameDispatcher is an HTTPRequestDispatcher that sends the requests of the client of a service with the name
+// of the service in their context, for the dispatchers set by ConfigureClient that apply to the requests of a service
+type serviceNameDispatcher struct {
+	serviceName string
+	dispatcher  oci_common.HTTPRequestDispatcher
+}
+
+func (d *serviceNameDispatcher) Do(r *http.Request) (*http.Response, error) {
+	return d.dispatcher.Do(r.WithContext(context.WithValue(r.Context(), serviceNameContextKey{}, d.serviceName)))
+}
+
+// ServiceNameFromContext returns the name of the service the request of the context is sent to, as in ServiceNames,
+// or "" if it is not sent by the client of a service
+func ServiceNameFromContext(ctx context.Context) string {
+	serviceName, _ := ctx.Value(serviceNameContextKey{}).(string)
+	return serviceName
+}
+
+// setServiceName sends the requests of the client with the name of its service, e.g. `core` for the
+// `oci_core.VirtualNetworkClient`, in their context
+func setServiceName(client *oci_common.BaseClient, clientName string) {
+	client.HTTPClient = &serviceNameDispatcher{serviceName: utils.GetSDKServiceName(clientName), dispatcher: client.HTTPClient}
+}
+
+func (m *OracleClients) createSDKClient(name string) (interface{}, error) {
+	if m.configProvider == nil || m.configureClient == nil {
+		return nil, fmt.Errorf("clients have not been configured")
+	}
+	if OracleClientRegistrationsVar == nil {
+		return nil, fmt.Errorf("there are no clients to Create")
+	}
+	clientRegistration, ok := OracleClientRegistrationsVar.RegisteredClients[name]
+	if !ok || clientRegistration.InitClientFn == nil {
+		return nil, fmt.Errorf("unable to initialize '%s' client", name)
+	}
+	if !common.CheckForEnabledServices(utils.GetSDKServiceName(name)) {
+		return nil, fmt.Errorf("service for '%s' client is not enabled", name)
+	}
+
+	serviceClientOverrides := ServiceClientOverrides{}
+	// apply client host override
+	if host, ok := m.clientHostOverrides[name]; ok {
+		serviceClientOverrides.HostUrlOverride = host
+	}
+	if host, ok := m.EndpointOverrides[utils.GetSDKServiceName(name)]; ok {
+		serviceClientOverrides.HostUrlOverride = host
+	}
+	configureClient := func(client *oci_common.BaseClient) error {
+		if err := m.configureClient(client); err != nil {
+			return err
+		}
+		setServiceName(client, name)
+		tfresource.WatchServiceHealth(utils.GetSDKServiceName(name), client)
+		return nil
+	}
+	return clientRegistration.InitClientFn(m.configProvider, configureClient, serviceClientOverrides)
+}
+
+// ClientsForRegion returns the clients to use for resources managed in the given region. Clients for the provider's
+// region are returned as is; clients for one of the AdditionalRegions are created the first time they are requested,
+// reusing the same Configuration provider as the provider's clients.
+func (m *OracleClients) ClientsForRegion(region string) (interface{}, error) {
+	if region == "" {
+		return m, nil
+	}
+	if m.parent != nil {
+		clients, err := m.parent.ClientsForRegion(region)
+		if err != nil {
+			return nil, err
+		}
+		if clients == m.parent {
+			return m, nil
+		}
+		return clients.(*OracleClients).ClientsWithContext(m.ctx), nil
+	}
+	if m.configProvider == nil || m.configureClient == nil {
+		return nil, fmt.Errorf("clients have not been configured")
+	}
+	if providerRegion, err := m.configProvider.Region(); err == nil && strings.EqualFold(region, providerRegion) {
+		return m, nil
+	}
+
+	isAdditionalRegion := false
+	for _, additionalRegion := range m.AdditionalRegions {
+		if strings.EqualFold(region, additionalRegion) {
+			isAdditionalRegion = true
+			break
+		}
+	}
+	if !isAdditionalRegion {
+		return nil, fmt.Errorf("region '%s' is not configured for the provider, add it to '%s' in the provider block", region, globalvar.AdditionalRegionsAttrName)
+	}
+
+	m.regionalClientsMutex.Lock()
+	defer m.regionalClientsMutex.Unlock()
+
+	region = strings.ToLower(region)
+	if clients, ok := m.regionalClients[region]; ok {
+		return clients, nil
+	}
+
+	clients := &OracleClients{
+		SdkClientMap:         make(map[string]interface{}),
+		Configuration:        make(map[string]string, len(m.Configuration)),
+		ConfigurationSources: m.ConfigurationSources,
+	}
+	for key, value := range m.Configuration {
+		clients.Configuration[key] = value
+	}
+	clients.Configuration[globalvar.RegionAttrName] = region
+
+	if err := CreateSDKClients(clients, regionConfigurationProvider{m.configProvider, region}, m.configureClient); err != nil {
+		return nil, err
+	}
+	// The hosts of CLIENT_HOST_OVERRIDES are in the provider's region, like the endpoints of the provider block
+	clients.sdkClientMapMutex.Lock()
+	clients.clientHostOverrides = nil
+	clients.sdkClientMapMutex.Unlock()
+	if m.regionalClients == nil {
+		m.regionalClients = make(map[string]*OracleClients)
+	}
+	m.regionalClients[region] = clients
+	return clients, nil
 }
 
 // The following clients require special endpoint information that is only known at Terraform apply time; so they
 // Create duplicate clients reusing the same Configuration provider as the initialized client and adding the endpoint
 // here.
 func (m *OracleClients) FunctionsInvokeClientWithEndpoint(endpoint string) (*oci_functions.FunctionsInvokeClient, error) {
-	if client, err := oci_functions.NewFunctionsInvokeClientWithConfigurationProvider(*m.FunctionsInvokeClient().ConfigurationProvider(), endpoint); err == nil {
+	initializedClient, err := m.GetClient("oci_functions.FunctionsInvokeClient")
+	if err != nil {
+		return nil, err
+	}
+	if client, err := oci_functions.NewFunctionsInvokeClientWithConfigurationProvider(*initializedClient.(*oci_functions.FunctionsInvokeClient).ConfigurationProvider(), endpoint); err == nil {
 		if err = ConfigureClientVar(&client.BaseClient); err != nil {
 			return nil, err
 		}
+		setServiceName(&client.BaseClient, "oci_functions.FunctionsInvokeClient")
+		m.bindContext(&client.BaseClient)
 		return &client, nil
 	} else {
 		return nil, err
 	}
 }
 func (m *OracleClients) KmsCryptoClientWithEndpoint(endpoint string) (*oci_kms.KmsCryptoClient, error) {
-	if client, err := oci_kms.NewKmsCryptoClientWithConfigurationProvider(*m.KmsCryptoClient().ConfigurationProvider(), endpoint); err == nil {
+	initializedClient, err := m.GetClient("oci_kms.KmsCryptoClient")
+	if err != nil {
+		return nil, err
+	}
+	if client, err := oci_kms.NewKmsCryptoClientWithConfigurationProvider(*initializedClient.(*oci_kms.KmsCryptoClient).ConfigurationProvider(), endpoint); err == nil {
 		if err = ConfigureClientVar(&client.BaseClient); err != nil {
 			return nil, err
 		}
+		setServiceName(&client.BaseClient, "oci_kms.KmsCryptoClient")
+		m.bindContext(&client.BaseClient)
 		return &client, nil
 	} else {
 		return nil, err
@@ -89,10 +485,16 @@ func (m *OracleClients) KmsCryptoClientWithEndpoint(endpoint string) (*oci_kms.K
 }
 
 func (m *OracleClients) KmsManagementClientWithEndpoint(endpoint string) (*oci_kms.KmsManagementClient, error) {
-	if client, err := oci_kms.NewKmsManagementClientWithConfigurationProvider(*m.KmsManagementClient().ConfigurationProvider(), endpoint); err == nil {
+	initializedClient, err := m.GetClient("oci_kms.KmsManagementClient")
+	if err != nil {
+		return nil, err
+	}
+	if client, err := oci_kms.NewKmsManagementClientWithConfigurationProvider(*initializedClient.(*oci_kms.KmsManagementClient).ConfigurationProvider(), endpoint); err == nil {
 		if err = ConfigureClientVar(&client.BaseClient); err != nil {
 			return nil, err
 		}
+		setServiceName(&client.BaseClient, "oci_kms.KmsManagementClient")
+		m.bindContext(&client.BaseClient)
 		return &client, nil
 	} else {
 		return nil, err
@@ -100,10 +502,16 @@ func (m *OracleClients) KmsManagementClientWithEndpoint(endpoint string) (*oci_k
 }
 
 func (m *OracleClients) IdentityDomainsClientWithEndpoint(endpoint string) (*oci_identity_domains.IdentityDomainsClient, error) {
-	if client, err := oci_identity_domains.NewIdentityDomainsClientWithConfigurationProvider(*m.IdentityDomainsClient().ConfigurationProvider(), endpoint); err == nil {
+	initializedClient, err := m.GetClient("oci_identity_domains.IdentityDomainsClient")
+	if err != nil {
+		return nil, err
+	}
+	if client, err := oci_identity_domains.NewIdentityDomainsClientWithConfigurationProvider(*initializedClient.(*oci_identity_domains.IdentityDomainsClient).ConfigurationProvider(), endpoint); err == nil {
 		if err = ConfigureClientVar(&client.BaseClient); err != nil {
 			return nil, err
 		}
+		setServiceName(&client.BaseClient, "oci_identity_domains.IdentityDomainsClient")
+		m.bindContext(&client.BaseClient)
 		return &client, nil
 	} else {
 		return nil, err
@@ -129,30 +537,28 @@ func getClientHostOverrides() map[string]string {
 	return clientHostOverrides
 }
 
+// CreateSDKClients prepares the given clients to be built on demand by GetClient. Only the work request client,
+// which is shared by all services, is created here.
 func CreateSDKClients(clients *OracleClients, configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient) (err error) {
 	if OracleClientRegistrationsVar == nil || len(OracleClientRegistrationsVar.RegisteredClients) == 0 {
 		return fmt.Errorf("there are no clients to Create")
 	}
 
-	clientHostOverrides := getClientHostOverrides()
 	for serviceName, clientRegistration := range OracleClientRegistrationsVar.RegisteredClients {
-		if clientRegistration.InitClientFn != nil {
-			serviceClientOverrides := ServiceClientOverrides{}
-			// apply client host override
-			if host, ok := clientHostOverrides[serviceName]; ok {
-				serviceClientOverrides.HostUrlOverride = host
-			}
-			if !common.CheckForEnabledServices(utils.GetSDKServiceName(serviceName)) {
-				continue
-			}
-			clients.SdkClientMap[serviceName], err = clientRegistration.InitClientFn(configProvider, configureClient, serviceClientOverrides)
-			if err != nil {
-				return err
-			}
-		} else {
+		if clientRegistration.InitClientFn == nil {
 			return fmt.Errorf("unable to initialize '%s' client", serviceName)
 		}
 	}
+
+	clients.sdkClientMapMutex.Lock()
+	clients.configProvider = configProvider
+	clients.configureClient = configureClient
+	clients.clientHostOverrides = getClientHostOverrides()
+	if clients.SdkClientMap == nil {
+		clients.SdkClientMap = make(map[string]interface{})
+	}
+	clients.sdkClientMapMutex.Unlock()
+
 	if common.CheckForEnabledServices(globalvar.WorkRequest) {
 		workRequestClient, err := oci_work_requests.NewWorkRequestClientWithConfigurationProvider(configProvider)
 		if err != nil {
@@ -162,6 +568,10 @@ func CreateSDKClients(clients *OracleClients, configProvider oci_common.Configur
 		if err != nil {
 			return err
 		}
+		if host, ok := clients.EndpointOverrides[globalvar.WorkRequest]; ok {
+			workRequestClient.Host = host
+		}
+		workRequestClient.HTTPClient = &serviceNameDispatcher{serviceName: globalvar.WorkRequest, dispatcher: workRequestClient.HTTPClient}
 		clients.WorkRequestClient = &workRequestClient
 	}
 	return nil
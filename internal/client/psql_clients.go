@@ -30,5 +30,5 @@ func initPsqlPostgresqlClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) PostgresqlClient() *oci_psql.PostgresqlClient {
-	return m.GetClient("oci_psql.PostgresqlClient").(*oci_psql.PostgresqlClient)
+	return m.getClientOrFailing("oci_psql.PostgresqlClient", (*oci_psql.PostgresqlClient)(nil)).(*oci_psql.PostgresqlClient)
 }
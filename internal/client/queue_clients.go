@@ -30,5 +30,5 @@ func initQueueQueueAdminClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) QueueAdminClient() *oci_queue.QueueAdminClient {
-	return m.GetClient("oci_queue.QueueAdminClient").(*oci_queue.QueueAdminClient)
+	return m.getClientOrFailing("oci_queue.QueueAdminClient", (*oci_queue.QueueAdminClient)(nil)).(*oci_queue.QueueAdminClient)
 }
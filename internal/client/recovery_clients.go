@@ -30,5 +30,5 @@ func initRecoveryDatabaseRecoveryClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) DatabaseRecoveryClient() *oci_recovery.DatabaseRecoveryClient {
-	return m.GetClient("oci_recovery.DatabaseRecoveryClient").(*oci_recovery.DatabaseRecoveryClient)
+	return m.getClientOrFailing("oci_recovery.DatabaseRecoveryClient", (*oci_recovery.DatabaseRecoveryClient)(nil)).(*oci_recovery.DatabaseRecoveryClient)
 }
@@ -30,5 +30,5 @@ func initRedisRedisClusterClient(configProvider oci_common.ConfigurationProvider
 }
 
 func (m *OracleClients) RedisClusterClient() *oci_redis.RedisClusterClient {
-	return m.GetClient("oci_redis.RedisClusterClient").(*oci_redis.RedisClusterClient)
+	return m.getClientOrFailing("oci_redis.RedisClusterClient", (*oci_redis.RedisClusterClient)(nil)).(*oci_redis.RedisClusterClient)
 }
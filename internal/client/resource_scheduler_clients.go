@@ -30,5 +30,5 @@ func initResourceschedulerScheduleClient(configProvider oci_common.Configuration
 }
 
 func (m *OracleClients) ScheduleClient() *oci_resource_scheduler.ScheduleClient {
-	return m.GetClient("oci_resource_scheduler.ScheduleClient").(*oci_resource_scheduler.ScheduleClient)
+	return m.getClientOrFailing("oci_resource_scheduler.ScheduleClient", (*oci_resource_scheduler.ScheduleClient)(nil)).(*oci_resource_scheduler.ScheduleClient)
 }
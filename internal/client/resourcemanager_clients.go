@@ -30,5 +30,5 @@ func initResourcemanagerResourceManagerClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) ResourceManagerClient() *oci_resourcemanager.ResourceManagerClient {
-	return m.GetClient("oci_resourcemanager.ResourceManagerClient").(*oci_resourcemanager.ResourceManagerClient)
+	return m.getClientOrFailing("oci_resourcemanager.ResourceManagerClient", (*oci_resourcemanager.ResourceManagerClient)(nil)).(*oci_resourcemanager.ResourceManagerClient)
 }
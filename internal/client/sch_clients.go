@@ -31,7 +31,7 @@ func initSchConnectorPluginsClient(configProvider oci_common.ConfigurationProvid
 }
 
 func (m *OracleClients) ConnectorPluginsClient() *oci_sch.ConnectorPluginsClient {
-	return m.GetClient("oci_sch.ConnectorPluginsClient").(*oci_sch.ConnectorPluginsClient)
+	return m.getClientOrFailing("oci_sch.ConnectorPluginsClient", (*oci_sch.ConnectorPluginsClient)(nil)).(*oci_sch.ConnectorPluginsClient)
 }
 
 func initSchServiceConnectorClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initSchServiceConnectorClient(configProvider oci_common.ConfigurationProvid
 }
 
 func (m *OracleClients) ServiceConnectorClient() *oci_sch.ServiceConnectorClient {
-	return m.GetClient("oci_sch.ServiceConnectorClient").(*oci_sch.ServiceConnectorClient)
+	return m.getClientOrFailing("oci_sch.ServiceConnectorClient", (*oci_sch.ServiceConnectorClient)(nil)).(*oci_sch.ServiceConnectorClient)
 }
@@ -30,5 +30,5 @@ func initSecretsSecretsClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) SecretsClient() *oci_secrets.SecretsClient {
-	return m.GetClient("oci_secrets.SecretsClient").(*oci_secrets.SecretsClient)
+	return m.getClientOrFailing("oci_secrets.SecretsClient", (*oci_secrets.SecretsClient)(nil)).(*oci_secrets.SecretsClient)
 }
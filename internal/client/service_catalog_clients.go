@@ -30,5 +30,5 @@ func initServicecatalogServiceCatalogClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) ServiceCatalogClient() *oci_service_catalog.ServiceCatalogClient {
-	return m.GetClient("oci_service_catalog.ServiceCatalogClient").(*oci_service_catalog.ServiceCatalogClient)
+	return m.getClientOrFailing("oci_service_catalog.ServiceCatalogClient", (*oci_service_catalog.ServiceCatalogClient)(nil)).(*oci_service_catalog.ServiceCatalogClient)
 }
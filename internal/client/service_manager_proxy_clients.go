@@ -30,5 +30,5 @@ func initServicemanagerproxyServiceManagerProxyClient(configProvider oci_common.
 }
 
 func (m *OracleClients) ServiceManagerProxyClient() *oci_service_manager_proxy.ServiceManagerProxyClient {
-	return m.GetClient("oci_service_manager_proxy.ServiceManagerProxyClient").(*oci_service_manager_proxy.ServiceManagerProxyClient)
+	return m.getClientOrFailing("oci_service_manager_proxy.ServiceManagerProxyClient", (*oci_service_manager_proxy.ServiceManagerProxyClient)(nil)).(*oci_service_manager_proxy.ServiceManagerProxyClient)
 }
@@ -30,5 +30,5 @@ func initServicemeshServiceMeshClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) ServiceMeshClient() *oci_service_mesh.ServiceMeshClient {
-	return m.GetClient("oci_service_mesh.ServiceMeshClient").(*oci_service_mesh.ServiceMeshClient)
+	return m.getClientOrFailing("oci_service_mesh.ServiceMeshClient", (*oci_service_mesh.ServiceMeshClient)(nil)).(*oci_service_mesh.ServiceMeshClient)
 }
@@ -30,5 +30,5 @@ func initStackmonitoringStackMonitoringClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) StackMonitoringClient() *oci_stack_monitoring.StackMonitoringClient {
-	return m.GetClient("oci_stack_monitoring.StackMonitoringClient").(*oci_stack_monitoring.StackMonitoringClient)
+	return m.getClientOrFailing("oci_stack_monitoring.StackMonitoringClient", (*oci_stack_monitoring.StackMonitoringClient)(nil)).(*oci_stack_monitoring.StackMonitoringClient)
 }
@@ -30,5 +30,5 @@ func initStreamingStreamAdminClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) StreamAdminClient() *oci_streaming.StreamAdminClient {
-	return m.GetClient("oci_streaming.StreamAdminClient").(*oci_streaming.StreamAdminClient)
+	return m.getClientOrFailing("oci_streaming.StreamAdminClient", (*oci_streaming.StreamAdminClient)(nil)).(*oci_streaming.StreamAdminClient)
 }
@@ -32,7 +32,7 @@ func initUsageResourcesClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) ResourcesClient() *oci_usage_proxy.ResourcesClient {
-	return m.GetClient("oci_usage_proxy.ResourcesClient").(*oci_usage_proxy.ResourcesClient)
+	return m.getClientOrFailing("oci_usage_proxy.ResourcesClient", (*oci_usage_proxy.ResourcesClient)(nil)).(*oci_usage_proxy.ResourcesClient)
 }
 
 func initUsageRewardsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -52,7 +52,7 @@ func initUsageRewardsClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) RewardsClient() *oci_usage_proxy.RewardsClient {
-	return m.GetClient("oci_usage_proxy.RewardsClient").(*oci_usage_proxy.RewardsClient)
+	return m.getClientOrFailing("oci_usage_proxy.RewardsClient", (*oci_usage_proxy.RewardsClient)(nil)).(*oci_usage_proxy.RewardsClient)
 }
 
 func initUsageUsagelimitsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -72,5 +72,5 @@ func initUsageUsagelimitsClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) UsagelimitsClient() *oci_usage_proxy.UsagelimitsClient {
-	return m.GetClient("oci_usage_proxy.UsagelimitsClient").(*oci_usage_proxy.UsagelimitsClient)
+	return m.getClientOrFailing("oci_usage_proxy.UsagelimitsClient", (*oci_usage_proxy.UsagelimitsClient)(nil)).(*oci_usage_proxy.UsagelimitsClient)
 }
@@ -30,5 +30,5 @@ func initVaultVaultsClient(configProvider oci_common.ConfigurationProvider, conf
 }
 
 func (m *OracleClients) VaultsClient() *oci_vault.VaultsClient {
-	return m.GetClient("oci_vault.VaultsClient").(*oci_vault.VaultsClient)
+	return m.getClientOrFailing("oci_vault.VaultsClient", (*oci_vault.VaultsClient)(nil)).(*oci_vault.VaultsClient)
 }
@@ -30,5 +30,5 @@ func initVbsinstVbsInstanceClient(configProvider oci_common.ConfigurationProvide
 }
 
 func (m *OracleClients) VbsInstanceClient() *oci_vbs_inst.VbsInstanceClient {
-	return m.GetClient("oci_vbs_inst.VbsInstanceClient").(*oci_vbs_inst.VbsInstanceClient)
+	return m.getClientOrFailing("oci_vbs_inst.VbsInstanceClient", (*oci_vbs_inst.VbsInstanceClient)(nil)).(*oci_vbs_inst.VbsInstanceClient)
 }
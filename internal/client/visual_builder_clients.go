@@ -30,5 +30,5 @@ func initVisualbuilderVbInstanceClient(configProvider oci_common.ConfigurationPr
 }
 
 func (m *OracleClients) VbInstanceClient() *oci_visual_builder.VbInstanceClient {
-	return m.GetClient("oci_visual_builder.VbInstanceClient").(*oci_visual_builder.VbInstanceClient)
+	return m.getClientOrFailing("oci_visual_builder.VbInstanceClient", (*oci_visual_builder.VbInstanceClient)(nil)).(*oci_visual_builder.VbInstanceClient)
 }
@@ -30,5 +30,5 @@ func initVnmonitoringVnMonitoringClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) VnMonitoringClient() *oci_vn_monitoring.VnMonitoringClient {
-	return m.GetClient("oci_vn_monitoring.VnMonitoringClient").(*oci_vn_monitoring.VnMonitoringClient)
+	return m.getClientOrFailing("oci_vn_monitoring.VnMonitoringClient", (*oci_vn_monitoring.VnMonitoringClient)(nil)).(*oci_vn_monitoring.VnMonitoringClient)
 }
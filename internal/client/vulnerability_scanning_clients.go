@@ -30,5 +30,5 @@ func initVulnerabilityscanningVulnerabilityScanningClient(configProvider oci_com
 }
 
 func (m *OracleClients) VulnerabilityScanningClient() *oci_vulnerability_scanning.VulnerabilityScanningClient {
-	return m.GetClient("oci_vulnerability_scanning.VulnerabilityScanningClient").(*oci_vulnerability_scanning.VulnerabilityScanningClient)
+	return m.getClientOrFailing("oci_vulnerability_scanning.VulnerabilityScanningClient", (*oci_vulnerability_scanning.VulnerabilityScanningClient)(nil)).(*oci_vulnerability_scanning.VulnerabilityScanningClient)
 }
@@ -31,7 +31,7 @@ func initWaaWaaClient(configProvider oci_common.ConfigurationProvider, configure
 }
 
 func (m *OracleClients) WaaClient() *oci_waa.WaaClient {
-	return m.GetClient("oci_waa.WaaClient").(*oci_waa.WaaClient)
+	return m.getClientOrFailing("oci_waa.WaaClient", (*oci_waa.WaaClient)(nil)).(*oci_waa.WaaClient)
 }
 
 func initWaaWorkRequestClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initWaaWorkRequestClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) WaaWorkRequestClient() *oci_waa.WorkRequestClient {
-	return m.GetClient("oci_waa.WorkRequestClient").(*oci_waa.WorkRequestClient)
+	return m.getClientOrFailing("oci_waa.WorkRequestClient", (*oci_waa.WorkRequestClient)(nil)).(*oci_waa.WorkRequestClient)
 }
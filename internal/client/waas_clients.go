@@ -31,7 +31,7 @@ func initWaasRedirectClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) RedirectClient() *oci_waas.RedirectClient {
-	return m.GetClient("oci_waas.RedirectClient").(*oci_waas.RedirectClient)
+	return m.getClientOrFailing("oci_waas.RedirectClient", (*oci_waas.RedirectClient)(nil)).(*oci_waas.RedirectClient)
 }
 
 func initWaasWaasClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +51,5 @@ func initWaasWaasClient(configProvider oci_common.ConfigurationProvider, configu
 }
 
 func (m *OracleClients) WaasClient() *oci_waas.WaasClient {
-	return m.GetClient("oci_waas.WaasClient").(*oci_waas.WaasClient)
+	return m.getClientOrFailing("oci_waas.WaasClient", (*oci_waas.WaasClient)(nil)).(*oci_waas.WaasClient)
 }
@@ -30,5 +30,5 @@ func initWafWafClient(configProvider oci_common.ConfigurationProvider, configure
 }
 
 func (m *OracleClients) WafClient() *oci_waf.WafClient {
-	return m.GetClient("oci_waf.WafClient").(*oci_waf.WafClient)
+	return m.getClientOrFailing("oci_waf.WafClient", (*oci_waf.WafClient)(nil)).(*oci_waf.WafClient)
 }
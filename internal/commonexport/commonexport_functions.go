@@ -325,6 +325,79 @@ func (args *ExportCommandArgs) Validate() error {
 		return fmt.Errorf("[ERROR] invalid value for arument parallelism, specify a value >= 1")
 	}
 
+	switch args.ImportMode {
+	case "", ImportModeState:
+	case ImportModeBlocks:
+		if args.GenerateState {
+			return fmt.Errorf("[ERROR] generate_state can not be set with import_mode '%s', the resources are imported by Terraform from the import blocks", ImportModeBlocks)
+		}
+		if args.TFVersion != nil && *args.TFVersion != nil && (*args.TFVersion).ToString() == string(TfVersion11) {
+			return fmt.Errorf("[ERROR] import_mode '%s' is not supported with tf_version %s", ImportModeBlocks, TfVersion11)
+		}
+	default:
+		return fmt.Errorf("[ERROR] invalid value for argument import_mode '%s', supported values: %s, %s", args.ImportMode, ImportModeState, ImportModeBlocks)
+	}
+
+	if args.BaselinePath != "" {
+		if _, err := os.Stat(args.BaselinePath); err != nil {
+			return fmt.Errorf("[ERROR] baseline_path does not exist: %s", err)
+		}
+	}
+
+	if args.BaselineNewResourcesOnly {
+		if args.BaselinePath == "" {
+			return fmt.Errorf("[ERROR] baseline_new_resources_only requires a baseline_path")
+		}
+		if args.GenerateState {
+			return fmt.Errorf("[ERROR] generate_state can not be set with baseline_new_resources_only, as the state would have the resources left out of the configuration")
+		}
+	}
+
+	switch args.OutputLayout {
+	case "", OutputLayoutFlat:
+		if args.ModuleGrouping != "" && args.ModuleGrouping != ModuleGroupingService {
+			return fmt.Errorf("[ERROR] module_grouping requires output_layout '%s'", OutputLayoutModules)
+		}
+	case OutputLayoutModules:
+		if args.GenerateState {
+			return fmt.Errorf("[ERROR] generate_state can not be set with output_layout '%s', use import_mode '%s' to import the resources into the modules", OutputLayoutModules, ImportModeBlocks)
+		}
+		if args.TFVersion != nil && *args.TFVersion != nil && (*args.TFVersion).ToString() == string(TfVersion11) {
+			return fmt.Errorf("[ERROR] output_layout '%s' is not supported with tf_version %s", OutputLayoutModules, TfVersion11)
+		}
+		switch args.ModuleGrouping {
+		case "", ModuleGroupingService, ModuleGroupingCompartment:
+		default:
+			return fmt.Errorf("[ERROR] invalid value for argument module_grouping '%s', supported values: %s, %s", args.ModuleGrouping, ModuleGroupingService, ModuleGroupingCompartment)
+		}
+	default:
+		return fmt.Errorf("[ERROR] invalid value for argument output_layout '%s', supported values: %s, %s", args.OutputLayout, OutputLayoutFlat, OutputLayoutModules)
+	}
+
+	switch args.OutputFormat {
+	case "", OutputFormatHcl, OutputFormatJson, OutputFormatYaml:
+	default:
+		return fmt.Errorf("[ERROR] invalid value for argument output_format '%s', supported values: %s, %s, %s", args.OutputFormat, OutputFormatHcl, OutputFormatJson, OutputFormatYaml)
+	}
+
+	if args.Recursive {
+		if len(args.IDs) > 0 {
+			return fmt.Errorf("[ERROR] recursive can not be set with ids, it exports compartments")
+		}
+		if args.GenerateState {
+			return fmt.Errorf("[ERROR] generate_state can not be set with recursive, use import_mode '%s' to import the resources of each compartment", ImportModeBlocks)
+		}
+		if args.OutputLayout == OutputLayoutModules {
+			return fmt.Errorf("[ERROR] recursive can not be set with output_layout '%s'", OutputLayoutModules)
+		}
+		if args.BaselinePath != "" {
+			return fmt.Errorf("[ERROR] recursive can not be set with baseline_path")
+		}
+		if args.TFVersion != nil && *args.TFVersion != nil && (*args.TFVersion).ToString() == string(TfVersion11) {
+			return fmt.Errorf("[ERROR] recursive is not supported with tf_version %s", TfVersion11)
+		}
+	}
+
 	// validate and extract variables_resource_level
 	if args.VarsExportResourceLevel != nil {
 		VarsExportForResourceLevel, err = extractVarsExportResourceLevel(args.VarsExportResourceLevel)
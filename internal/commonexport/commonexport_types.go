@@ -131,7 +131,57 @@ type ExportCommandArgs struct {
 	VarsExportResourceLevel      []string
 	VarExportGlobalLevel         []string
 	Filters                      []ResourceFilter
+	ImportMode                   ImportModeEnum
+	BaselinePath                 string
+	BaselineNewResourcesOnly     bool
+	OutputLayout                 OutputLayoutEnum
+	ModuleGrouping               ModuleGroupingEnum
+	Recursive                    bool
+	OutputFormat                 OutputFormatEnum
 }
+
+// ImportModeEnum is how the discovered resources are imported when exported
+type ImportModeEnum string
+
+const (
+	// ImportModeState imports the resources into a state file with `terraform import` when GenerateState is set
+	ImportModeState ImportModeEnum = "state"
+	// ImportModeBlocks writes an `import` block per resource, for Terraform v1.5+ to import them on plan and apply
+	ImportModeBlocks ImportModeEnum = "blocks"
+)
+
+// OutputLayoutEnum is how the generated configuration is laid out under the output directory
+type OutputLayoutEnum string
+
+const (
+	// OutputLayoutFlat writes a `<service>.tf` file per service in the output directory
+	OutputLayoutFlat OutputLayoutEnum = "flat"
+	// OutputLayoutModules writes a child module per ModuleGroupingEnum under `modules/`, and a root module calling them
+	OutputLayoutModules OutputLayoutEnum = "modules"
+)
+
+// OutputFormatEnum is the format the discovered resources are written in, along with their configuration
+type OutputFormatEnum string
+
+const (
+	// OutputFormatHcl only writes the Terraform configuration of the resources
+	OutputFormatHcl OutputFormatEnum = "hcl"
+	// OutputFormatJson also writes an inventory of the resources, their parent/child edges and references as JSON
+	OutputFormatJson OutputFormatEnum = "json"
+	// OutputFormatYaml also writes the inventory of OutputFormatJson as YAML
+	OutputFormatYaml OutputFormatEnum = "yaml"
+)
+
+// ModuleGroupingEnum is how the resources are grouped into child modules with OutputLayoutModules
+type ModuleGroupingEnum string
+
+const (
+	// ModuleGroupingService writes a child module per service
+	ModuleGroupingService ModuleGroupingEnum = "service"
+	// ModuleGroupingCompartment writes a child module per compartment of the resources
+	ModuleGroupingCompartment ModuleGroupingEnum = "compartment"
+)
+
 type ErrorList struct {
 	Errors []*ResourceDiscoveryError
 }
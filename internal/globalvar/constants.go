@@ -10,6 +10,7 @@ const (
 	AuthInstancePrincipalWithCertsSetting = "InstancePrincipalWithCerts"
 	AuthSecurityToken                     = "SecurityToken"
 	AuthOKEWorkloadIdentity               = "OKEWorkloadIdentity"
+	AuthExternalProcess                   = "ExternalProcess"
 	ResourcePrincipal                     = "ResourcePrincipal"
 	RequestHeaderOpcOboToken              = "opc-obo-token"
 	RequestHeaderOpcHostSerial            = "opc-host-serial"
@@ -28,6 +29,8 @@ const (
 	ClientHostOverridesEnv                = "CLIENT_HOST_OVERRIDES"
 	CustomCertLocationEnv                 = "custom_cert_location"
 	AcceptLocalCerts                      = "accept_local_certs"
+	RateLimitsEnv                         = "RATE_LIMITS"
+	ConcurrencyLimitsEnv                  = "CONCURRENCY_LIMITS"
 	JobOCID                               = "job-ocid"
 
 	AuthAttrName                                = "auth"
@@ -45,7 +48,19 @@ const (
 	OboTokenPath                                = "obo_token_path"
 	ConfigFileProfileAttrName                   = "config_file_profile"
 	DefinedTagsToIgnore                         = "ignore_defined_tags"
+	DefaultFreeformTagsAttrName                 = "default_freeform_tags"
+	DefaultDefinedTagsAttrName                  = "default_defined_tags"
 	RealmSpecificServiceEndpointTemplateEnabled = "realm_specific_service_endpoint_template_enabled"
+	AdditionalRegionsAttrName                   = "additional_regions"
+	EndpointsAttrName                           = "endpoints"
+	WorkRequestFailureRetriesAttrName           = "work_request_failure_retries"
+	RateLimitsAttrName                          = "rate_limits"
+	ConcurrencyLimitsAttrName                   = "concurrency_limits"
+	CredentialProcessAttrName                   = "credential_process"
+	SessionTokenRefreshCommandAttrName          = "session_token_refresh_command"
+	RetryAttrName                               = "retry"
+	IfMatchETagsEnabledAttrName                 = "if_match_etags_enabled"
+	DefaultTimeoutsAttrName                     = "default_timeouts"
 
 	DefaultConfigFileName    = "config"
 	DefaultConfigDirName     = ".oci"
@@ -59,6 +74,15 @@ const (
 	DefaultStateFilename            = "terraform.tfstate"
 	VarsFile                        = "vars.tf"
 	ProviderFile                    = "provider.tf"
+	ImportsFile                     = "imports.tf"
+	BaselineDiffFile                = "baseline_diff.json"
+	ModulesFile                     = "main.tf"
+	ModulesDir                      = "modules"
+	ModuleVariablesFile             = "variables.tf"
+	ModuleOutputsFile               = "outputs.tf"
+	RemoteStateFile                 = "remote_state.tf"
+	InventoryJsonFile               = "inventory.json"
+	InventoryYamlFile               = "inventory.yaml"
 	MissingRequiredAttributeWarning = `
 
 	
@@ -71,7 +95,12 @@ These missing attributes are also added to the lifecycle ignore_changes.
 `
 	PlaceholderValueForMissingAttribute = `<placeholder for missing required attribute>`
 	EnvLogFile                          = "TF_LOG_PATH"
-	EnvOCITFLogFile                     = "OCI_TF_LOG_PATH" // Log path for Custom TF logger - TFProviderLogger
+	EnvOCITFLogFile                     = "OCI_TF_LOG_PATH"   // Log path for Custom TF logger - TFProviderLogger
+	EnvOCITFLogFormat                   = "OCI_TF_LOG_FORMAT" // Set to "json" to log SDK calls as JSON lines to OCI_TF_LOG_PATH
+	StructuredLogFormatJson             = "json"
+	EnvOCITFTracePath                   = "OCI_TF_TRACE_PATH"       // File to which traces are appended as OTLP/JSON lines
+	EnvOCITFTraceEndpoint               = "OCI_TF_TRACE_ENDPOINT"   // OTLP/HTTP collector endpoint to which traces are sent as JSON
+	EnvOCITFSuggestionsPath             = "OCI_TF_SUGGESTIONS_PATH" // YAML file of suggestions for the errors, taking precedence over the built-in ones
 	TerraformBinPathName                = "terraform_bin_path"
 	MaxInt64                            = 1<<63 - 1 // TODO : Fix needed for GoLang SDK v1.17.2
 	DiscoverAllStatesEnv                = "TF_DISCOVER_ALL_STATES"
@@ -0,0 +1,177 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+const (
+	commandTimeout = 1 * time.Minute
+	// Credentials are refreshed this long before they expire, so that requests in flight are not signed with expired credentials
+	credentialProcessRefreshWindow = 1 * time.Minute
+)
+
+var runCommandVar = runCommand
+
+// externalProcessCredentials is the JSON printed by the credential process
+type externalProcessCredentials struct {
+	Tenancy       string     `json:"tenancy"`
+	User          string     `json:"user"`
+	Fingerprint   string     `json:"fingerprint"`
+	Key           string     `json:"key"`
+	KeyPassphrase string     `json:"key_passphrase"`
+	SecurityToken string     `json:"security_token"`
+	Region        string     `json:"region"`
+	Expiration    *time.Time `json:"expiration"`
+
+	privateKey *rsa.PrivateKey
+}
+
+// externalProcessConfigurationProvider is a ConfigurationProvider that gets the credentials from an external command,
+// so that private keys do not need to be written to disk. The credentials are cached until they expire.
+type externalProcessConfigurationProvider struct {
+	command string
+	now     func() time.Time
+
+	mutex  sync.Mutex
+	cached *externalProcessCredentials
+}
+
+func newExternalProcessConfigurationProvider(command string) *externalProcessConfigurationProvider {
+	return &externalProcessConfigurationProvider{command: command, now: time.Now}
+}
+
+func (p *externalProcessConfigurationProvider) credentials() (*externalProcessCredentials, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.cached != nil && (p.cached.Expiration == nil || p.now().Before(p.cached.Expiration.Add(-credentialProcessRefreshWindow))) {
+		return p.cached, nil
+	}
+
+	output, err := runCommandVar(p.command)
+	if err != nil {
+		return nil, fmt.Errorf("%s command failed: %v", globalvar.CredentialProcessAttrName, err)
+	}
+
+	credentials := &externalProcessCredentials{}
+	if err := json.Unmarshal(output, credentials); err != nil {
+		return nil, fmt.Errorf("%s command returned invalid JSON: %v", globalvar.CredentialProcessAttrName, err)
+	}
+	if credentials.Tenancy == "" || credentials.Fingerprint == "" || credentials.Key == "" {
+		return nil, fmt.Errorf("%s command must return the tenancy, fingerprint and key", globalvar.CredentialProcessAttrName)
+	}
+	if credentials.User == "" && credentials.SecurityToken == "" {
+		return nil, fmt.Errorf("%s command must return either the user or a security token", globalvar.CredentialProcessAttrName)
+	}
+
+	var passphrase *string
+	if credentials.KeyPassphrase != "" {
+		passphrase = &credentials.KeyPassphrase
+	}
+	if credentials.privateKey, err = oci_common.PrivateKeyFromBytes([]byte(credentials.Key), passphrase); err != nil {
+		return nil, fmt.Errorf("%s command returned an invalid key: %v", globalvar.CredentialProcessAttrName, err)
+	}
+
+	p.cached = credentials
+	return credentials, nil
+}
+
+func (p *externalProcessConfigurationProvider) TenancyOCID() (string, error) {
+	credentials, err := p.credentials()
+	if err != nil {
+		return "", err
+	}
+	return credentials.Tenancy, nil
+}
+
+func (p *externalProcessConfigurationProvider) UserOCID() (string, error) {
+	credentials, err := p.credentials()
+	if err != nil {
+		return "", err
+	}
+	if credentials.User == "" {
+		return "", fmt.Errorf("%s command did not return the user", globalvar.CredentialProcessAttrName)
+	}
+	return credentials.User, nil
+}
+
+func (p *externalProcessConfigurationProvider) KeyFingerprint() (string, error) {
+	credentials, err := p.credentials()
+	if err != nil {
+		return "", err
+	}
+	return credentials.Fingerprint, nil
+}
+
+func (p *externalProcessConfigurationProvider) Region() (string, error) {
+	credentials, err := p.credentials()
+	if err != nil {
+		return "", err
+	}
+	if credentials.Region == "" {
+		return "", fmt.Errorf("%s command did not return the region", globalvar.CredentialProcessAttrName)
+	}
+	return credentials.Region, nil
+}
+
+func (p *externalProcessConfigurationProvider) KeyID() (string, error) {
+	credentials, err := p.credentials()
+	if err != nil {
+		return "", err
+	}
+	if credentials.SecurityToken != "" {
+		return "ST$" + credentials.SecurityToken, nil
+	}
+	return fmt.Sprintf("%s/%s/%s", credentials.Tenancy, credentials.User, credentials.Fingerprint), nil
+}
+
+func (p *externalProcessConfigurationProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	credentials, err := p.credentials()
+	if err != nil {
+		return nil, err
+	}
+	return credentials.privateKey, nil
+}
+
+func (p *externalProcessConfigurationProvider) AuthType() (oci_common.AuthConfig, error) {
+	return oci_common.AuthConfig{AuthType: oci_common.UnknownAuthenticationType, IsFromConfigFile: false, OboToken: nil}, nil
+}
+
+// runCommand runs a command configured in the provider block with the shell, and returns what it printed
+func runCommand(command string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("%v: %s", err, message)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
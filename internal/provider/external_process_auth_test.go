@@ -0,0 +1,142 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitExternalProcessConfigurationProvider(t *testing.T) {
+	privateKeyPem := getTestPrivateKeyPem(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiration := now.Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		output      map[string]interface{}
+		commandErr  error
+		wantKeyId   string
+		wantErr     string
+		wantRegion  string
+		wantUserErr bool
+	}{
+		{
+			name: "Test api key credentials",
+			output: map[string]interface{}{
+				"tenancy": testTenancyOCID, "user": testUserOCID, "fingerprint": testKeyFingerPrint, "key": privateKeyPem, "region": "us-phoenix-1",
+			},
+			wantKeyId:  testTenancyOCID + "/" + testUserOCID + "/" + testKeyFingerPrint,
+			wantRegion: "us-phoenix-1",
+		},
+		{
+			name: "Test security token credentials",
+			output: map[string]interface{}{
+				"tenancy": testTenancyOCID, "fingerprint": testKeyFingerPrint, "key": privateKeyPem, "security_token": "token", "expiration": expiration,
+			},
+			wantKeyId:   "ST$token",
+			wantUserErr: true,
+		},
+		{
+			name:    "Test missing key",
+			output:  map[string]interface{}{"tenancy": testTenancyOCID, "user": testUserOCID, "fingerprint": testKeyFingerPrint},
+			wantErr: "credential_process command must return the tenancy, fingerprint and key",
+		},
+		{
+			name:    "Test missing user and security token",
+			output:  map[string]interface{}{"tenancy": testTenancyOCID, "fingerprint": testKeyFingerPrint, "key": privateKeyPem},
+			wantErr: "credential_process command must return either the user or a security token",
+		},
+		{
+			name:    "Test invalid key",
+			output:  map[string]interface{}{"tenancy": testTenancyOCID, "user": testUserOCID, "fingerprint": testKeyFingerPrint, "key": "not a key"},
+			wantErr: "credential_process command returned an invalid key",
+		},
+		{
+			name:       "Test command failure",
+			commandErr: errors.New("exit status 1: broker unavailable"),
+			wantErr:    "credential_process command failed: exit status 1: broker unavailable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runCommandVar = func(command string) ([]byte, error) {
+				assert.Equal(t, "get-oci-credentials", command)
+				if tt.commandErr != nil {
+					return nil, tt.commandErr
+				}
+				return json.Marshal(tt.output)
+			}
+			defer func() { runCommandVar = runCommand }()
+
+			provider := newExternalProcessConfigurationProvider("get-oci-credentials")
+			provider.now = func() time.Time { return now }
+
+			keyId, err := provider.KeyID()
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantKeyId, keyId)
+
+			privateKey, err := provider.PrivateRSAKey()
+			assert.NoError(t, err)
+			assert.NotNil(t, privateKey)
+
+			region, err := provider.Region()
+			assert.Equal(t, tt.wantRegion, region)
+			assert.Equal(t, tt.wantRegion == "", err != nil)
+
+			_, err = provider.UserOCID()
+			assert.Equal(t, tt.wantUserErr, err != nil)
+		})
+	}
+}
+
+func TestUnitExternalProcessConfigurationProvider_refresh(t *testing.T) {
+	privateKeyPem := getTestPrivateKeyPem(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	runs := 0
+	runCommandVar = func(command string) ([]byte, error) {
+		runs++
+		return json.Marshal(map[string]interface{}{
+			"tenancy": testTenancyOCID, "fingerprint": testKeyFingerPrint, "key": privateKeyPem,
+			"security_token": "token" + string(rune('0'+runs)), "expiration": now.Add(time.Hour),
+		})
+	}
+	defer func() { runCommandVar = runCommand }()
+
+	provider := newExternalProcessConfigurationProvider("get-oci-credentials")
+	provider.now = func() time.Time { return now }
+
+	keyId, _ := provider.KeyID()
+	assert.Equal(t, "ST$token1", keyId)
+
+	// The credentials are cached until shortly before they expire
+	provider.now = func() time.Time { return now.Add(30 * time.Minute) }
+	keyId, _ = provider.KeyID()
+	assert.Equal(t, "ST$token1", keyId)
+	assert.Equal(t, 1, runs)
+
+	provider.now = func() time.Time { return now.Add(59*time.Minute + 30*time.Second) }
+	keyId, _ = provider.KeyID()
+	assert.Equal(t, "ST$token2", keyId)
+	assert.Equal(t, 2, runs)
+}
+
+func TestUnitRunCommand(t *testing.T) {
+	output, err := runCommand(`echo '{"tenancy": "ocid1.tenancy.oc1..test"}'`)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"tenancy": "ocid1.tenancy.oc1..test"}`, string(output))
+
+	_, err = runCommand("echo broker unavailable >&2; exit 3")
+	assert.EqualError(t, err, "exit status 3: broker unavailable")
+}
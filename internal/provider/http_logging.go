@@ -0,0 +1,103 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+// Bodies larger than this, or that are not JSON, are not logged
+const maxLoggedBodySize = 64 * 1024
+
+// structuredLoggingDispatcher is an HTTPRequestDispatcher that logs every SDK call as a JSON line, along with the
+// resource operation it was made for
+type structuredLoggingDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	logBodies  bool
+}
+
+func (d *structuredLoggingDispatcher) Do(r *http.Request) (*http.Response, error) {
+	entry := utils.StructuredLogEntry{
+		Method: r.Method,
+		Path:   r.URL.Path,
+	}
+	if logContext := utils.LogContextFrom(r.Context()); logContext != nil {
+		entry.ResourceType = logContext.ResourceType
+		entry.Phase = logContext.Phase
+		entry.ResourceId = logContext.ResourceId()
+	}
+	if d.logBodies {
+		entry.RequestHeaders = utils.RedactHeaders(r.Header)
+		entry.RequestBody = readRequestBodyForLogging(r)
+	}
+
+	start := time.Now()
+	response, err := d.dispatcher.Do(r)
+	entry.LatencyMs = time.Since(start).Milliseconds()
+
+	if err != nil {
+		entry.Level = "ERROR"
+		entry.Error = err.Error()
+	}
+	if response != nil {
+		entry.Status = response.StatusCode
+		entry.OpcRequestId = response.Header.Get("opc-request-id")
+		if response.StatusCode >= http.StatusBadRequest {
+			entry.Level = "ERROR"
+		}
+		if d.logBodies {
+			entry.ResponseHeaders = utils.RedactHeaders(response.Header)
+			entry.ResponseBody = readResponseBodyForLogging(response)
+		}
+	}
+	if entry.OpcRequestId == "" {
+		entry.OpcRequestId = r.Header.Get("opc-request-id")
+	}
+
+	utils.LogStructured(entry)
+	return response, err
+}
+
+func readRequestBodyForLogging(r *http.Request) interface{} {
+	if r.Body == nil || r.GetBody == nil || !isLoggableBody(r.Header, r.ContentLength) {
+		return nil
+	}
+	body, err := r.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	content, err := ioutil.ReadAll(io.LimitReader(body, maxLoggedBodySize))
+	if err != nil {
+		return nil
+	}
+	return utils.RedactJsonBody(content)
+}
+
+func readResponseBodyForLogging(response *http.Response) interface{} {
+	if response.Body == nil || !isLoggableBody(response.Header, response.ContentLength) {
+		return nil
+	}
+	content, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	// The body is put back so that the SDK can still read it
+	response.Body = ioutil.NopCloser(bytes.NewReader(content))
+	if err != nil {
+		return nil
+	}
+	return utils.RedactJsonBody(content)
+}
+
+func isLoggableBody(header http.Header, contentLength int64) bool {
+	return contentLength >= 0 && contentLength <= maxLoggedBodySize && strings.Contains(header.Get("Content-Type"), "json")
+}
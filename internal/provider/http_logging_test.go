@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+type mockResponseDispatcher struct {
+	response *http.Response
+}
+
+func (d *mockResponseDispatcher) Do(r *http.Request) (*http.Response, error) {
+	return d.response, nil
+}
+
+func TestUnitStructuredLoggingDispatcher(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "oci_tf.log")
+	os.Setenv(globalvar.EnvOCITFLogFile, logPath)
+	defer os.Unsetenv(globalvar.EnvOCITFLogFile)
+
+	responseBody := `{"id":"ocid1.vcn.oc1..vcn","adminPassword":"secret"}`
+	dispatcher := &structuredLoggingDispatcher{
+		dispatcher: &mockResponseDispatcher{response: &http.Response{
+			StatusCode:    http.StatusOK,
+			Header:        http.Header{"Opc-Request-Id": {"request-id"}, "Content-Type": {"application/json"}},
+			Body:          ioutil.NopCloser(strings.NewReader(responseBody)),
+			ContentLength: int64(len(responseBody)),
+		}},
+		logBodies: true,
+	}
+
+	ctx := utils.WithLogContext(context.Background(), &utils.LogContext{ResourceType: "oci_core_vcn", Phase: "create"})
+	request, _ := http.NewRequestWithContext(ctx, http.MethodPost, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", bytes.NewBufferString(`{"displayName":"vcn"}`))
+	request.Header.Set("Authorization", "Signature")
+	request.Header.Set("Content-Type", "application/json")
+	response, err := dispatcher.Do(request)
+	assert.NoError(t, err)
+
+	// The response body can still be read by the SDK
+	body, err := ioutil.ReadAll(response.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, responseBody, string(body))
+
+	content, err := ioutil.ReadFile(logPath)
+	assert.NoError(t, err)
+	var entry utils.StructuredLogEntry
+	assert.NoError(t, json.Unmarshal(bytes.TrimSpace(content), &entry))
+	assert.Equal(t, "oci_core_vcn", entry.ResourceType)
+	assert.Equal(t, "create", entry.Phase)
+	assert.Equal(t, http.MethodPost, entry.Method)
+	assert.Equal(t, "/20160918/vcns", entry.Path)
+	assert.Equal(t, http.StatusOK, entry.Status)
+	assert.Equal(t, "request-id", entry.OpcRequestId)
+	assert.Equal(t, "<redacted>", entry.RequestHeaders["Authorization"])
+	assert.Equal(t, map[string]interface{}{"displayName": "vcn"}, entry.RequestBody)
+	assert.Equal(t, map[string]interface{}{"id": "ocid1.vcn.oc1..vcn", "adminPassword": "<redacted>"}, entry.ResponseBody)
+}
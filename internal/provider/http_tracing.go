@@ -0,0 +1,40 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+// tracingDispatcher is an HTTPRequestDispatcher that records every SDK call as a span, child of the span carried by the
+// context of the request, i.e. the span of the resource operation or of the CRUD helper it was made for
+type tracingDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+}
+
+func (d *tracingDispatcher) Do(r *http.Request) (*http.Response, error) {
+	_, span := utils.StartSpan(r.Context(), fmt.Sprintf("%s %s", r.Method, r.URL.Path), utils.SpanKindClient)
+	span.SetAttribute("http.method", r.Method)
+	span.SetAttribute("url.path", r.URL.Path)
+	span.SetAttribute("server.address", r.URL.Host)
+	span.SetAttribute("oci.service", tf_client.ServiceNameFromContext(r.Context()))
+
+	response, err := d.dispatcher.Do(r)
+	if response != nil {
+		span.SetAttribute("http.status_code", response.StatusCode)
+		span.SetAttribute("oci.opc_request_id", response.Header.Get("opc-request-id"))
+		if err == nil && response.StatusCode >= http.StatusBadRequest {
+			span.End(fmt.Errorf("%s", response.Status))
+			return response, err
+		}
+	}
+	span.End(err)
+	return response, err
+}
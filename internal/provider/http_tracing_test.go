@@ -0,0 +1,58 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/stretchr/testify/assert"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+func TestUnitTracingDispatcher(t *testing.T) {
+	exporter := &utils.InMemorySpanExporter{}
+	utils.SetSpanExporter(exporter)
+	defer utils.SetSpanExporter(nil)
+
+	configureClient := func(client *oci_common.BaseClient) error {
+		client.HTTPClient = &tracingDispatcher{dispatcher: &mockDispatcher{}}
+		return nil
+	}
+	clients := &tf_client.OracleClients{
+		SdkClientMap:  make(map[string]interface{}),
+		Configuration: make(map[string]string),
+	}
+	assert.NoError(t, tf_client.CreateSDKClients(clients, getTestRawConfigurationProvider(t, "us-phoenix-1"), configureClient))
+	dispatcher := clients.VirtualNetworkClient().HTTPClient
+	request, _ := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+	_, err := dispatcher.Do(request)
+	assert.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "GET /20160918/vcns", spans[0].Name)
+		assert.Equal(t, utils.SpanKindClient, spans[0].Kind)
+		assert.Equal(t, "", spans[0].ParentSpanId)
+		assert.Equal(t, "core", spans[0].Attributes["oci.service"])
+		assert.Equal(t, http.StatusOK, spans[0].Attributes["http.status_code"])
+	}
+
+	// The SDK calls of a resource operation are children of the span carried by the context of their request
+	ctx, operationSpan := utils.StartSpan(context.Background(), "oci_core_vcn read", utils.SpanKindInternal)
+	_, err = dispatcher.Do(request.WithContext(ctx))
+	assert.NoError(t, err)
+	operationSpan.End(nil)
+
+	spans = exporter.GetSpans()
+	if assert.Len(t, spans, 3) {
+		assert.Equal(t, operationSpan.TraceId, spans[1].TraceId)
+		assert.Equal(t, operationSpan.SpanId, spans[1].ParentSpanId)
+		assert.Equal(t, operationSpan, spans[2])
+	}
+}
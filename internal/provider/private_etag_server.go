@@ -0,0 +1,52 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tf_resource "github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+// NewGRPCProviderServer returns the server of the provider, which keeps the etags of the resources in their private
+// state for `if_match_etags_enabled`. The SDK only keeps the timeouts of the resources in it.
+func NewGRPCProviderServer(p *schema.Provider) tfprotov5.ProviderServer {
+	return &privateETagProviderServer{ProviderServer: schema.NewGRPCProviderServer(p)}
+}
+
+// privateETagProviderServer passes the etag kept in the private state of the requests about a resource to its CRUD
+// type, and adds the etag the CRUD type last read to the private state of the responses
+type privateETagProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s *privateETagProviderServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	ctx, addPrivateETag := tf_resource.WithPrivateETag(ctx, req.Private)
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if resp != nil {
+		resp.Private = addPrivateETag(resp.Private)
+	}
+	return resp, err
+}
+
+func (s *privateETagProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx, addPrivateETag := tf_resource.WithPrivateETag(ctx, req.PriorPrivate)
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		resp.PlannedPrivate = addPrivateETag(resp.PlannedPrivate)
+	}
+	return resp, err
+}
+
+func (s *privateETagProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	ctx, addPrivateETag := tf_resource.WithPrivateETag(ctx, req.PlannedPrivate)
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if resp != nil {
+		resp.Private = addPrivateETag(resp.Private)
+	}
+	return resp, err
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/stretchr/testify/assert"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+// mockProviderServer is the server of the SDK, which replaces the private state of the resources with their timeouts
+// and schema version
+type mockProviderServer struct {
+	tfprotov5.ProviderServer
+	operation func(ctx context.Context)
+}
+
+func (s *mockProviderServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	s.operation(ctx)
+	return &tfprotov5.ReadResourceResponse{Private: []byte(`{"schema_version":"0"}`)}, nil
+}
+
+func (s *mockProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	s.operation(ctx)
+	return &tfprotov5.PlanResourceChangeResponse{PlannedPrivate: []byte(`{"schema_version":"0"}`)}, nil
+}
+
+func (s *mockProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	s.operation(ctx)
+	return &tfprotov5.ApplyResourceChangeResponse{Private: []byte(`{"schema_version":"0"}`)}, nil
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitPrivateETagProviderServer(t *testing.T) {
+	defer func() { tfresource.IfMatchETagsEnabled = false }()
+	private := []byte(`{"if_match_etag":"etag-1","schema_version":"0"}`)
+	mockServer := &mockProviderServer{operation: func(ctx context.Context) {}}
+	server := &privateETagProviderServer{ProviderServer: mockServer}
+
+	// The etag is kept in the private state of the resource from one request of Terraform to the next
+	tfresource.IfMatchETagsEnabled = true
+	readResponse, err := server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{Private: private})
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(private), string(readResponse.Private))
+
+	planResponse, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{PriorPrivate: readResponse.Private})
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(private), string(planResponse.PlannedPrivate))
+
+	// It is dropped once the resource is changed, until it is read again
+	mockServer.operation = tfresource.ResourceChanged
+	applyResponse, err := server.ApplyResourceChange(context.Background(), &tfprotov5.ApplyResourceChangeRequest{PlannedPrivate: planResponse.PlannedPrivate})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"schema_version":"0"}`, string(applyResponse.Private))
+
+	// It is not kept when the etags are not sent
+	tfresource.IfMatchETagsEnabled = false
+	mockServer.operation = func(ctx context.Context) {}
+	readResponse, err = server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{Private: private})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"schema_version":"0"}`, string(readResponse.Private))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitClientsWithContext_resourceChanged(t *testing.T) {
+	defer func() { tfresource.IfMatchETagsEnabled = false }()
+	tfresource.IfMatchETagsEnabled = true
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	privateKeyPem := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}))
+	configProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, privateKeyPem, nil)
+	configureClient := func(client *oci_common.BaseClient) error {
+		client.HTTPClient = &contextRecordingDispatcher{}
+		return nil
+	}
+	clients := &tf_client.OracleClients{
+		SdkClientMap:  make(map[string]interface{}),
+		Configuration: make(map[string]string),
+	}
+	assert.NoError(t, tf_client.CreateSDKClients(clients, configProvider, configureClient))
+	private := []byte(`{"if_match_etag":"etag-1"}`)
+
+	// The requests that read the resource keep its etag
+	ctx, addPrivateETag := tfresource.WithPrivateETag(context.Background(), private)
+	operationClients := clients.ClientsWithContext(ctx).(*tf_client.OracleClients)
+	request, err := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1..vcn", nil)
+	assert.NoError(t, err)
+	_, err = operationClients.VirtualNetworkClient().HTTPClient.Do(request)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(private), string(addPrivateETag(nil)))
+
+	// The requests that change it make the etag stale
+	request, err = http.NewRequest(http.MethodPut, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1..vcn", nil)
+	assert.NoError(t, err)
+	_, err = operationClients.VirtualNetworkClient().HTTPClient.Do(request)
+	assert.NoError(t, err)
+	assert.Nil(t, addPrivateETag(nil))
+}
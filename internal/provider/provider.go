@@ -90,8 +90,9 @@ func ociVarName(attrName string) string {
 }
 
 func init() {
+	tf_client.DescribeConfigurationSourcesVar = describeConfigurationSources
 	descriptions = map[string]string{
-		globalvar.AuthAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s', '%s', '%s' and '%s'. By default, '%s' will be used.", globalvar.AuthAPIKeySetting, globalvar.AuthSecurityToken, globalvar.AuthInstancePrincipalSetting, globalvar.ResourcePrincipal, globalvar.AuthOKEWorkloadIdentity, globalvar.AuthAPIKeySetting),
+		globalvar.AuthAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s', '%s', '%s', '%s' and '%s'. By default, '%s' will be used.", globalvar.AuthAPIKeySetting, globalvar.AuthSecurityToken, globalvar.AuthInstancePrincipalSetting, globalvar.ResourcePrincipal, globalvar.AuthOKEWorkloadIdentity, globalvar.AuthExternalProcess, globalvar.AuthAPIKeySetting),
 		globalvar.TenancyOcidAttrName: fmt.Sprintf("(Optional) The tenancy OCID for a user. The tenancy OCID can be found at the bottom of user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
 		globalvar.UserOcidAttrName:    fmt.Sprintf("(Optional) The user OCID. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
 		globalvar.FingerprintAttrName: fmt.Sprintf("(Optional) The fingerprint for the user's RSA key. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
@@ -105,17 +106,40 @@ func init() {
 			"Automatic retries were introduced to solve some eventual consistency problems but it also introduced performance issues on destroy operations.",
 		globalvar.RetryDurationSecondsAttrName: "(Optional) The minimum duration (in seconds) to retry a resource operation in response to an error.\n" +
 			"The actual retry duration may be longer due to jittering of retry operations. This value is ignored if the `disable_auto_retries` field is set to true.",
-		globalvar.ConfigFileProfileAttrName:                   "(Optional) The profile name to be used from config file, if not set it will be DEFAULT.",
+		globalvar.ConfigFileProfileAttrName: "(Optional) The profile name to be used from config file, if not set it will be DEFAULT.",
+		globalvar.CredentialProcessAttrName: "(Optional) The command that prints the credentials as JSON, with the `tenancy`, `user`, `fingerprint`, `key` and optional `key_passphrase`, `security_token`, `region` and `expiration` (RFC 3339) fields.\n" +
+			fmt.Sprintf("The credentials are cached until they expire, and the command is run again to refresh them. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthExternalProcess),
+		globalvar.SessionTokenRefreshCommandAttrName: "(Optional) The command that refreshes the security token of the `config_file_profile` when it expires during a run, e.g. `oci session refresh --profile DEFAULT`.\n" +
+			fmt.Sprintf("Requests that fail because the token has expired are sent again with the refreshed token. Ignored unless auth is set to '%s'.", globalvar.AuthSecurityToken),
 		globalvar.DefinedTagsToIgnore:                         "(Optional) List of defined tags keys that Terraform should ignore when planning creates and updates to the associated remote object",
+		globalvar.DefaultFreeformTagsAttrName:                 "(Optional) Freeform tags added to every resource that supports tagging. Tags set on a resource take precedence over these.",
+		globalvar.DefaultDefinedTagsAttrName:                  "(Optional) Defined tags, in the form `namespace.key`, added to every resource that supports tagging. Tags set on a resource take precedence over these.",
 		globalvar.RealmSpecificServiceEndpointTemplateEnabled: "(Optional) flags to enable realm specific service endpoint.",
+		globalvar.AdditionalRegionsAttrName:                   "(Optional) List of regions, other than the provider's region, in which resources may be managed by setting their `region` attribute.",
+		globalvar.WorkRequestFailureRetriesAttrName: "(Optional) Create resources again when the work request that creates them fails, e.g. for lack of capacity.\n" +
+			fmt.Sprintf("The resource that failed to be created is deleted first. Resources may override it with a `%s` block of their own.", globalvar.WorkRequestFailureRetriesAttrName),
+		globalvar.EndpointsAttrName: "(Optional) Endpoints to send the requests of a service to, instead of its public endpoint in the provider's region, keyed by service name (e.g. `core`, `objectstorage`).\n" +
+			fmt.Sprintf("Takes precedence over the '%s' environment variable, and is not used for the `%s`.", globalvar.ClientHostOverridesEnv, globalvar.AdditionalRegionsAttrName),
+		globalvar.RateLimitsAttrName: fmt.Sprintf("(Optional) Maximum number of requests per second to send to each service, keyed by service name as in the `%s` block (e.g. `identity`, `core`).\n", globalvar.EndpointsAttrName) +
+			fmt.Sprintf("Can also be set with the '%s' environment variable, e.g. 'identity=5;core=20'.", ociVarName(globalvar.RateLimitsEnv)),
+		globalvar.ConcurrencyLimitsAttrName: fmt.Sprintf("(Optional) Maximum number of concurrent requests to send to each service, keyed by service name as in the `%s` block (e.g. `identity`, `core`).\n", globalvar.EndpointsAttrName) +
+			fmt.Sprintf("Can also be set with the '%s' environment variable, e.g. 'identity=2;core=10'.", ociVarName(globalvar.ConcurrencyLimitsEnv)),
+		globalvar.RetryAttrName: "(Optional) Overrides of the automatic retries of the requests of a service, or of one of its operations: the duration to retry for, the backoff between attempts and the errors to retry.\n" +
+			fmt.Sprintf("The service is named as in the `%s` block (e.g. `objectstorage`) or with underscores (e.g. `object_storage`). ", globalvar.EndpointsAttrName) +
+			"The override of an operation takes precedence over the one of its service.",
+		globalvar.IfMatchETagsEnabledAttrName: "(Optional) Send the etag a resource had when it was last read with the requests that update or delete it, so that they fail instead of overwriting changes made outside of Terraform since.\n" +
+			"The etag is kept in the private state of the resource, and is not sent after a request of the operation changed the resource. Only the resources whose update and delete requests take an etag send one.",
+		globalvar.DefaultTimeoutsAttrName: "(Optional) Default create, update and delete timeouts of the resources of a service (e.g. `database`), or of all the resources if no service is set.\n" +
+			fmt.Sprintf("The service is named as in the `%s` block (e.g. `goldengate`) or as in the type of its resources (e.g. `golden_gate`). ", globalvar.EndpointsAttrName) +
+			"The timeouts of a service take precedence over the ones of all the resources, and the `timeouts` block of a resource over both.",
 	}
 }
 
 func Provider() *schema.Provider {
 	ociProvider = &schema.Provider{
-		DataSourcesMap: DataSourcesMap(),
+		DataSourcesMap: tf_resource.WithOperationContexts(DataSourcesMap()),
 		Schema:         SchemaMap(),
-		ResourcesMap:   ResourcesMap(),
+		ResourcesMap:   tf_resource.WithOperationContexts(ResourcesMap()),
 		ConfigureFunc:  ProviderConfig,
 	}
 	return ociProvider
@@ -128,7 +152,7 @@ func SchemaMap() map[string]*schema.Schema {
 			Optional:     true,
 			Description:  descriptions[globalvar.AuthAttrName],
 			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.AuthAttrName), ociVarName(globalvar.AuthAttrName)}, globalvar.AuthAPIKeySetting),
-			ValidateFunc: validation.StringInSlice([]string{globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken, globalvar.ResourcePrincipal, globalvar.AuthOKEWorkloadIdentity}, true),
+			ValidateFunc: validation.StringInSlice([]string{globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken, globalvar.ResourcePrincipal, globalvar.AuthOKEWorkloadIdentity, globalvar.AuthExternalProcess}, true),
 		},
 		globalvar.TenancyOcidAttrName: {
 			Type:        schema.TypeString,
@@ -182,6 +206,12 @@ func SchemaMap() map[string]*schema.Schema {
 			Description: descriptions[globalvar.DisableAutoRetriesAttrName],
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.DisableAutoRetriesAttrName), ociVarName(globalvar.DisableAutoRetriesAttrName)}, nil),
 		},
+		globalvar.IfMatchETagsEnabledAttrName: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: descriptions[globalvar.IfMatchETagsEnabledAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.IfMatchETagsEnabledAttrName), ociVarName(globalvar.IfMatchETagsEnabledAttrName)}, false),
+		},
 		globalvar.RetryDurationSecondsAttrName: {
 			Type:        schema.TypeInt,
 			Optional:    true,
@@ -194,6 +224,18 @@ func SchemaMap() map[string]*schema.Schema {
 			Description: descriptions[globalvar.ConfigFileProfileAttrName],
 			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.ConfigFileProfileAttrName), ociVarName(globalvar.ConfigFileProfileAttrName)}, nil),
 		},
+		globalvar.CredentialProcessAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[globalvar.CredentialProcessAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.CredentialProcessAttrName), ociVarName(globalvar.CredentialProcessAttrName)}, nil),
+		},
+		globalvar.SessionTokenRefreshCommandAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[globalvar.SessionTokenRefreshCommandAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.SessionTokenRefreshCommandAttrName), ociVarName(globalvar.SessionTokenRefreshCommandAttrName)}, nil),
+		},
 		globalvar.DefinedTagsToIgnore: {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -201,11 +243,59 @@ func SchemaMap() map[string]*schema.Schema {
 			Description: descriptions[globalvar.DefinedTagsToIgnore],
 			MaxItems:    100,
 		},
+		globalvar.DefaultFreeformTagsAttrName: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        schema.TypeString,
+			Description: descriptions[globalvar.DefaultFreeformTagsAttrName],
+		},
+		globalvar.DefaultDefinedTagsAttrName: {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        schema.TypeString,
+			Description: descriptions[globalvar.DefaultDefinedTagsAttrName],
+			ValidateFunc: func(v interface{}, k string) (ws []string, es []error) {
+				if _, err := tf_resource.MapToDefinedTags(v.(map[string]interface{})); err != nil {
+					es = append(es, fmt.Errorf("%s: %v", k, err))
+				}
+				return
+			},
+		},
 		globalvar.RealmSpecificServiceEndpointTemplateEnabled: {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Description: descriptions[globalvar.RealmSpecificServiceEndpointTemplateEnabled],
 		},
+		globalvar.AdditionalRegionsAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: descriptions[globalvar.AdditionalRegionsAttrName],
+		},
+		globalvar.WorkRequestFailureRetriesAttrName: tf_resource.WorkRequestFailureRetriesSchema(descriptions[globalvar.WorkRequestFailureRetriesAttrName]),
+		globalvar.EndpointsAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        endpointsSchema(),
+			Description: descriptions[globalvar.EndpointsAttrName],
+		},
+		globalvar.RateLimitsAttrName: {
+			Type:         schema.TypeMap,
+			Optional:     true,
+			Elem:         &schema.Schema{Type: schema.TypeInt},
+			ValidateFunc: validateServiceNameKeys,
+			Description:  descriptions[globalvar.RateLimitsAttrName],
+		},
+		globalvar.ConcurrencyLimitsAttrName: {
+			Type:         schema.TypeMap,
+			Optional:     true,
+			Elem:         &schema.Schema{Type: schema.TypeInt},
+			ValidateFunc: validateServiceNameKeys,
+			Description:  descriptions[globalvar.ConcurrencyLimitsAttrName],
+		},
+		globalvar.RetryAttrName:           tf_resource.RetrySchema(descriptions[globalvar.RetryAttrName], validateRetryServiceName),
+		globalvar.DefaultTimeoutsAttrName: tf_resource.DefaultTimeoutsSchema(descriptions[globalvar.DefaultTimeoutsAttrName], validateDefaultTimeoutsServiceName),
 	}
 }
 
@@ -223,6 +313,7 @@ func DataSourcesMap() map[string]*schema.Resource {
 		tf_resource.RegisterDatasource("oci_load_balancers", tf_load_balancer.LoadBalancerLoadBalancersDataSource())
 		tf_resource.RegisterDatasource("oci_load_balancer_backendsets", tf_load_balancer.LoadBalancerBackendSetsDataSource())
 	}
+	tf_resource.RegisterDatasource("oci_provider_diagnostics", ProviderDiagnosticsDataSource())
 	return globalvar.OciDatasources
 }
 
@@ -231,21 +322,35 @@ func DataSourcesMap() map[string]*schema.Resource {
 func ResourcesMap() map[string]*schema.Resource {
 	// Register some aliases of registered resources. These are registered for convenience and legacy reasons.
 	if oci_common.CheckForEnabledServices(globalvar.CoreService) {
-		tf_resource.RegisterResource("oci_core_virtual_network", tf_core.CoreVcnResource())
+		tf_resource.RegisterServiceResources(globalvar.CoreService, func() {
+			tf_resource.RegisterResource("oci_core_virtual_network", tf_core.CoreVcnResource())
+		})
 	}
 	if oci_common.CheckForEnabledServices(globalvar.LoadBalancerService) {
-		tf_resource.RegisterResource("oci_load_balancer", tf_load_balancer.LoadBalancerLoadBalancerResource())
-		tf_resource.RegisterResource("oci_load_balancer_backendset", tf_load_balancer.LoadBalancerBackendSetResource())
+		tf_resource.RegisterServiceResources(globalvar.LoadBalancerService, func() {
+			tf_resource.RegisterWorkRequestResource("oci_load_balancer", tf_load_balancer.LoadBalancerLoadBalancerResource())
+			tf_resource.RegisterWorkRequestResource("oci_load_balancer_backendset", tf_load_balancer.LoadBalancerBackendSetResource())
+		})
 	}
 	return globalvar.OciResources
 }
 
 func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 	tf_resource.DefinedTagsToSuppress = IgnoreDefinedTags(d)
+	tf_resource.DefaultFreeformTags = defaultTags(d, globalvar.DefaultFreeformTagsAttrName)
+	tf_resource.DefaultDefinedTags = defaultTags(d, globalvar.DefaultDefinedTagsAttrName)
 	tf_resource.RealmSpecificServiceEndpointTemplateEnabled = realmSpecificServiceEndpointTemplateEnabled(d)
+	RateLimitsFromConfig = serviceLimits(d, globalvar.RateLimitsAttrName)
+	ConcurrencyLimitsFromConfig = serviceLimits(d, globalvar.ConcurrencyLimitsAttrName)
+	tf_resource.WorkRequestFailureRetryPolicyFromConfig = tf_resource.WorkRequestFailureRetryPolicyFromData(d)
+	tf_resource.RetryOverridesFromConfig = tf_resource.RetryOverridesFromData(d)
+	tf_resource.ApplyDefaultTimeouts(tf_resource.DefaultTimeoutsFromData(d))
+	tf_resource.IfMatchETagsEnabled = d.Get(globalvar.IfMatchETagsEnabledAttrName).(bool)
 	clients := &tf_client.OracleClients{
-		SdkClientMap:  make(map[string]interface{}, len(tf_client.OracleClientRegistrationsVar.RegisteredClients)),
-		Configuration: make(map[string]string),
+		SdkClientMap:      make(map[string]interface{}, len(tf_client.OracleClientRegistrationsVar.RegisteredClients)),
+		Configuration:     make(map[string]string),
+		AdditionalRegions: additionalRegions(d),
+		EndpointOverrides: endpointOverrides(d),
 	}
 
 	if d.Get(globalvar.DisableAutoRetriesAttrName).(bool) {
@@ -275,8 +380,9 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 
 	err = tf_client.CreateSDKClients(clients, sdkConfigProvider, tf_client.ConfigureClientVar)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%v\n%s", err, describeConfigurationSources(clients.ConfigurationSources))
 	}
+	clients.ConfigurationSettings = configurationSettings(d)
 
 	AvoidWaitingForDeleteTarget, _ = strconv.ParseBool(utils.GetEnvSettingWithDefault("avoid_waiting_for_delete_target", "false"))
 
@@ -289,7 +395,7 @@ func GetSdkConfigProvider(d *schema.ResourceData, clients *tf_client.OracleClien
 	profile := d.Get(globalvar.ConfigFileProfileAttrName).(string)
 	clients.Configuration[globalvar.AuthAttrName] = auth
 
-	configProviders, err := getConfigProviders(d, auth)
+	configSources, err := getConfigProviders(d, auth)
 	if err != nil {
 		return nil, err
 	}
@@ -302,27 +408,34 @@ func GetSdkConfigProvider(d *schema.ResourceData, clients *tf_client.OracleClien
 	//for composite provider, we only check the first provider in the list for the AuthType.
 	//Then SDK will based on the AuthType to Create the actual provider if it's a valid value.
 	//If not, then SDK will base on the order in the composite provider list to check for necessary info (tenancyid, userID, fingerprint, region, keyID).
-	configProviders = append(configProviders, resourceDataConfigProvider)
+	configSources = append(configSources, tf_client.ConfigurationSource{Name: "provider block", Provider: resourceDataConfigProvider})
 	if profile == "" {
-		configProviders = append(configProviders, oci_common.DefaultConfigProvider())
+		configSources = append(configSources, tf_client.ConfigurationSource{Name: "~/.oci/config [DEFAULT] and TF_VAR_ environment variables", Provider: oci_common.DefaultConfigProvider()})
 	} else {
 		defaultPath := path.Join(utils.GetHomeFolder(), globalvar.DefaultConfigDirName, globalvar.DefaultConfigFileName)
+		configSources = append(configSources, tf_client.ConfigurationSource{Name: fmt.Sprintf("%s [%s]", defaultPath, profile), Provider: oci_common.CustomProfileConfigProvider(defaultPath, profile)})
 		err := utils.CheckProfile(profile, defaultPath)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("%v\n%s", err, describeConfigurationSources(configSources))
 		}
-		configProviders = append(configProviders, oci_common.CustomProfileConfigProvider(defaultPath, profile))
+	}
+	clients.ConfigurationSources = configSources
+
+	configProviders := make([]oci_common.ConfigurationProvider, 0, len(configSources))
+	for _, configSource := range configSources {
+		configProviders = append(configProviders, configSource.Provider)
 	}
 	sdkConfigProvider, err := oci_common.ComposingConfigurationProvider(configProviders)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%v\n%s", err, describeConfigurationSources(configSources))
 	}
 
 	return sdkConfigProvider, nil
 }
 
-func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.ConfigurationProvider, error) {
-	var configProviders []oci_common.ConfigurationProvider
+func getConfigProviders(d *schema.ResourceData, auth string) ([]tf_client.ConfigurationSource, error) {
+	var configSources []tf_client.ConfigurationSource
+	sessionTokenRefresherFromConfig = nil
 
 	switch auth {
 	case strings.ToLower(globalvar.AuthAPIKeySetting):
@@ -357,7 +470,7 @@ func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.Confi
 		}
 		log.Printf("[DEBUG] Configuration provided by: %s", cfg)
 
-		configProviders = append(configProviders, cfg)
+		configSources = append(configSources, tf_client.ConfigurationSource{Name: "instance principal", Provider: cfg})
 	case strings.ToLower(globalvar.AuthInstancePrincipalWithCertsSetting):
 		_, ok := utils.CheckIncompatibleAttrsForApiKeyAuth(d, ApiKeyConfigAttributes)
 		if !ok {
@@ -408,7 +521,7 @@ func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.Confi
 		}
 		log.Printf("[DEBUG] Configuration provided by: %s", cfg)
 
-		configProviders = append(configProviders, cfg)
+		configSources = append(configSources, tf_client.ConfigurationSource{Name: "instance principal with certificates from " + certsDir, Provider: cfg})
 	case strings.ToLower(globalvar.AuthSecurityToken):
 		region, ok := d.GetOk(globalvar.RegionAttrName)
 		if !ok {
@@ -416,7 +529,7 @@ func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.Confi
 		}
 		// if region is part of the provider block make sure it is part of the final configuration too, and overwrites the region in the profile. +
 		regionProvider := oci_common.NewRawConfigurationProvider("", "", region.(string), "", "", nil)
-		configProviders = append(configProviders, regionProvider)
+		configSources = append(configSources, tf_client.ConfigurationSource{Name: "region in provider block", Provider: regionProvider})
 
 		profile, ok := d.GetOk(globalvar.ConfigFileProfileAttrName)
 		if !ok {
@@ -433,7 +546,8 @@ func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.Confi
 		if err != nil {
 			return nil, fmt.Errorf("could not create security token based auth config provider %v", err)
 		}
-		configProviders = append(configProviders, securityTokenBasedAuthConfigProvider)
+		configSources = append(configSources, tf_client.ConfigurationSource{Name: fmt.Sprintf("security token of %s [%s]", defaultPath, profileString), Provider: securityTokenBasedAuthConfigProvider})
+		sessionTokenRefresherFromConfig = newSessionTokenRefresher(securityTokenBasedAuthConfigProvider, profileString, d.Get(globalvar.SessionTokenRefreshCommandAttrName).(string))
 	case strings.ToLower(globalvar.ResourcePrincipal):
 		var err error
 		var resourcePrincipalAuthConfigProvider oci_common_auth.ConfigurationProviderWithClaimAccess
@@ -447,18 +561,39 @@ func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.Confi
 		if err != nil {
 			return nil, err
 		}
-		configProviders = append(configProviders, resourcePrincipalAuthConfigProvider)
+		configSources = append(configSources, tf_client.ConfigurationSource{Name: "resource principal", Provider: resourcePrincipalAuthConfigProvider})
 	case strings.ToLower(globalvar.AuthOKEWorkloadIdentity):
 		okeWorkloadIdentityConfigProvider, err := oci_common_auth.OkeWorkloadIdentityConfigurationProvider()
 		if err != nil {
 			return nil, fmt.Errorf("can not get oke workload indentity based auth config provider %v", err)
 		}
-		configProviders = append(configProviders, okeWorkloadIdentityConfigProvider)
+		configSources = append(configSources, tf_client.ConfigurationSource{Name: "OKE workload identity", Provider: okeWorkloadIdentityConfigProvider})
+	case strings.ToLower(globalvar.AuthExternalProcess):
+		_, ok := utils.CheckIncompatibleAttrsForApiKeyAuth(d, ApiKeyConfigAttributes)
+		if !ok {
+			log.Printf("[DEBUG] Ignoring all user credentials for %v authentication", auth)
+		}
+
+		command, ok := d.GetOk(globalvar.CredentialProcessAttrName)
+		if !ok {
+			return nil, fmt.Errorf("can not get %s from Terraform configuration (ExternalProcess)", globalvar.CredentialProcessAttrName)
+		}
+		// if region is part of the provider block make sure it overwrites the region returned by the command
+		if region, ok := d.GetOk(globalvar.RegionAttrName); ok {
+			configSources = append(configSources, tf_client.ConfigurationSource{Name: "region in provider block", Provider: oci_common.NewRawConfigurationProvider("", "", region.(string), "", "", nil)})
+		}
+
+		externalProcessConfigProvider := newExternalProcessConfigurationProvider(command.(string))
+		// Run the command once so that a misconfigured command fails the provider configuration
+		if _, err := externalProcessConfigProvider.credentials(); err != nil {
+			return nil, err
+		}
+		configSources = append(configSources, tf_client.ConfigurationSource{Name: globalvar.CredentialProcessAttrName + " command", Provider: externalProcessConfigProvider})
 	default:
-		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s' or '%s' or '%s' or '%s'", globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken, globalvar.ResourcePrincipal, globalvar.AuthOKEWorkloadIdentity)
+		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s' or '%s' or '%s' or '%s' or '%s'", globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken, globalvar.ResourcePrincipal, globalvar.AuthOKEWorkloadIdentity, globalvar.AuthExternalProcess)
 	}
 
-	return configProviders, nil
+	return configSources, nil
 }
 
 type ResourceDataConfigProvider struct {
@@ -519,6 +654,91 @@ func IgnoreDefinedTags(d schemaResourceData) []string {
 	}
 	return nil
 }
+func defaultTags(d schemaResourceData, attrName string) map[string]interface{} {
+	if tags, ok := d.GetOkExists(attrName); ok {
+		return tags.(map[string]interface{})
+	}
+	return nil
+}
+
+func additionalRegions(d schemaResourceData) []string {
+	if regions, ok := d.GetOkExists(globalvar.AdditionalRegionsAttrName); ok {
+		var result []string
+		for _, item := range regions.([]interface{}) {
+			result = append(result, item.(string))
+		}
+		return result
+	}
+	return nil
+}
+
+// endpointsSchema has an endpoint for each service a client is registered for, so that unknown services are rejected
+func endpointsSchema() *schema.Resource {
+	endpoints := make(map[string]*schema.Schema)
+	for _, serviceName := range tf_client.ServiceNames() {
+		endpoints[serviceName] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		}
+	}
+	return &schema.Resource{Schema: endpoints}
+}
+
+func endpointOverrides(d schemaResourceData) map[string]string {
+	endpoints, ok := d.GetOkExists(globalvar.EndpointsAttrName)
+	if !ok || len(endpoints.([]interface{})) == 0 || endpoints.([]interface{})[0] == nil {
+		return nil
+	}
+	result := make(map[string]string)
+	for serviceName, endpoint := range endpoints.([]interface{})[0].(map[string]interface{}) {
+		if endpoint != "" {
+			result[serviceName] = endpoint.(string)
+		}
+	}
+	return result
+}
+
+// validateServiceNameKeys validates that the keys of a map set in the provider block are names of services
+func validateServiceNameKeys(i interface{}, k string) ([]string, []error) {
+	var errs []error
+	for serviceName := range i.(map[string]interface{}) {
+		if err := tf_client.ValidateServiceName(serviceName); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", k, err))
+		}
+	}
+	return nil, errs
+}
+
+// validateRetryServiceName validates that the service of a `retry` block is the name of a service, either as in the
+// `endpoints` block or with underscores, e.g. `object_storage`
+func validateRetryServiceName(i interface{}, k string) ([]string, []error) {
+	if err := tf_client.ValidateServiceName(tf_resource.RetryServiceName(i.(string))); err != nil {
+		return nil, []error{fmt.Errorf("%s: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// validateDefaultTimeoutsServiceName validates that the service of a `default_timeouts` block is the name of a service,
+// either as in the `endpoints` block or as in the type of its resources, e.g. `golden_gate`
+func validateDefaultTimeoutsServiceName(i interface{}, k string) ([]string, []error) {
+	if err := tf_client.ValidateServiceName(tf_resource.DefaultTimeoutsServiceName(i.(string))); err != nil {
+		return nil, []error{fmt.Errorf("%s: %v", k, err)}
+	}
+	return nil, nil
+}
+
+func serviceLimits(d schemaResourceData, attrName string) map[string]int {
+	if limits, ok := d.GetOkExists(attrName); ok {
+		result := make(map[string]int)
+		for service, limit := range limits.(map[string]interface{}) {
+			result[service] = limit.(int)
+		}
+		return result
+	}
+	return nil
+}
+
 func realmSpecificServiceEndpointTemplateEnabled(d schemaResourceData) string {
 	if flag, ok := d.GetOkExists(globalvar.RealmSpecificServiceEndpointTemplateEnabled); ok {
 		return strconv.FormatBool(flag.(bool))
@@ -609,6 +829,14 @@ func BuildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 
 	simulateDbForDbSystemUpgrade, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("simulate_db_db_system_upgrade", "false"))
 
+	// Limiters are shared by all the clients, so that the limits apply to all the requests sent to a service
+	serviceLimiters, err := buildServiceLimiters()
+	if err != nil {
+		return nil, err
+	}
+
+	sessionTokenRefresher := sessionTokenRefresherFromConfig
+
 	requestSigner := oci_common.DefaultRequestSigner(configProvider)
 	var oboTokenProvider OboTokenProvider
 	oboTokenProvider = emptyOboTokenProvider{}
@@ -703,6 +931,22 @@ func BuildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 			}
 		}
 
+		if utils.StructuredLoggingEnabled() {
+			client.HTTPClient = &structuredLoggingDispatcher{dispatcher: client.HTTPClient, logBodies: utils.StructuredLogBodiesEnabled()}
+		}
+
+		if utils.TracingEnabled() {
+			client.HTTPClient = &tracingDispatcher{dispatcher: client.HTTPClient}
+		}
+
+		if sessionTokenRefresher != nil {
+			client.HTTPClient = &sessionTokenRefreshDispatcher{dispatcher: client.HTTPClient, signer: requestSigner, refresher: sessionTokenRefresher}
+		}
+
+		if len(serviceLimiters) > 0 {
+			client.HTTPClient = &rateLimitedDispatcher{dispatcher: client.HTTPClient, limiters: serviceLimiters}
+		}
+
 		return nil
 	}
 
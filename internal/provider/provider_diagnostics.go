@@ -0,0 +1,182 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+const (
+	providerBlockSource = "provider block"
+
+	// The realm specific service endpoint template the clients use, once it is enabled
+	realmSpecificServiceEndpointTemplateSetting = "realm_specific_service_endpoint_template"
+)
+
+// Provider settings reported by the oci_provider_diagnostics data source. Credentials, such as the private key, are never reported.
+var diagnosedSettings = []string{
+	globalvar.AuthAttrName,
+	globalvar.ConfigFileProfileAttrName,
+	globalvar.RegionAttrName,
+	globalvar.TenancyOcidAttrName,
+	globalvar.UserOcidAttrName,
+	globalvar.FingerprintAttrName,
+	globalvar.PrivateKeyPathAttrName,
+	globalvar.RealmSpecificServiceEndpointTemplateEnabled,
+	globalvar.DisableAutoRetriesAttrName,
+	globalvar.RetryDurationSecondsAttrName,
+	globalvar.IfMatchETagsEnabledAttrName,
+}
+
+// Settings that, when not set in the provider block or the environment, are looked up from the configuration sources
+var configurationSourceSettings = map[string]func(oci_common.ConfigurationProvider) (string, error){
+	globalvar.TenancyOcidAttrName: oci_common.ConfigurationProvider.TenancyOCID,
+	globalvar.UserOcidAttrName:    oci_common.ConfigurationProvider.UserOCID,
+	globalvar.FingerprintAttrName: oci_common.ConfigurationProvider.KeyFingerprint,
+	globalvar.RegionAttrName:      oci_common.ConfigurationProvider.Region,
+}
+
+// configurationSettings returns the value of each diagnosed setting, along with where it was taken from
+func configurationSettings(d *schema.ResourceData) []tf_client.ConfigurationSetting {
+	settings := make([]tf_client.ConfigurationSetting, 0, len(diagnosedSettings))
+	for _, attrName := range diagnosedSettings {
+		setting := tf_client.ConfigurationSetting{Name: attrName, Source: settingSource(d, attrName)}
+		if value, ok := d.GetOkExists(attrName); ok {
+			setting.Value = fmt.Sprintf("%v", value)
+		}
+
+		// The SDK also reads the realm specific endpoint template flag from its own environment variable
+		if attrName == globalvar.RealmSpecificServiceEndpointTemplateEnabled && setting.Source == "" {
+			if value, ok := os.LookupEnv(oci_common.OciRealmSpecificServiceEndpointTemplateEnabledEnvVar); ok {
+				setting.Value = value
+				setting.Source = oci_common.OciRealmSpecificServiceEndpointTemplateEnabledEnvVar + " environment variable"
+			}
+		}
+		settings = append(settings, setting)
+	}
+	return settings
+}
+
+// settingSource returns where the value of a provider setting was taken from: the provider block, one of the
+// TF_VAR_ or OCI_ environment variables, or "" if it is not set
+func settingSource(d *schema.ResourceData, attrName string) string {
+	rawConfig := d.GetRawConfig()
+	hasRawConfig := !rawConfig.IsNull() && rawConfig.IsKnown() && rawConfig.Type().IsObjectType() && rawConfig.Type().HasAttribute(attrName)
+	if hasRawConfig && !rawConfig.GetAttr(attrName).IsNull() {
+		return providerBlockSource
+	}
+
+	if _, ok := d.GetOkExists(attrName); !ok {
+		return ""
+	}
+	for _, envVarName := range []string{tfVarName(attrName), ociVarName(attrName)} {
+		if _, ok := os.LookupEnv(envVarName); ok {
+			return envVarName + " environment variable"
+		}
+	}
+	if !hasRawConfig {
+		return providerBlockSource
+	}
+	return ""
+}
+
+// resolveConfigurationSettings fills in the settings that were not set in the provider block or the environment with
+// the value of the first configuration source that provides them, the same way ComposingConfigurationProvider does
+func resolveConfigurationSettings(settings []tf_client.ConfigurationSetting, configSources []tf_client.ConfigurationSource) []tf_client.ConfigurationSetting {
+	resolved := make([]tf_client.ConfigurationSetting, 0, len(settings))
+	for _, setting := range settings {
+		if getValue, ok := configurationSourceSettings[setting.Name]; ok && setting.Source == "" {
+			for _, configSource := range configSources {
+				if value, err := getValue(configSource.Provider); err == nil && value != "" {
+					setting.Value = value
+					setting.Source = configSource.Name
+					break
+				}
+			}
+		}
+		resolved = append(resolved, setting)
+	}
+	return resolved
+}
+
+// endpointTemplateSetting returns the realm specific service endpoint template used by the clients. Object Storage is
+// the service with a template of its own in some realms, e.g. `https://{namespaceName+Dot}objectstorage.{region}.oci.customer-oci.com`,
+// so the template is the host of its client once it is enabled. The template is taken from where it was enabled.
+func endpointTemplateSetting(clients *tf_client.OracleClients, settings []tf_client.ConfigurationSetting) tf_client.ConfigurationSetting {
+	setting := tf_client.ConfigurationSetting{Name: realmSpecificServiceEndpointTemplateSetting}
+	client, err := clients.GetClient("oci_object_storage.ObjectStorageClient")
+	if err != nil {
+		return setting
+	}
+	objectStorageClient, ok := client.(*oci_object_storage.ObjectStorageClient)
+	if !ok || !objectStorageClient.IsOciRealmSpecificServiceEndpointTemplateEnabled() {
+		return setting
+	}
+	setting.Value = objectStorageClient.Host
+	for _, enabledSetting := range settings {
+		if enabledSetting.Name == globalvar.RealmSpecificServiceEndpointTemplateEnabled {
+			setting.Source = enabledSetting.Source
+		}
+	}
+	return setting
+}
+
+// configurationSourceInUse returns the index of the configuration source the key is taken from, i.e. the first one
+// that provides a key fingerprint as ComposingConfigurationProvider does, or -1 if there is none
+func configurationSourceInUse(configSources []tf_client.ConfigurationSource) int {
+	for i, configSource := range configSources {
+		if fingerprint, err := configSource.Provider.KeyFingerprint(); err == nil && fingerprint != "" {
+			return i
+		}
+	}
+	return -1
+}
+
+// configurationSourceErrors returns why the configuration source can not provide each part of the configuration. The
+// key is only read from the source in use, since reading it may run a command or request a certificate.
+func configurationSourceErrors(configSource tf_client.ConfigurationSource, inUse bool) []string {
+	var errors []string
+	for _, attrName := range []string{globalvar.TenancyOcidAttrName, globalvar.UserOcidAttrName, globalvar.FingerprintAttrName, globalvar.RegionAttrName} {
+		if _, err := configurationSourceSettings[attrName](configSource.Provider); err != nil {
+			errors = append(errors, fmt.Sprintf("%s: %v", attrName, err))
+		}
+	}
+	if !inUse {
+		return errors
+	}
+	if _, err := configSource.Provider.KeyID(); err != nil {
+		errors = append(errors, fmt.Sprintf("key_id: %v", err))
+	}
+	if _, err := configSource.Provider.PrivateRSAKey(); err != nil {
+		errors = append(errors, fmt.Sprintf("%s: %v", globalvar.PrivateKeyAttrName, err))
+	}
+	return errors
+}
+
+// describeConfigurationSources lists every configuration source that was attempted, and why it failed
+func describeConfigurationSources(configSources []tf_client.ConfigurationSource) string {
+	var description strings.Builder
+	description.WriteString("Attempted configuration sources, in order:")
+	inUse := configurationSourceInUse(configSources)
+	for i, configSource := range configSources {
+		description.WriteString(fmt.Sprintf("\n  - %s:", configSource.Name))
+		errors := configurationSourceErrors(configSource, i == inUse)
+		if len(errors) == 0 {
+			description.WriteString(" ok")
+		}
+		for _, err := range errors {
+			description.WriteString("\n      " + err)
+		}
+	}
+	return description.String()
+}
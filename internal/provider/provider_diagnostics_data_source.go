@@ -0,0 +1,107 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+func ProviderDiagnosticsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readProviderDiagnostics,
+		Schema: map[string]*schema.Schema{
+			// Computed
+			"settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"configuration_sources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"errors": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readProviderDiagnostics(d *schema.ResourceData, m interface{}) error {
+	sync := &ProviderDiagnosticsDataSourceCrud{}
+	sync.D = d
+	sync.Clients = m.(*tf_client.OracleClients)
+
+	return tfresource.ReadResource(sync)
+}
+
+type ProviderDiagnosticsDataSourceCrud struct {
+	D       *schema.ResourceData
+	Clients *tf_client.OracleClients
+	Res     []tf_client.ConfigurationSetting
+}
+
+func (s *ProviderDiagnosticsDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *ProviderDiagnosticsDataSourceCrud) Get() error {
+	s.Res = resolveConfigurationSettings(s.Clients.ConfigurationSettings, s.Clients.ConfigurationSources)
+	s.Res = append(s.Res, endpointTemplateSetting(s.Clients, s.Res))
+	return nil
+}
+
+func (s *ProviderDiagnosticsDataSourceCrud) SetData() error {
+	s.D.SetId(tfresource.GenerateDataSourceID())
+
+	settings := []interface{}{}
+	for _, setting := range s.Res {
+		settings = append(settings, map[string]interface{}{
+			"name":   setting.Name,
+			"value":  setting.Value,
+			"source": setting.Source,
+		})
+	}
+	if err := s.D.Set("settings", settings); err != nil {
+		return err
+	}
+
+	configurationSources := []interface{}{}
+	inUse := configurationSourceInUse(s.Clients.ConfigurationSources)
+	for i, configSource := range s.Clients.ConfigurationSources {
+		configurationSources = append(configurationSources, map[string]interface{}{
+			"name":   configSource.Name,
+			"errors": configurationSourceErrors(configSource, i == inUse),
+		})
+	}
+	return s.D.Set("configuration_sources", configurationSources)
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/stretchr/testify/assert"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+func getTestConfigurationSources(t *testing.T) []tf_client.ConfigurationSource {
+	missingConfigFile, err := oci_common.ConfigurationProviderFromFile("/nonexistent/.oci/config", "")
+	assert.NoError(t, err)
+	return []tf_client.ConfigurationSource{
+		{Name: "~/.oci/config [DEFAULT]", Provider: missingConfigFile},
+		{Name: "provider block", Provider: oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, getTestPrivateKeyPem(t), nil)},
+	}
+}
+
+func getSetting(settings []tf_client.ConfigurationSetting, name string) tf_client.ConfigurationSetting {
+	for _, setting := range settings {
+		if setting.Name == name {
+			return setting
+		}
+	}
+	return tf_client.ConfigurationSetting{}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitConfigurationSettings(t *testing.T) {
+	os.Setenv("TF_VAR_region", "us-ashburn-1")
+	defer os.Unsetenv("TF_VAR_region")
+	os.Setenv(oci_common.OciRealmSpecificServiceEndpointTemplateEnabledEnvVar, "true")
+	defer os.Unsetenv(oci_common.OciRealmSpecificServiceEndpointTemplateEnabledEnvVar)
+
+	d := schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.AuthAttrName:        globalvar.AuthAPIKeySetting,
+		globalvar.TenancyOcidAttrName: testTenancyOCID,
+	})
+	settings := configurationSettings(d)
+
+	tests := []struct {
+		name string
+		want tf_client.ConfigurationSetting
+	}{
+		{name: "Test setting from provider block", want: tf_client.ConfigurationSetting{Name: globalvar.TenancyOcidAttrName, Value: testTenancyOCID, Source: "provider block"}},
+		{name: "Test setting from environment variable", want: tf_client.ConfigurationSetting{Name: globalvar.RegionAttrName, Value: "us-ashburn-1", Source: "TF_VAR_region environment variable"}},
+		{name: "Test setting not set", want: tf_client.ConfigurationSetting{Name: globalvar.UserOcidAttrName}},
+		{name: "Test setting from SDK environment variable", want: tf_client.ConfigurationSetting{Name: globalvar.RealmSpecificServiceEndpointTemplateEnabled, Value: "true", Source: oci_common.OciRealmSpecificServiceEndpointTemplateEnabledEnvVar + " environment variable"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getSetting(settings, tt.want.Name))
+		})
+	}
+	assert.Len(t, settings, len(diagnosedSettings))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitResolveConfigurationSettings(t *testing.T) {
+	settings := resolveConfigurationSettings([]tf_client.ConfigurationSetting{
+		{Name: globalvar.RegionAttrName, Value: "us-ashburn-1", Source: "provider block"},
+		{Name: globalvar.UserOcidAttrName},
+		{Name: globalvar.DisableAutoRetriesAttrName},
+	}, getTestConfigurationSources(t))
+
+	assert.Equal(t, []tf_client.ConfigurationSetting{
+		{Name: globalvar.RegionAttrName, Value: "us-ashburn-1", Source: "provider block"},
+		{Name: globalvar.UserOcidAttrName, Value: testUserOCID, Source: "provider block"},
+		{Name: globalvar.DisableAutoRetriesAttrName},
+	}, settings)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitDescribeConfigurationSources(t *testing.T) {
+	description := describeConfigurationSources(getTestConfigurationSources(t))
+
+	assert.Contains(t, description, "Attempted configuration sources, in order:\n  - ~/.oci/config [DEFAULT]:\n      tenancy_ocid: ")
+	assert.Contains(t, description, "/nonexistent/.oci/config")
+	assert.Contains(t, description, "\n  - provider block: ok")
+}
+
+// keyReadingConfigProvider counts the reads of its key, which may run a command or request a certificate
+type keyReadingConfigProvider struct {
+	oci_common.ConfigurationProvider
+	keyReads int
+}
+
+func (p *keyReadingConfigProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	p.keyReads++
+	return p.ConfigurationProvider.PrivateRSAKey()
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitDescribeConfigurationSources_keyOfSourceInUse(t *testing.T) {
+	regionOnly := &keyReadingConfigProvider{ConfigurationProvider: oci_common.NewRawConfigurationProvider("", "", "us-ashburn-1", "", "", nil)}
+	apiKey := &keyReadingConfigProvider{ConfigurationProvider: oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, getTestPrivateKeyPem(t), nil)}
+	configSources := []tf_client.ConfigurationSource{
+		{Name: "region in provider block", Provider: regionOnly},
+		{Name: "provider block", Provider: apiKey},
+	}
+
+	assert.Equal(t, 1, configurationSourceInUse(configSources))
+	assert.Contains(t, describeConfigurationSources(configSources), "\n  - provider block: ok")
+	assert.Equal(t, 0, regionOnly.keyReads)
+	assert.Equal(t, 1, apiKey.keyReads)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetSdkConfigProvider_badProfile(t *testing.T) {
+	homeDir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(homeDir, globalvar.DefaultConfigDirName), 0700))
+	configPath := filepath.Join(homeDir, globalvar.DefaultConfigDirName, globalvar.DefaultConfigFileName)
+	assert.NoError(t, ioutil.WriteFile(configPath, []byte("[DEFAULT]\nregion=us-phoenix-1\n"), 0600))
+	os.Setenv("TF_HOME_OVERRIDE", homeDir)
+	defer os.Unsetenv("TF_HOME_OVERRIDE")
+
+	d := schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.AuthAttrName:              globalvar.AuthAPIKeySetting,
+		globalvar.ConfigFileProfileAttrName: "missing",
+	})
+	clients := &tf_client.OracleClients{Configuration: make(map[string]string)}
+	_, err := GetSdkConfigProvider(d, clients)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "configuration file did not contain profile: missing")
+		assert.Contains(t, err.Error(), "Attempted configuration sources, in order:\n  - provider block:")
+		assert.Contains(t, err.Error(), "\n  - "+configPath+" [missing]:\n      tenancy_ocid: ")
+	}
+}
+
+// unreadableKeyConfigProvider can not read its private key once it is unreadable, e.g. once the key file is removed
+type unreadableKeyConfigProvider struct {
+	oci_common.ConfigurationProvider
+	unreadable bool
+}
+
+func (p *unreadableKeyConfigProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	if p.unreadable {
+		return nil, fmt.Errorf("can not read private key from: /nonexistent/oci_api_key.pem")
+	}
+	return p.ConfigurationProvider.PrivateRSAKey()
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitCreateSDKClients_badKey(t *testing.T) {
+	configProvider := &unreadableKeyConfigProvider{
+		ConfigurationProvider: oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, getTestPrivateKeyPem(t), nil),
+	}
+	clients := &tf_client.OracleClients{
+		SdkClientMap:         make(map[string]interface{}),
+		Configuration:        make(map[string]string),
+		ConfigurationSources: []tf_client.ConfigurationSource{{Name: "provider block", Provider: configProvider}},
+	}
+	assert.NoError(t, tf_client.CreateSDKClients(clients, configProvider, func(client *oci_common.BaseClient) error { return nil }))
+
+	// The clients created on demand report where the configuration was looked up from
+	configProvider.unreadable = true
+	_, err := clients.GetClient("oci_core.BlockstorageClient")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "unable to create 'oci_core.BlockstorageClient' client")
+		assert.Contains(t, err.Error(), "Attempted configuration sources, in order:\n  - provider block:\n      "+globalvar.PrivateKeyAttrName+": can not read private key from: /nonexistent/oci_api_key.pem")
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitProviderDiagnosticsDataSource_read(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ProviderDiagnosticsDataSource().Schema, map[string]interface{}{})
+	clients := &tf_client.OracleClients{
+		ConfigurationSources:  getTestConfigurationSources(t),
+		ConfigurationSettings: []tf_client.ConfigurationSetting{{Name: globalvar.TenancyOcidAttrName}},
+	}
+
+	assert.NoError(t, readProviderDiagnostics(d, clients))
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, testTenancyOCID, d.Get("settings.0.value"))
+	assert.Equal(t, "provider block", d.Get("settings.0.source"))
+	assert.Equal(t, 2, d.Get("configuration_sources.#"))
+	assert.Equal(t, "~/.oci/config [DEFAULT]", d.Get("configuration_sources.0.name"))
+	assert.NotEqual(t, 0, d.Get("configuration_sources.0.errors.#"))
+	assert.Equal(t, 0, d.Get("configuration_sources.1.errors.#"))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitProviderDiagnosticsDataSource_endpointTemplate(t *testing.T) {
+	os.Setenv(oci_common.OciRealmSpecificServiceEndpointTemplateEnabledEnvVar, "true")
+	defer os.Unsetenv(oci_common.OciRealmSpecificServiceEndpointTemplateEnabledEnvVar)
+
+	source := oci_common.OciRealmSpecificServiceEndpointTemplateEnabledEnvVar + " environment variable"
+	clients := &tf_client.OracleClients{
+		SdkClientMap:          make(map[string]interface{}),
+		Configuration:         make(map[string]string),
+		ConfigurationSources:  getTestConfigurationSources(t),
+		ConfigurationSettings: []tf_client.ConfigurationSetting{{Name: globalvar.RealmSpecificServiceEndpointTemplateEnabled, Value: "true", Source: source}},
+	}
+	assert.NoError(t, tf_client.CreateSDKClients(clients, getTestRawConfigurationProvider(t, "us-phoenix-1"), func(client *oci_common.BaseClient) error { return nil }))
+
+	// The template is reported along with the setting that enables it
+	d := schema.TestResourceDataRaw(t, ProviderDiagnosticsDataSource().Schema, map[string]interface{}{})
+	assert.NoError(t, readProviderDiagnostics(d, clients))
+	assert.Equal(t, globalvar.RealmSpecificServiceEndpointTemplateEnabled, d.Get("settings.0.name"))
+	assert.Equal(t, realmSpecificServiceEndpointTemplateSetting, d.Get("settings.1.name"))
+	assert.Equal(t, "https://{namespaceName+Dot}objectstorage.us-phoenix-1.oci.customer-oci.com", d.Get("settings.1.value"))
+	assert.Equal(t, source, d.Get("settings.1.source"))
+}
@@ -2,22 +2,31 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 	"github.com/oracle/terraform-provider-oci/httpreplay"
 	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -645,6 +654,54 @@ func TestUnitSecurityToken_basic(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// issue-routing-tag: terraform/default
+func TestUnitExternalProcess_basic(t *testing.T) {
+	privateKeyPem := getTestPrivateKeyPem(t)
+	runCommandVar = func(command string) ([]byte, error) {
+		return json.Marshal(map[string]interface{}{
+			"tenancy": testTenancyOCID, "fingerprint": testKeyFingerPrint, "key": privateKeyPem,
+			"security_token": "token", "region": "us-ashburn-1",
+		})
+	}
+	defer func() { runCommandVar = runCommand }()
+
+	r := &schema.Resource{
+		Schema: SchemaMap(),
+	}
+	d := r.Data(nil)
+	d.SetId("tenancy_ocid")
+	d.Set("auth", globalvar.AuthExternalProcess)
+	d.Set(globalvar.CredentialProcessAttrName, "get-oci-credentials")
+	d.Set(globalvar.RegionAttrName, "eu-frankfurt-1")
+	clients := &tf_client.OracleClients{
+		SdkClientMap:  make(map[string]interface{}, len(tf_client.OracleClientRegistrationsVar.RegisteredClients)),
+		Configuration: make(map[string]string),
+	}
+	sdkConfigProvider, err := GetSdkConfigProvider(d, clients)
+	assert.NoError(t, err)
+
+	keyId, err := sdkConfigProvider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ST$token", keyId)
+	tenancy, _ := sdkConfigProvider.TenancyOCID()
+	assert.Equal(t, testTenancyOCID, tenancy)
+	// The region in the provider block overrides the region returned by the command
+	region, _ := sdkConfigProvider.Region()
+	assert.Equal(t, "eu-frankfurt-1", region)
+	privateKey, err := sdkConfigProvider.PrivateRSAKey()
+	assert.NoError(t, err)
+	assert.NotNil(t, privateKey)
+
+	// A command that fails is reported when the provider is configured
+	d.Set(globalvar.CredentialProcessAttrName, "")
+	_, err = GetSdkConfigProvider(d, clients)
+	assert.EqualError(t, err, "can not get credential_process from Terraform configuration (ExternalProcess)")
+	d.Set(globalvar.CredentialProcessAttrName, "get-oci-credentials")
+	runCommandVar = func(command string) ([]byte, error) { return nil, fmt.Errorf("exit status 1") }
+	_, err = GetSdkConfigProvider(d, clients)
+	assert.EqualError(t, err, "credential_process command failed: exit status 1")
+}
+
 // issue-routing-tag: terraform/default
 func TestUnitResourcePrincipal_basic(t *testing.T) {
 	t.Skip("Run manually with a valid Resource Principle Session Token.")
@@ -779,6 +836,54 @@ func TestUnit_RegisterResourceMap(t *testing.T) {
 	}
 }
 
+// Every resource that can be created must have create and delete timeouts, and update timeouts if it can be updated
+// issue-routing-tag: terraform/default
+func TestUnit_ResourcesMapTimeouts(t *testing.T) {
+	resources := ResourcesMap()
+	for name, resource := range resources {
+		// The CRUD functions of the registered resources are wrapped into their context variants
+		if resource.Create == nil && resource.CreateContext == nil && resource.CreateWithoutTimeout == nil {
+			continue
+		}
+		if resource.Timeouts == nil {
+			t.Errorf("Resource %s has no timeouts", name)
+			continue
+		}
+		if resource.Timeouts.Create == nil {
+			t.Errorf("Resource %s has no create timeout", name)
+		}
+		if (resource.Update != nil || resource.UpdateContext != nil || resource.UpdateWithoutTimeout != nil) && resource.Timeouts.Update == nil {
+			t.Errorf("Resource %s has no update timeout", name)
+		}
+		if (resource.Delete != nil || resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil) && resource.Timeouts.Delete == nil {
+			t.Errorf("Resource %s has no delete timeout", name)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		resource string
+		create   time.Duration
+		delete   time.Duration
+	}{
+		{name: "Test resource of a service with longer default timeouts", resource: "oci_database_application_vip", create: tfresource.TwoHours, delete: tfresource.TwoHours},
+		{name: "Test resource of a service with the type prefix of another one", resource: "oci_database_management_managed_database_group", create: tfresource.TwentyMinutes, delete: tfresource.TwentyMinutes},
+		{name: "Test resource of a service named differently than its type", resource: "oci_golden_gate_connection", create: tfresource.OneHour, delete: tfresource.OneHour},
+		{name: "Test resource with context CRUD functions", resource: "oci_core_vcn", create: tfresource.TwentyMinutes, delete: tfresource.TwentyMinutes},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource, ok := resources[tt.resource]
+			if !assert.True(t, ok) || !assert.NotNil(t, resource.Timeouts) {
+				return
+			}
+			assert.Equal(t, tt.create, *resource.Timeouts.Create)
+			assert.Equal(t, tt.delete, *resource.Timeouts.Delete)
+		})
+	}
+	assert.NotNil(t, resources["oci_core_vcn"].CreateContext)
+}
+
 func TestUnit_DataSourcesMap(t *testing.T) {
 	tests := []struct {
 		name string
@@ -877,3 +982,266 @@ func TestUnitUserAgentFromEnv(t *testing.T) {
 		})
 	}
 }
+
+// ensure sdk clients are only built the first time they are requested, and are reused afterwards
+// issue-routing-tag: terraform/default
+func TestUnitCreateSDKClients_lazyInitialization(t *testing.T) {
+	configProvider := getTestRawConfigurationProvider(t, "us-phoenix-1")
+
+	configureCount := 0
+	var configureCountMutex sync.Mutex
+	configureClient := func(client *oci_common.BaseClient) error {
+		configureCountMutex.Lock()
+		defer configureCountMutex.Unlock()
+		configureCount++
+		return nil
+	}
+
+	clients := &tf_client.OracleClients{
+		SdkClientMap:  make(map[string]interface{}, len(tf_client.OracleClientRegistrationsVar.RegisteredClients)),
+		Configuration: make(map[string]string),
+	}
+	err := tf_client.CreateSDKClients(clients, configProvider, configureClient)
+	assert.NoError(t, err)
+	assert.Empty(t, clients.SdkClientMap, "no service clients should be created up front")
+
+	var wg sync.WaitGroup
+	blockstorageClients := make([]interface{}, 10)
+	for i := range blockstorageClients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			blockstorageClients[i] = clients.BlockstorageClient()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, client := range blockstorageClients {
+		assert.NotNil(t, client)
+		assert.Same(t, blockstorageClients[0], client)
+	}
+	assert.Len(t, clients.SdkClientMap, 1)
+	assert.Contains(t, clients.SdkClientMap, "oci_core.BlockstorageClient")
+
+	// One call for the work request client and one for the blockstorage client
+	assert.Equal(t, 2, configureCount)
+}
+
+// ensure clients for additional regions are created from the provider's configuration and reused afterwards
+// issue-routing-tag: terraform/default
+func TestUnitClientsForRegion(t *testing.T) {
+	os.Setenv(globalvar.ClientHostOverridesEnv, "oci_core.VirtualNetworkClient=https://vcn.us-phoenix-1.example.com")
+	defer os.Unsetenv(globalvar.ClientHostOverridesEnv)
+	configProvider := getTestRawConfigurationProvider(t, "us-phoenix-1")
+	configureClient := func(client *oci_common.BaseClient) error {
+		return nil
+	}
+
+	clients := &tf_client.OracleClients{
+		SdkClientMap:      make(map[string]interface{}, len(tf_client.OracleClientRegistrationsVar.RegisteredClients)),
+		Configuration:     map[string]string{globalvar.RegionAttrName: "us-phoenix-1"},
+		AdditionalRegions: []string{"us-ashburn-1"},
+	}
+	err := tf_client.CreateSDKClients(clients, configProvider, configureClient)
+	assert.NoError(t, err)
+
+	providerRegionClients, err := clients.ClientsForRegion("us-phoenix-1")
+	assert.NoError(t, err)
+	assert.Same(t, clients, providerRegionClients)
+
+	regionalClients, err := clients.ClientsForRegion("us-ashburn-1")
+	assert.NoError(t, err)
+	assert.NotSame(t, clients, regionalClients)
+	assert.Equal(t, "us-ashburn-1", regionalClients.(*tf_client.OracleClients).Configuration[globalvar.RegionAttrName])
+	// The client host overrides are only used in the provider's region
+	assert.Equal(t, "https://iaas.us-ashburn-1.oraclecloud.com", regionalClients.(*tf_client.OracleClients).VirtualNetworkClient().Host)
+	assert.Equal(t, "https://vcn.us-phoenix-1.example.com", clients.VirtualNetworkClient().Host)
+
+	sameRegionalClients, err := clients.ClientsForRegion("US-ASHBURN-1")
+	assert.NoError(t, err)
+	assert.Same(t, regionalClients, sameRegionalClients)
+
+	_, err = clients.ClientsForRegion("eu-frankfurt-1")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), globalvar.AdditionalRegionsAttrName)
+}
+
+// ensure endpoints set in the provider block are used by the clients of the service, over CLIENT_HOST_OVERRIDES
+// issue-routing-tag: terraform/default
+func TestUnitEndpointOverrides(t *testing.T) {
+	endpoints := endpointsSchema().Schema
+	assert.Contains(t, endpoints, "core")
+	assert.Contains(t, endpoints, "objectstorage")
+	assert.Contains(t, endpoints, globalvar.WorkRequest)
+	assert.NotContains(t, endpoints, "oci_core.VirtualNetworkClient")
+
+	d := schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.EndpointsAttrName: []interface{}{map[string]interface{}{
+			"core":                "https://core.private.example.com",
+			globalvar.WorkRequest: "http://localhost:8080",
+		}},
+	})
+	overrides := endpointOverrides(d)
+	assert.Equal(t, map[string]string{"core": "https://core.private.example.com", globalvar.WorkRequest: "http://localhost:8080"}, overrides)
+	assert.Nil(t, endpointOverrides(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{})))
+
+	os.Setenv(globalvar.ClientHostOverridesEnv, "oci_core.VirtualNetworkClient=https://env.example.com;oci_core.ComputeClient=https://compute.example.com")
+	defer os.Unsetenv(globalvar.ClientHostOverridesEnv)
+
+	clients := &tf_client.OracleClients{
+		SdkClientMap:      make(map[string]interface{}),
+		Configuration:     make(map[string]string),
+		EndpointOverrides: overrides,
+	}
+	err := tf_client.CreateSDKClients(clients, getTestRawConfigurationProvider(t, "us-phoenix-1"), func(client *oci_common.BaseClient) error { return nil })
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080", clients.WorkRequestClient.Host)
+	assert.Equal(t, "https://core.private.example.com", clients.VirtualNetworkClient().Host)
+	assert.Equal(t, "https://core.private.example.com", clients.BlockstorageClient().Host)
+	assert.Contains(t, clients.IdentityClient().Host, "us-phoenix-1")
+
+	_, errs := endpoints["core"].ValidateFunc("core.private.example.com", "endpoints.0.core")
+	assert.NotEmpty(t, errs)
+}
+
+func getTestRawConfigurationProvider(t *testing.T, region string) oci_common.ConfigurationProvider {
+	return oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, region, testKeyFingerPrint, getTestPrivateKeyPem(t), nil)
+}
+
+func getTestPrivateKeyPem(t *testing.T) string {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}))
+}
+
+// ensure the error of a client that could not be created is returned every time it is requested
+// issue-routing-tag: terraform/default
+func TestUnitCreateSDKClients_clientError(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	privateKeyPem := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}))
+	configProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, privateKeyPem, nil)
+	configureCount := 0
+	configureClient := func(client *oci_common.BaseClient) error {
+		configureCount++
+		if configureCount > 1 {
+			return fmt.Errorf("can not read private key")
+		}
+		return nil
+	}
+
+	clients := &tf_client.OracleClients{
+		SdkClientMap:  make(map[string]interface{}),
+		Configuration: make(map[string]string),
+	}
+	assert.NoError(t, tf_client.CreateSDKClients(clients, configProvider, configureClient))
+
+	for i := 0; i < 2; i++ {
+		client, err := clients.GetClient("oci_core.BlockstorageClient")
+		assert.Nil(t, client)
+		var clientError *tfresource.ClientError
+		if assert.ErrorAs(t, err, &clientError) {
+			assert.Equal(t, "oci_core.BlockstorageClient", clientError.ClientName)
+			assert.EqualError(t, clientError.Err, "can not read private key")
+		}
+	}
+	// One call for the work request client and one for the blockstorage client
+	assert.Equal(t, 2, configureCount)
+
+	// The typed accessor returns a client whose requests fail with the error, instead of panicking
+	_, err = clients.BlockstorageClient().GetVolume(context.Background(), oci_core.GetVolumeRequest{VolumeId: oci_common.String("ocid1.volume.oc1..volume")})
+	assert.EqualError(t, err, "unable to create 'oci_core.BlockstorageClient' client: can not read private key")
+	assert.Equal(t, 2, configureCount)
+	_, err = clients.KmsCryptoClientWithEndpoint("https://kms.example.com")
+	assert.Error(t, err)
+}
+
+// contextRecordingDispatcher records the context of the requests it sends
+type contextRecordingDispatcher struct {
+	ctx context.Context
+}
+
+func (d *contextRecordingDispatcher) Do(r *http.Request) (*http.Response, error) {
+	d.ctx = r.Context()
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitClientsWithContext(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	privateKeyPem := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}))
+	configProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, testUserOCID, "us-phoenix-1", testKeyFingerPrint, privateKeyPem, nil)
+	dispatcher := &contextRecordingDispatcher{}
+	configureClient := func(client *oci_common.BaseClient) error {
+		client.HTTPClient = dispatcher
+		return nil
+	}
+
+	clients := &tf_client.OracleClients{
+		SdkClientMap:  make(map[string]interface{}),
+		Configuration: make(map[string]string),
+	}
+	assert.NoError(t, tf_client.CreateSDKClients(clients, configProvider, configureClient))
+	providerDispatcher := clients.BlockstorageClient().HTTPClient
+
+	ctx, cancel := context.WithCancel(context.Background())
+	operationClients, ok := clients.ClientsWithContext(ctx).(*tf_client.OracleClients)
+	assert.True(t, ok)
+
+	// The clients of the operation are copies of the provider clients, which are left as they are
+	blockstorageClient := operationClients.BlockstorageClient()
+	assert.True(t, blockstorageClient != clients.BlockstorageClient())
+	assert.True(t, blockstorageClient == operationClients.BlockstorageClient())
+	assert.Equal(t, providerDispatcher, clients.BlockstorageClient().HTTPClient)
+	assert.True(t, operationClients.WorkRequestClient != clients.WorkRequestClient)
+
+	// The waiters given the work request client only get the context of the operation from it
+	operationContextProvider, ok := operationClients.WorkRequestClient.HTTPClient.(interface{ OperationContext() context.Context })
+	if assert.True(t, ok) {
+		assert.Equal(t, ctx, operationContextProvider.OperationContext())
+	}
+
+	// Their requests made without a context that can be cancelled are sent with the context of the operation
+	request, err := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/volumes", nil)
+	assert.NoError(t, err)
+	_, err = blockstorageClient.HTTPClient.Do(request)
+	assert.NoError(t, err)
+	cancel()
+	assert.Equal(t, context.Canceled, dispatcher.ctx.Err())
+
+	requestCtx, cancelRequest := context.WithCancel(context.Background())
+	defer cancelRequest()
+	_, err = operationClients.WorkRequestClient.HTTPClient.Do(request.WithContext(requestCtx))
+	assert.NoError(t, err)
+	assert.True(t, requestCtx.Done() == dispatcher.ctx.Done())
+	assert.Equal(t, globalvar.WorkRequest, tf_client.ServiceNameFromContext(dispatcher.ctx))
+
+	_, err = clients.BlockstorageClient().HTTPClient.Do(request)
+	assert.NoError(t, err)
+	assert.NoError(t, dispatcher.ctx.Err())
+}
+
+func TestUnitValidateRetryServiceName(t *testing.T) {
+	for _, service := range []string{"identity", "objectstorage", "object_storage", "catalog", "work_request"} {
+		_, errs := validateRetryServiceName(service, globalvar.RetryAttrName)
+		assert.Empty(t, errs, service)
+	}
+
+	_, errs := validateRetryServiceName("iaas", globalvar.RetryAttrName)
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0].Error(), "unknown service 'iaas'")
+	}
+}
+
+func TestUnitValidateDefaultTimeoutsServiceName(t *testing.T) {
+	for _, service := range []string{"database", "database_management", "goldengate", "golden_gate"} {
+		_, errs := validateDefaultTimeoutsServiceName(service, globalvar.DefaultTimeoutsAttrName)
+		assert.Empty(t, errs, service)
+	}
+
+	_, errs := validateDefaultTimeoutsServiceName("db", globalvar.DefaultTimeoutsAttrName)
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0].Error(), "unknown service 'db'")
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+// Rate and concurrency limits set in the provider block. They take precedence over the limits set through the
+// environment, which are the only ones available to the export tool.
+var (
+	RateLimitsFromConfig        map[string]int
+	ConcurrencyLimitsFromConfig map[string]int
+)
+
+// tokenBucket allows up to `rate` requests per second, with bursts of up to `rate` requests
+type tokenBucket struct {
+	mutex      sync.Mutex
+	rate       float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(requestsPerSecond int) *tokenBucket {
+	return &tokenBucket{
+		rate:       float64(requestsPerSecond),
+		tokens:     float64(requestsPerSecond),
+		lastRefill: time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it
+func (b *tokenBucket) reserve() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.lastRefill).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.lastRefill = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// wait blocks until a request may be sent, or until the context is done
+func (b *tokenBucket) wait(ctx context.Context) error {
+	delay := b.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// serviceLimiter holds the limits that apply to all the requests sent to one service
+type serviceLimiter struct {
+	tokenBucket *tokenBucket
+	semaphore   chan struct{}
+}
+
+// rateLimitedDispatcher is an HTTPRequestDispatcher that throttles the requests sent to each service, before the
+// service has to throttle them with 429s. The service of a request is the one of the client sending it.
+type rateLimitedDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	limiters   map[string]*serviceLimiter
+}
+
+func (d *rateLimitedDispatcher) Do(r *http.Request) (*http.Response, error) {
+	limiter, ok := d.limiters[tf_client.ServiceNameFromContext(r.Context())]
+	if !ok {
+		return d.dispatcher.Do(r)
+	}
+
+	if limiter.semaphore != nil {
+		select {
+		case limiter.semaphore <- struct{}{}:
+			defer func() { <-limiter.semaphore }()
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		}
+	}
+	if limiter.tokenBucket != nil {
+		if err := limiter.tokenBucket.wait(r.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return d.dispatcher.Do(r)
+}
+
+// buildServiceLimiters returns the limiters for every service that has a rate limit or a concurrency limit, or nil
+// if there are none
+func buildServiceLimiters() (map[string]*serviceLimiter, error) {
+	rateLimits, err := getServiceLimits(globalvar.RateLimitsEnv, RateLimitsFromConfig)
+	if err != nil {
+		return nil, err
+	}
+	concurrencyLimits, err := getServiceLimits(globalvar.ConcurrencyLimitsEnv, ConcurrencyLimitsFromConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(rateLimits) == 0 && len(concurrencyLimits) == 0 {
+		return nil, nil
+	}
+
+	limiters := make(map[string]*serviceLimiter)
+	getLimiter := func(service string) *serviceLimiter {
+		if _, ok := limiters[service]; !ok {
+			limiters[service] = &serviceLimiter{}
+		}
+		return limiters[service]
+	}
+	for service, limit := range rateLimits {
+		getLimiter(service).tokenBucket = newTokenBucket(limit)
+		log.Printf("[DEBUG] Limiting requests to '%s' to %d per second", service, limit)
+	}
+	for service, limit := range concurrencyLimits {
+		getLimiter(service).semaphore = make(chan struct{}, limit)
+		log.Printf("[DEBUG] Limiting concurrent requests to '%s' to %d", service, limit)
+	}
+	return limiters, nil
+}
+
+// getServiceLimits merges the limits set in the environment, in the form "identity=5;core=20", with the ones set in
+// the provider block. The limits are keyed by the names of the services, as in the `endpoints` block.
+func getServiceLimits(envName string, limitsFromConfig map[string]int) (map[string]int, error) {
+	limits := make(map[string]int)
+	if limitsString := utils.GetEnvSettingWithBlankDefault(envName); limitsString != "" {
+		for _, item := range strings.Split(limitsString, globalvar.ColonDelimiter) {
+			serviceLimit := strings.Split(item, globalvar.EqualToOperatorDelimiter)
+			if len(serviceLimit) != 2 {
+				continue
+			}
+			limit, err := strconv.Atoi(strings.TrimSpace(serviceLimit[1]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid limit '%s' for service '%s' in %s: %v", serviceLimit[1], serviceLimit[0], envName, err)
+			}
+			limits[strings.ToLower(strings.TrimSpace(serviceLimit[0]))] = limit
+		}
+	}
+	for service, limit := range limitsFromConfig {
+		limits[strings.ToLower(service)] = limit
+	}
+	for service, limit := range limits {
+		if err := tf_client.ValidateServiceName(service); err != nil {
+			return nil, err
+		}
+		if limit <= 0 {
+			return nil, fmt.Errorf("limit for service '%s' must be greater than 0, got %d", service, limit)
+		}
+	}
+	return limits, nil
+}
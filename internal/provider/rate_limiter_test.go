@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/stretchr/testify/assert"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+type mockDispatcher struct {
+	inFlight    int32
+	maxInFlight int32
+	requests    int32
+}
+
+func (d *mockDispatcher) Do(r *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&d.requests, 1)
+	inFlight := atomic.AddInt32(&d.inFlight, 1)
+	defer atomic.AddInt32(&d.inFlight, -1)
+	for {
+		maxInFlight := atomic.LoadInt32(&d.maxInFlight)
+		if inFlight <= maxInFlight || atomic.CompareAndSwapInt32(&d.maxInFlight, maxInFlight, inFlight) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestUnitGetServiceLimits(t *testing.T) {
+	tests := []struct {
+		name             string
+		env              string
+		limitsFromConfig map[string]int
+		want             map[string]int
+		wantErr          bool
+	}{
+		{
+			name: "Test no limits",
+			want: map[string]int{},
+		},
+		{
+			name: "Test limits from env",
+			env:  "identity=5;Core=20",
+			want: map[string]int{"identity": 5, "core": 20},
+		},
+		{
+			name:             "Test limits from config override env",
+			env:              "identity=5;core=20",
+			limitsFromConfig: map[string]int{"core": 10},
+			want:             map[string]int{"identity": 5, "core": 10},
+		},
+		{
+			name:    "Test invalid limit in env",
+			env:     "identity=five",
+			wantErr: true,
+		},
+		{
+			name:    "Test unknown service in env",
+			env:     "iaas=5",
+			wantErr: true,
+		},
+		{
+			name:             "Test unknown service in config",
+			limitsFromConfig: map[string]int{"object_storage": 5},
+			wantErr:          true,
+		},
+		{
+			name:             "Test limit must be positive",
+			limitsFromConfig: map[string]int{"core": 0},
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv(globalvar.OciEnvPrefix+globalvar.RateLimitsEnv, tt.env)
+			defer os.Unsetenv(globalvar.OciEnvPrefix + globalvar.RateLimitsEnv)
+
+			limits, err := getServiceLimits(globalvar.RateLimitsEnv, tt.limitsFromConfig)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, limits)
+		})
+	}
+}
+
+func TestUnitTokenBucket(t *testing.T) {
+	bucket := newTokenBucket(2)
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+	assert.True(t, bucket.reserve() > 0, "expected to wait once the burst is used up")
+
+	// A cancelled context stops the wait
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, bucket.wait(ctx))
+}
+
+func TestUnitRateLimitedDispatcher(t *testing.T) {
+	dispatcher := &mockDispatcher{}
+	configureClient := func(client *oci_common.BaseClient) error {
+		client.HTTPClient = &rateLimitedDispatcher{
+			dispatcher: dispatcher,
+			limiters: map[string]*serviceLimiter{
+				"identity": {semaphore: make(chan struct{}, 2)},
+			},
+		}
+		return nil
+	}
+	clients := &tf_client.OracleClients{
+		SdkClientMap:  make(map[string]interface{}),
+		Configuration: make(map[string]string),
+	}
+	assert.NoError(t, tf_client.CreateSDKClients(clients, getTestRawConfigurationProvider(t, "us-phoenix-1"), configureClient))
+
+	// The requests are limited by the service of the client sending them, whatever their host
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			request, _ := http.NewRequest(http.MethodGet, "https://identity.example.com/20160918/users", nil)
+			_, err := clients.IdentityClient().HTTPClient.Do(request)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(10), dispatcher.requests)
+	assert.True(t, dispatcher.maxInFlight <= 2, "expected at most 2 concurrent requests, got %d", dispatcher.maxInFlight)
+
+	// Services without limits are not throttled
+	request, _ := http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+	_, err := clients.VirtualNetworkClient().HTTPClient.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(11), dispatcher.requests)
+}
+
+func TestUnitValidateServiceNameKeys(t *testing.T) {
+	_, errs := validateServiceNameKeys(map[string]interface{}{"core": 5, "objectstorage": 10}, globalvar.RateLimitsAttrName)
+	assert.Empty(t, errs)
+
+	_, errs = validateServiceNameKeys(map[string]interface{}{"iaas": 5}, globalvar.RateLimitsAttrName)
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0].Error(), "unknown service 'iaas'")
+	}
+}
+
+func TestUnitBuildClientConfigureFn_withRateLimits(t *testing.T) {
+	RateLimitsFromConfig = map[string]int{"identity": 5}
+	defer func() { RateLimitsFromConfig = nil }()
+
+	configureClientFn, err := BuildConfigureClientFn(oci_common.DefaultConfigProvider(), BuildHttpClient())
+	assert.NoError(t, err)
+
+	baseClient := &oci_common.BaseClient{}
+	assert.NoError(t, configureClientFn(baseClient))
+	_, ok := baseClient.HTTPClient.(*rateLimitedDispatcher)
+	assert.True(t, ok, "expected the http client to be rate limited")
+}
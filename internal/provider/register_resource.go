@@ -124,366 +124,367 @@ import (
 	tf_waa "github.com/oracle/terraform-provider-oci/internal/service/waa"
 	tf_waas "github.com/oracle/terraform-provider-oci/internal/service/waas"
 	tf_waf "github.com/oracle/terraform-provider-oci/internal/service/waf"
+	tf_resource "github.com/oracle/terraform-provider-oci/internal/tfresource"
 )
 
 func init() {
 
 	if common.CheckForEnabledServices("adm") {
-		tf_adm.RegisterResource()
+		tf_resource.RegisterServiceResources("adm", tf_adm.RegisterResource)
 	}
 	if common.CheckForEnabledServices("aianomalydetection") {
-		tf_ai_anomaly_detection.RegisterResource()
+		tf_resource.RegisterServiceResources("aianomalydetection", tf_ai_anomaly_detection.RegisterResource)
 	}
 	if common.CheckForEnabledServices("aidocument") {
-		tf_ai_document.RegisterResource()
+		tf_resource.RegisterServiceResources("aidocument", tf_ai_document.RegisterResource)
 	}
 	if common.CheckForEnabledServices("ailanguage") {
-		tf_ai_language.RegisterResource()
+		tf_resource.RegisterServiceResources("ailanguage", tf_ai_language.RegisterResource)
 	}
 	if common.CheckForEnabledServices("aivision") {
-		tf_ai_vision.RegisterResource()
+		tf_resource.RegisterServiceResources("aivision", tf_ai_vision.RegisterResource)
 	}
 	if common.CheckForEnabledServices("analytics") {
-		tf_analytics.RegisterResource()
+		tf_resource.RegisterServiceResources("analytics", tf_analytics.RegisterResource)
 	}
 	if common.CheckForEnabledServices("announcementsservice") {
-		tf_announcements_service.RegisterResource()
+		tf_resource.RegisterServiceResources("announcementsservice", tf_announcements_service.RegisterResource)
 	}
 	if common.CheckForEnabledServices("apigateway") {
-		tf_apigateway.RegisterResource()
+		tf_resource.RegisterServiceResources("apigateway", tf_apigateway.RegisterResource)
 	}
 	if common.CheckForEnabledServices("apm") {
-		tf_apm.RegisterResource()
+		tf_resource.RegisterServiceResources("apm", tf_apm.RegisterResource)
 	}
 	if common.CheckForEnabledServices("apmconfig") {
-		tf_apm_config.RegisterResource()
+		tf_resource.RegisterServiceResources("apmconfig", tf_apm_config.RegisterResource)
 	}
 	if common.CheckForEnabledServices("apmsynthetics") {
-		tf_apm_synthetics.RegisterResource()
+		tf_resource.RegisterServiceResources("apmsynthetics", tf_apm_synthetics.RegisterResource)
 	}
 	if common.CheckForEnabledServices("apmtraces") {
-		tf_apm_traces.RegisterResource()
+		tf_resource.RegisterServiceResources("apmtraces", tf_apm_traces.RegisterResource)
 	}
 	if common.CheckForEnabledServices("appmgmtcontrol") {
-		tf_appmgmt_control.RegisterResource()
+		tf_resource.RegisterServiceResources("appmgmtcontrol", tf_appmgmt_control.RegisterResource)
 	}
 	if common.CheckForEnabledServices("artifacts") {
-		tf_artifacts.RegisterResource()
+		tf_resource.RegisterServiceResources("artifacts", tf_artifacts.RegisterResource)
 	}
 	if common.CheckForEnabledServices("audit") {
-		tf_audit.RegisterResource()
+		tf_resource.RegisterServiceResources("audit", tf_audit.RegisterResource)
 	}
 	if common.CheckForEnabledServices("autoscaling") {
-		tf_autoscaling.RegisterResource()
+		tf_resource.RegisterServiceResources("autoscaling", tf_autoscaling.RegisterResource)
 	}
 	if common.CheckForEnabledServices("bastion") {
-		tf_bastion.RegisterResource()
+		tf_resource.RegisterServiceResources("bastion", tf_bastion.RegisterResource)
 	}
 	if common.CheckForEnabledServices("bds") {
-		tf_bds.RegisterResource()
+		tf_resource.RegisterServiceResources("bds", tf_bds.RegisterResource)
 	}
 	if common.CheckForEnabledServices("blockchain") {
-		tf_blockchain.RegisterResource()
+		tf_resource.RegisterServiceResources("blockchain", tf_blockchain.RegisterResource)
 	}
 	if common.CheckForEnabledServices("budget") {
-		tf_budget.RegisterResource()
+		tf_resource.RegisterServiceResources("budget", tf_budget.RegisterResource)
 	}
 	if common.CheckForEnabledServices("capacitymanagement") {
-		tf_capacity_management.RegisterResource()
+		tf_resource.RegisterServiceResources("capacitymanagement", tf_capacity_management.RegisterResource)
 	}
 	if common.CheckForEnabledServices("certificatesmanagement") {
-		tf_certificates_management.RegisterResource()
+		tf_resource.RegisterServiceResources("certificatesmanagement", tf_certificates_management.RegisterResource)
 	}
 	if common.CheckForEnabledServices("cloudbridge") {
-		tf_cloud_bridge.RegisterResource()
+		tf_resource.RegisterServiceResources("cloudbridge", tf_cloud_bridge.RegisterResource)
 	}
 	if common.CheckForEnabledServices("cloudguard") {
-		tf_cloud_guard.RegisterResource()
+		tf_resource.RegisterServiceResources("cloudguard", tf_cloud_guard.RegisterResource)
 	}
 	if common.CheckForEnabledServices("cloudmigrations") {
-		tf_cloud_migrations.RegisterResource()
+		tf_resource.RegisterServiceResources("cloudmigrations", tf_cloud_migrations.RegisterResource)
 	}
 	if common.CheckForEnabledServices("clusterplacementgroups") {
-		tf_cluster_placement_groups.RegisterResource()
+		tf_resource.RegisterServiceResources("clusterplacementgroups", tf_cluster_placement_groups.RegisterResource)
 	}
 	if common.CheckForEnabledServices("computecloudatcustomer") {
-		tf_compute_cloud_at_customer.RegisterResource()
+		tf_resource.RegisterServiceResources("computecloudatcustomer", tf_compute_cloud_at_customer.RegisterResource)
 	}
 	if common.CheckForEnabledServices("computeinstanceagent") {
-		tf_computeinstanceagent.RegisterResource()
+		tf_resource.RegisterServiceResources("computeinstanceagent", tf_computeinstanceagent.RegisterResource)
 	}
 	if common.CheckForEnabledServices("containerinstances") {
-		tf_container_instances.RegisterResource()
+		tf_resource.RegisterServiceResources("containerinstances", tf_container_instances.RegisterResource)
 	}
 	if common.CheckForEnabledServices("containerengine") {
-		tf_containerengine.RegisterResource()
+		tf_resource.RegisterServiceResources("containerengine", tf_containerengine.RegisterResource)
 	}
 	if common.CheckForEnabledServices("core") {
-		tf_core.RegisterResource()
+		tf_resource.RegisterServiceResources("core", tf_core.RegisterResource)
 	}
 	if common.CheckForEnabledServices("datalabelingservice") {
-		tf_data_labeling_service.RegisterResource()
+		tf_resource.RegisterServiceResources("datalabelingservice", tf_data_labeling_service.RegisterResource)
 	}
 	if common.CheckForEnabledServices("datasafe") {
-		tf_data_safe.RegisterResource()
+		tf_resource.RegisterServiceResources("datasafe", tf_data_safe.RegisterResource)
 	}
 	if common.CheckForEnabledServices("database") {
-		tf_database.RegisterResource()
+		tf_resource.RegisterServiceResources("database", tf_database.RegisterResource)
 	}
 	if common.CheckForEnabledServices("databasemanagement") {
-		tf_database_management.RegisterResource()
+		tf_resource.RegisterServiceResources("databasemanagement", tf_database_management.RegisterResource)
 	}
 	if common.CheckForEnabledServices("databasemigration") {
-		tf_database_migration.RegisterResource()
+		tf_resource.RegisterServiceResources("databasemigration", tf_database_migration.RegisterResource)
 	}
 	if common.CheckForEnabledServices("databasetools") {
-		tf_database_tools.RegisterResource()
+		tf_resource.RegisterServiceResources("databasetools", tf_database_tools.RegisterResource)
 	}
 	if common.CheckForEnabledServices("datacatalog") {
-		tf_datacatalog.RegisterResource()
+		tf_resource.RegisterServiceResources("datacatalog", tf_datacatalog.RegisterResource)
 	}
 	if common.CheckForEnabledServices("dataflow") {
-		tf_dataflow.RegisterResource()
+		tf_resource.RegisterServiceResources("dataflow", tf_dataflow.RegisterResource)
 	}
 	if common.CheckForEnabledServices("dataintegration") {
-		tf_dataintegration.RegisterResource()
+		tf_resource.RegisterServiceResources("dataintegration", tf_dataintegration.RegisterResource)
 	}
 	if common.CheckForEnabledServices("datascience") {
-		tf_datascience.RegisterResource()
+		tf_resource.RegisterServiceResources("datascience", tf_datascience.RegisterResource)
 	}
 	if common.CheckForEnabledServices("delegateaccesscontrol") {
-		tf_delegate_access_control.RegisterResource()
+		tf_resource.RegisterServiceResources("delegateaccesscontrol", tf_delegate_access_control.RegisterResource)
 	}
 	if common.CheckForEnabledServices("demandsignal") {
-		tf_demand_signal.RegisterResource()
+		tf_resource.RegisterServiceResources("demandsignal", tf_demand_signal.RegisterResource)
 	}
 	if common.CheckForEnabledServices("desktops") {
-		tf_desktops.RegisterResource()
+		tf_resource.RegisterServiceResources("desktops", tf_desktops.RegisterResource)
 	}
 	if common.CheckForEnabledServices("devops") {
-		tf_devops.RegisterResource()
+		tf_resource.RegisterServiceResources("devops", tf_devops.RegisterResource)
 	}
 	if common.CheckForEnabledServices("disasterrecovery") {
-		tf_disaster_recovery.RegisterResource()
+		tf_resource.RegisterServiceResources("disasterrecovery", tf_disaster_recovery.RegisterResource)
 	}
 	if common.CheckForEnabledServices("dns") {
-		tf_dns.RegisterResource()
+		tf_resource.RegisterServiceResources("dns", tf_dns.RegisterResource)
 	}
 	if common.CheckForEnabledServices("emwarehouse") {
-		tf_em_warehouse.RegisterResource()
+		tf_resource.RegisterServiceResources("emwarehouse", tf_em_warehouse.RegisterResource)
 	}
 	if common.CheckForEnabledServices("email") {
-		tf_email.RegisterResource()
+		tf_resource.RegisterServiceResources("email", tf_email.RegisterResource)
 	}
 	if common.CheckForEnabledServices("events") {
-		tf_events.RegisterResource()
+		tf_resource.RegisterServiceResources("events", tf_events.RegisterResource)
 	}
 	if common.CheckForEnabledServices("filestorage") {
-		tf_file_storage.RegisterResource()
+		tf_resource.RegisterServiceResources("filestorage", tf_file_storage.RegisterResource)
 	}
 	if common.CheckForEnabledServices("functions") {
-		tf_functions.RegisterResource()
+		tf_resource.RegisterServiceResources("functions", tf_functions.RegisterResource)
 	}
 	if common.CheckForEnabledServices("fusionapps") {
-		tf_fusion_apps.RegisterResource()
+		tf_resource.RegisterServiceResources("fusionapps", tf_fusion_apps.RegisterResource)
 	}
 	if common.CheckForEnabledServices("generativeai") {
-		tf_generative_ai.RegisterResource()
+		tf_resource.RegisterServiceResources("generativeai", tf_generative_ai.RegisterResource)
 	}
 	if common.CheckForEnabledServices("genericartifactscontent") {
-		tf_generic_artifacts_content.RegisterResource()
+		tf_resource.RegisterServiceResources("genericartifactscontent", tf_generic_artifacts_content.RegisterResource)
 	}
 	if common.CheckForEnabledServices("goldengate") {
-		tf_golden_gate.RegisterResource()
+		tf_resource.RegisterServiceResources("goldengate", tf_golden_gate.RegisterResource)
 	}
 	if common.CheckForEnabledServices("healthchecks") {
-		tf_health_checks.RegisterResource()
+		tf_resource.RegisterServiceResources("healthchecks", tf_health_checks.RegisterResource)
 	}
 	if common.CheckForEnabledServices("identity") {
-		tf_identity.RegisterResource()
+		tf_resource.RegisterServiceResources("identity", tf_identity.RegisterResource)
 	}
 	if common.CheckForEnabledServices("identitydataplane") {
-		tf_identity_data_plane.RegisterResource()
+		tf_resource.RegisterServiceResources("identitydataplane", tf_identity_data_plane.RegisterResource)
 	}
 	if common.CheckForEnabledServices("identitydomains") {
-		tf_identity_domains.RegisterResource()
+		tf_resource.RegisterServiceResources("identitydomains", tf_identity_domains.RegisterResource)
 	}
 	if common.CheckForEnabledServices("integration") {
-		tf_integration.RegisterResource()
+		tf_resource.RegisterServiceResources("integration", tf_integration.RegisterResource)
 	}
 	if common.CheckForEnabledServices("jms") {
-		tf_jms.RegisterResource()
+		tf_resource.RegisterServiceResources("jms", tf_jms.RegisterResource)
 	}
 	if common.CheckForEnabledServices("jmsjavadownloads") {
-		tf_jms_java_downloads.RegisterResource()
+		tf_resource.RegisterServiceResources("jmsjavadownloads", tf_jms_java_downloads.RegisterResource)
 	}
 	if common.CheckForEnabledServices("kms") {
-		tf_kms.RegisterResource()
+		tf_resource.RegisterServiceResources("kms", tf_kms.RegisterResource)
 	}
 	if common.CheckForEnabledServices("licensemanager") {
-		tf_license_manager.RegisterResource()
+		tf_resource.RegisterServiceResources("licensemanager", tf_license_manager.RegisterResource)
 	}
 	if common.CheckForEnabledServices("limits") {
-		tf_limits.RegisterResource()
+		tf_resource.RegisterServiceResources("limits", tf_limits.RegisterResource)
 	}
 	if common.CheckForEnabledServices("loadbalancer") {
-		tf_load_balancer.RegisterResource()
+		tf_resource.RegisterServiceResources("loadbalancer", tf_load_balancer.RegisterResource)
 	}
 	if common.CheckForEnabledServices("loganalytics") {
-		tf_log_analytics.RegisterResource()
+		tf_resource.RegisterServiceResources("loganalytics", tf_log_analytics.RegisterResource)
 	}
 	if common.CheckForEnabledServices("logging") {
-		tf_logging.RegisterResource()
+		tf_resource.RegisterServiceResources("logging", tf_logging.RegisterResource)
 	}
 	if common.CheckForEnabledServices("managementagent") {
-		tf_management_agent.RegisterResource()
+		tf_resource.RegisterServiceResources("managementagent", tf_management_agent.RegisterResource)
 	}
 	if common.CheckForEnabledServices("managementdashboard") {
-		tf_management_dashboard.RegisterResource()
+		tf_resource.RegisterServiceResources("managementdashboard", tf_management_dashboard.RegisterResource)
 	}
 	if common.CheckForEnabledServices("marketplace") {
-		tf_marketplace.RegisterResource()
+		tf_resource.RegisterServiceResources("marketplace", tf_marketplace.RegisterResource)
 	}
 	if common.CheckForEnabledServices("mediaservices") {
-		tf_media_services.RegisterResource()
+		tf_resource.RegisterServiceResources("mediaservices", tf_media_services.RegisterResource)
 	}
 	if common.CheckForEnabledServices("meteringcomputation") {
-		tf_metering_computation.RegisterResource()
+		tf_resource.RegisterServiceResources("meteringcomputation", tf_metering_computation.RegisterResource)
 	}
 	if common.CheckForEnabledServices("monitoring") {
-		tf_monitoring.RegisterResource()
+		tf_resource.RegisterServiceResources("monitoring", tf_monitoring.RegisterResource)
 	}
 	if common.CheckForEnabledServices("mysql") {
-		tf_mysql.RegisterResource()
+		tf_resource.RegisterServiceResources("mysql", tf_mysql.RegisterResource)
 	}
 	if common.CheckForEnabledServices("networkfirewall") {
-		tf_network_firewall.RegisterResource()
+		tf_resource.RegisterServiceResources("networkfirewall", tf_network_firewall.RegisterResource)
 	}
 	if common.CheckForEnabledServices("networkloadbalancer") {
-		tf_network_load_balancer.RegisterResource()
+		tf_resource.RegisterServiceResources("networkloadbalancer", tf_network_load_balancer.RegisterResource)
 	}
 	if common.CheckForEnabledServices("nosql") {
-		tf_nosql.RegisterResource()
+		tf_resource.RegisterServiceResources("nosql", tf_nosql.RegisterResource)
 	}
 	if common.CheckForEnabledServices("objectstorage") {
-		tf_objectstorage.RegisterResource()
+		tf_resource.RegisterServiceResources("objectstorage", tf_objectstorage.RegisterResource)
 	}
 	if common.CheckForEnabledServices("oce") {
-		tf_oce.RegisterResource()
+		tf_resource.RegisterServiceResources("oce", tf_oce.RegisterResource)
 	}
 	if common.CheckForEnabledServices("ocvp") {
-		tf_ocvp.RegisterResource()
+		tf_resource.RegisterServiceResources("ocvp", tf_ocvp.RegisterResource)
 	}
 	if common.CheckForEnabledServices("oda") {
-		tf_oda.RegisterResource()
+		tf_resource.RegisterServiceResources("oda", tf_oda.RegisterResource)
 	}
 	if common.CheckForEnabledServices("onesubscription") {
-		tf_onesubscription.RegisterResource()
+		tf_resource.RegisterServiceResources("onesubscription", tf_onesubscription.RegisterResource)
 	}
 	if common.CheckForEnabledServices("ons") {
-		tf_ons.RegisterResource()
+		tf_resource.RegisterServiceResources("ons", tf_ons.RegisterResource)
 	}
 	if common.CheckForEnabledServices("opa") {
-		tf_opa.RegisterResource()
+		tf_resource.RegisterServiceResources("opa", tf_opa.RegisterResource)
 	}
 	if common.CheckForEnabledServices("opensearch") {
-		tf_opensearch.RegisterResource()
+		tf_resource.RegisterServiceResources("opensearch", tf_opensearch.RegisterResource)
 	}
 	if common.CheckForEnabledServices("operatoraccesscontrol") {
-		tf_operator_access_control.RegisterResource()
+		tf_resource.RegisterServiceResources("operatoraccesscontrol", tf_operator_access_control.RegisterResource)
 	}
 	if common.CheckForEnabledServices("opsi") {
-		tf_opsi.RegisterResource()
+		tf_resource.RegisterServiceResources("opsi", tf_opsi.RegisterResource)
 	}
 	if common.CheckForEnabledServices("optimizer") {
-		tf_optimizer.RegisterResource()
+		tf_resource.RegisterServiceResources("optimizer", tf_optimizer.RegisterResource)
 	}
 	if common.CheckForEnabledServices("os_management_hub") {
-		tf_os_management_hub.RegisterResource()
+		tf_resource.RegisterServiceResources("osmanagementhub", tf_os_management_hub.RegisterResource)
 	}
 	if common.CheckForEnabledServices("osmanagement") {
-		tf_osmanagement.RegisterResource()
+		tf_resource.RegisterServiceResources("osmanagement", tf_osmanagement.RegisterResource)
 	}
 	if common.CheckForEnabledServices("ospgateway") {
-		tf_osp_gateway.RegisterResource()
+		tf_resource.RegisterServiceResources("ospgateway", tf_osp_gateway.RegisterResource)
 	}
 	if common.CheckForEnabledServices("osubbillingschedule") {
-		tf_osub_billing_schedule.RegisterResource()
+		tf_resource.RegisterServiceResources("osubbillingschedule", tf_osub_billing_schedule.RegisterResource)
 	}
 	if common.CheckForEnabledServices("osuborganizationsubscription") {
-		tf_osub_organization_subscription.RegisterResource()
+		tf_resource.RegisterServiceResources("osuborganizationsubscription", tf_osub_organization_subscription.RegisterResource)
 	}
 	if common.CheckForEnabledServices("osubsubscription") {
-		tf_osub_subscription.RegisterResource()
+		tf_resource.RegisterServiceResources("osubsubscription", tf_osub_subscription.RegisterResource)
 	}
 	if common.CheckForEnabledServices("osubusage") {
-		tf_osub_usage.RegisterResource()
+		tf_resource.RegisterServiceResources("osubusage", tf_osub_usage.RegisterResource)
 	}
 	if common.CheckForEnabledServices("psql") {
-		tf_psql.RegisterResource()
+		tf_resource.RegisterServiceResources("psql", tf_psql.RegisterResource)
 	}
 	if common.CheckForEnabledServices("queue") {
-		tf_queue.RegisterResource()
+		tf_resource.RegisterServiceResources("queue", tf_queue.RegisterResource)
 	}
 	if common.CheckForEnabledServices("recovery") {
-		tf_recovery.RegisterResource()
+		tf_resource.RegisterServiceResources("recovery", tf_recovery.RegisterResource)
 	}
 	if common.CheckForEnabledServices("redis") {
-		tf_redis.RegisterResource()
+		tf_resource.RegisterServiceResources("redis", tf_redis.RegisterResource)
 	}
 	if common.CheckForEnabledServices("resourcescheduler") {
-		tf_resource_scheduler.RegisterResource()
+		tf_resource.RegisterServiceResources("resourcescheduler", tf_resource_scheduler.RegisterResource)
 	}
 	if common.CheckForEnabledServices("resourcemanager") {
-		tf_resourcemanager.RegisterResource()
+		tf_resource.RegisterServiceResources("resourcemanager", tf_resourcemanager.RegisterResource)
 	}
 	if common.CheckForEnabledServices("sch") {
-		tf_sch.RegisterResource()
+		tf_resource.RegisterServiceResources("sch", tf_sch.RegisterResource)
 	}
 	if common.CheckForEnabledServices("secrets") {
-		tf_secrets.RegisterResource()
+		tf_resource.RegisterServiceResources("secrets", tf_secrets.RegisterResource)
 	}
 	if common.CheckForEnabledServices("servicecatalog") {
-		tf_service_catalog.RegisterResource()
+		tf_resource.RegisterServiceResources("servicecatalog", tf_service_catalog.RegisterResource)
 	}
 	if common.CheckForEnabledServices("servicemanagerproxy") {
-		tf_service_manager_proxy.RegisterResource()
+		tf_resource.RegisterServiceResources("servicemanagerproxy", tf_service_manager_proxy.RegisterResource)
 	}
 	if common.CheckForEnabledServices("servicemesh") {
-		tf_service_mesh.RegisterResource()
+		tf_resource.RegisterServiceResources("servicemesh", tf_service_mesh.RegisterResource)
 	}
 	if common.CheckForEnabledServices("stackmonitoring") {
-		tf_stack_monitoring.RegisterResource()
+		tf_resource.RegisterServiceResources("stackmonitoring", tf_stack_monitoring.RegisterResource)
 	}
 	if common.CheckForEnabledServices("streaming") {
-		tf_streaming.RegisterResource()
+		tf_resource.RegisterServiceResources("streaming", tf_streaming.RegisterResource)
 	}
 	if common.CheckForEnabledServices("usageproxy") {
-		tf_usage_proxy.RegisterResource()
+		tf_resource.RegisterServiceResources("usageproxy", tf_usage_proxy.RegisterResource)
 	}
 	if common.CheckForEnabledServices("vault") {
-		tf_vault.RegisterResource()
+		tf_resource.RegisterServiceResources("vault", tf_vault.RegisterResource)
 	}
 	if common.CheckForEnabledServices("vbsinst") {
-		tf_vbs_inst.RegisterResource()
+		tf_resource.RegisterServiceResources("vbsinst", tf_vbs_inst.RegisterResource)
 	}
 	if common.CheckForEnabledServices("visualbuilder") {
-		tf_visual_builder.RegisterResource()
+		tf_resource.RegisterServiceResources("visualbuilder", tf_visual_builder.RegisterResource)
 	}
 	if common.CheckForEnabledServices("vnmonitoring") {
-		tf_vn_monitoring.RegisterResource()
+		tf_resource.RegisterServiceResources("vnmonitoring", tf_vn_monitoring.RegisterResource)
 	}
 	if common.CheckForEnabledServices("vulnerabilityscanning") {
-		tf_vulnerability_scanning.RegisterResource()
+		tf_resource.RegisterServiceResources("vulnerabilityscanning", tf_vulnerability_scanning.RegisterResource)
 	}
 	if common.CheckForEnabledServices("waa") {
-		tf_waa.RegisterResource()
+		tf_resource.RegisterServiceResources("waa", tf_waa.RegisterResource)
 	}
 	if common.CheckForEnabledServices("waas") {
-		tf_waas.RegisterResource()
+		tf_resource.RegisterServiceResources("waas", tf_waas.RegisterResource)
 	}
 	if common.CheckForEnabledServices("waf") {
-		tf_waf.RegisterResource()
+		tf_resource.RegisterServiceResources("waf", tf_waf.RegisterResource)
 	}
 
 }
@@ -0,0 +1,186 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+// Requests with larger bodies, or bodies of unknown length, are not sent again after the security token is refreshed
+const maxReplayedBodySize = 10 * 1024 * 1024
+
+var securityTokenKeyIdRegex = regexp.MustCompile(`keyId="ST\$([^"]+)"`)
+
+// sessionTokenRefresherFromConfig is set when the provider is configured with auth set to SecurityToken
+var sessionTokenRefresherFromConfig *sessionTokenRefresher
+
+// sessionTokenRefresher refreshes the security token of a config file profile once it has expired, by running the
+// configured refresh command, or by picking up a token file refreshed outside of Terraform (e.g. `oci session refresh`)
+type sessionTokenRefresher struct {
+	// keyProvider reads the token file of the profile every time it is called
+	keyProvider    oci_common.KeyProvider
+	profile        string
+	refreshCommand string
+	now            func() time.Time
+
+	mutex sync.Mutex
+}
+
+func newSessionTokenRefresher(keyProvider oci_common.KeyProvider, profile string, refreshCommand string) *sessionTokenRefresher {
+	return &sessionTokenRefresher{keyProvider: keyProvider, profile: profile, refreshCommand: refreshCommand, now: time.Now}
+}
+
+func (r *sessionTokenRefresher) currentToken() (string, error) {
+	keyId, err := r.keyProvider.KeyID()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(keyId, "ST$"), nil
+}
+
+// expired returns true if the token has expired. Tokens whose expiry can not be read are not considered expired.
+func (r *sessionTokenRefresher) expired(token string) bool {
+	expiry, err := securityTokenExpiry(token)
+	if err != nil {
+		log.Printf("[DEBUG] unable to read the expiry of the security token: %v", err)
+		return false
+	}
+	return !r.now().Before(expiry)
+}
+
+// refresh makes sure that the token file holds a valid token other than expiredToken
+func (r *sessionTokenRefresher) refresh(expiredToken string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// The token may have been refreshed by another request, or outside of Terraform
+	if token, err := r.currentToken(); err == nil && token != expiredToken && !r.expired(token) {
+		return nil
+	}
+
+	if r.refreshCommand == "" {
+		return fmt.Errorf("the security token of profile '%s' has expired. Run `oci session refresh --profile %s` or set `%s` in the provider block to refresh it automatically",
+			r.profile, r.profile, globalvar.SessionTokenRefreshCommandAttrName)
+	}
+
+	log.Printf("[INFO] the security token of profile '%s' has expired, refreshing it", r.profile)
+	if _, err := runCommandVar(r.refreshCommand); err != nil {
+		return fmt.Errorf("the security token of profile '%s' has expired and could not be refreshed, %s failed: %v", r.profile, globalvar.SessionTokenRefreshCommandAttrName, err)
+	}
+
+	token, err := r.currentToken()
+	if err != nil {
+		return fmt.Errorf("the security token of profile '%s' could not be read after running %s: %v", r.profile, globalvar.SessionTokenRefreshCommandAttrName, err)
+	}
+	if token == expiredToken || r.expired(token) {
+		return fmt.Errorf("the security token of profile '%s' is still expired after running %s, the session may need to be authenticated again with `oci session authenticate`",
+			r.profile, globalvar.SessionTokenRefreshCommandAttrName)
+	}
+	return nil
+}
+
+// securityTokenExpiry returns the expiry of a security token, from the `exp` claim of the JWT
+func securityTokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("the security token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, err
+	}
+	claims := struct {
+		Exp int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, err
+	}
+	if claims.Exp == 0 {
+		return time.Time{}, fmt.Errorf("the security token has no expiry")
+	}
+	return time.Unix(claims.Exp, 0), nil
+}
+
+// sessionTokenRefreshDispatcher is an HTTPRequestDispatcher that refreshes the security token when a request fails
+// with a 401 because the token has expired, and sends the request again signed with the new token
+type sessionTokenRefreshDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	signer     oci_common.HTTPRequestSigner
+	refresher  *sessionTokenRefresher
+}
+
+func (d *sessionTokenRefreshDispatcher) Do(r *http.Request) (*http.Response, error) {
+	getBody := replayableBody(r)
+	response, err := d.dispatcher.Do(r)
+	if err != nil || response == nil || response.StatusCode != http.StatusUnauthorized {
+		return response, err
+	}
+
+	match := securityTokenKeyIdRegex.FindStringSubmatch(r.Header.Get("Authorization"))
+	if match == nil || !d.refresher.expired(match[1]) {
+		return response, err
+	}
+
+	if refreshErr := d.refresher.refresh(match[1]); refreshErr != nil {
+		response.Body.Close()
+		return nil, refreshErr
+	}
+	if getBody == nil {
+		log.Printf("[DEBUG] the security token was refreshed but the request to %s can not be sent again", r.URL.Path)
+		return response, err
+	}
+
+	body, bodyErr := getBody()
+	if bodyErr != nil {
+		return response, err
+	}
+	r.Body = body
+	if signErr := d.signer.Sign(r); signErr != nil {
+		response.Body.Close()
+		return nil, signErr
+	}
+	response.Body.Close()
+	return d.dispatcher.Do(r)
+}
+
+// replayableBody returns a function that returns the body of the request again, or nil if it can not be sent again
+func replayableBody(r *http.Request) func() (io.ReadCloser, error) {
+	if body := r.Body; body == nil || body == http.NoBody {
+		return func() (io.ReadCloser, error) { return body, nil }
+	}
+	if r.GetBody != nil {
+		return r.GetBody
+	}
+	if r.ContentLength < 0 || r.ContentLength > maxReplayedBodySize {
+		return nil
+	}
+
+	content, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		// Leave the request as it was, the error is returned when it is sent
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(content), r.Body), r.Body}
+		return nil
+	}
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(content))
+	return func() (io.ReadCloser, error) { return ioutil.NopCloser(bytes.NewReader(content)), nil }
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockSessionTokenKeyProvider struct {
+	token      string
+	privateKey *rsa.PrivateKey
+}
+
+func (p *mockSessionTokenKeyProvider) KeyID() (string, error) {
+	return "ST$" + p.token, nil
+}
+
+func (p *mockSessionTokenKeyProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	return p.privateKey, nil
+}
+
+type mockUnauthorizedDispatcher struct {
+	expiredToken string
+	bodies       []string
+}
+
+func (d *mockUnauthorizedDispatcher) Do(r *http.Request) (*http.Response, error) {
+	body := ""
+	if r.Body != nil {
+		content, _ := ioutil.ReadAll(r.Body)
+		body = string(content)
+	}
+	d.bodies = append(d.bodies, body)
+	if strings.Contains(r.Header.Get("Authorization"), d.expiredToken) {
+		return &http.Response{StatusCode: http.StatusUnauthorized, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+}
+
+func getTestSecurityToken(expiry time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"ocid1.user.oc1..fakeuser","exp":%d}`, expiry.Unix())))
+	return "eyJhbGciOiJSUzI1NiJ9." + payload + ".c2lnbmF0dXJl"
+}
+
+func TestUnitSecurityTokenExpiry(t *testing.T) {
+	expiry := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		token   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Test valid token", token: getTestSecurityToken(expiry), want: expiry},
+		{name: "Test token without expiry", token: "e30." + base64.RawURLEncoding.EncodeToString([]byte(`{}`)) + ".e30", wantErr: true},
+		{name: "Test token that is not a JWT", token: "token", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := securityTokenExpiry(tt.token)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.want.Equal(got))
+		})
+	}
+}
+
+func TestUnitSessionTokenRefresher_refresh(t *testing.T) {
+	now := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	expiredToken := getTestSecurityToken(now.Add(-time.Minute))
+	refreshedToken := getTestSecurityToken(now.Add(time.Hour))
+
+	tests := []struct {
+		name           string
+		tokenInFile    string
+		refreshCommand string
+		commandToken   string
+		commandErr     error
+		wantRuns       int
+		wantErr        string
+	}{
+		{
+			name:        "Test token file refreshed outside of Terraform",
+			tokenInFile: refreshedToken,
+		},
+		{
+			name:        "Test no refresh command",
+			tokenInFile: expiredToken,
+			wantErr:     "the security token of profile 'DEFAULT' has expired. Run `oci session refresh --profile DEFAULT` or set `session_token_refresh_command`",
+		},
+		{
+			name:           "Test refresh command",
+			tokenInFile:    expiredToken,
+			refreshCommand: "oci session refresh --profile DEFAULT",
+			commandToken:   refreshedToken,
+			wantRuns:       1,
+		},
+		{
+			name:           "Test refresh command fails",
+			tokenInFile:    expiredToken,
+			refreshCommand: "oci session refresh --profile DEFAULT",
+			commandErr:     errors.New("exit status 1: session is no longer valid"),
+			wantRuns:       1,
+			wantErr:        "could not be refreshed, session_token_refresh_command failed: exit status 1: session is no longer valid",
+		},
+		{
+			name:           "Test token still expired after refresh command",
+			tokenInFile:    expiredToken,
+			refreshCommand: "oci session refresh --profile DEFAULT",
+			commandToken:   expiredToken,
+			wantRuns:       1,
+			wantErr:        "is still expired after running session_token_refresh_command",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyProvider := &mockSessionTokenKeyProvider{token: tt.tokenInFile}
+			runs := 0
+			runCommandVar = func(command string) ([]byte, error) {
+				runs++
+				assert.Equal(t, tt.refreshCommand, command)
+				if tt.commandErr != nil {
+					return nil, tt.commandErr
+				}
+				keyProvider.token = tt.commandToken
+				return nil, nil
+			}
+			defer func() { runCommandVar = runCommand }()
+
+			refresher := newSessionTokenRefresher(keyProvider, "DEFAULT", tt.refreshCommand)
+			refresher.now = func() time.Time { return now }
+
+			err := refresher.refresh(expiredToken)
+			assert.Equal(t, tt.wantRuns, runs)
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUnitSessionTokenRefreshDispatcher(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	expiredToken := getTestSecurityToken(time.Now().Add(-time.Minute))
+	refreshedToken := getTestSecurityToken(time.Now().Add(time.Hour))
+
+	keyProvider := &mockSessionTokenKeyProvider{token: expiredToken, privateKey: privateKey}
+	runCommandVar = func(command string) ([]byte, error) {
+		keyProvider.token = refreshedToken
+		return nil, nil
+	}
+	defer func() { runCommandVar = runCommand }()
+
+	signer := oci_common.DefaultRequestSigner(keyProvider)
+	mockDispatcher := &mockUnauthorizedDispatcher{expiredToken: expiredToken}
+	dispatcher := &sessionTokenRefreshDispatcher{
+		dispatcher: mockDispatcher,
+		signer:     signer,
+		refresher:  newSessionTokenRefresher(keyProvider, "DEFAULT", "oci session refresh --profile DEFAULT"),
+	}
+
+	body := `{"displayName":"vcn"}`
+	request, _ := http.NewRequest(http.MethodPost, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", ioutil.NopCloser(strings.NewReader(body)))
+	request.ContentLength = int64(len(body))
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	assert.NoError(t, signer.Sign(request))
+
+	response, err := dispatcher.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, []string{body, body}, mockDispatcher.bodies, "expected the request to be sent again with the same body")
+	assert.Contains(t, request.Header.Get("Authorization"), refreshedToken)
+
+	// A 401 with a token that has not expired is returned as is
+	mockDispatcher.expiredToken = refreshedToken
+	mockDispatcher.bodies = nil
+	request, _ = http.NewRequest(http.MethodGet, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns", nil)
+	request.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	assert.NoError(t, signer.Sign(request))
+	response, err = dispatcher.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+	assert.Len(t, mockDispatcher.bodies, 1)
+}
@@ -0,0 +1,4 @@
+## This is tmp config to run import for resources
+
+resource oci_resource_type1 type1_res1 {}
+
@@ -0,0 +1,426 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl2/hcl"
+	"github.com/hashicorp/hcl2/hcl/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+// baselineResource is a resource of a previous export
+type baselineResource struct {
+	Id             string
+	TerraformClass string
+	TerraformName  string
+	Attributes     map[string]interface{}
+	// Partial is set when Attributes only has the attributes set to literal values in the configuration, as opposed to
+	// all the attributes of the resource in a state file
+	Partial bool
+}
+
+// exportBaseline is a previous export, keyed by the ID of its resources
+type exportBaseline map[string]*baselineResource
+
+// exportBaselineDiff is the report of the differences between the resources of a previous export and the ones discovered
+type exportBaselineDiff struct {
+	BaselinePath string                  `json:"baseline_path"`
+	Added        []*baselineDiffResource `json:"added"`
+	Removed      []*baselineDiffResource `json:"removed"`
+	Changed      []*baselineDiffResource `json:"changed"`
+}
+
+type baselineDiffResource struct {
+	Id             string                   `json:"id"`
+	TerraformClass string                   `json:"terraform_class"`
+	TerraformName  string                   `json:"terraform_name"`
+	Attributes     []*baselineAttributeDiff `json:"attributes,omitempty"`
+}
+
+// baselineAttributeDiff is a changed attribute, e.g. `create_vnic_details.0.display_name`. Old or New is nil if the
+// attribute was added or removed.
+type baselineAttributeDiff struct {
+	Name string  `json:"name"`
+	Old  *string `json:"old"`
+	New  *string `json:"new"`
+}
+
+// terraformStateV4 is the part of a Terraform state file (version 4, written by Terraform v0.12 and later) read for
+// a baseline
+type terraformStateV4 struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+/*
+loadExportBaseline loads the resources of a previous export from, in order:
+- the state file at the baseline path, or the terraform.tfstate file in the baseline directory
+- the import blocks in the imports.tf file of the baseline directory, with the literal attributes of the resources in its configuration
+*/
+func loadExportBaseline(baselinePath string) (exportBaseline, error) {
+	info, err := os.Stat(baselinePath)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] unable to read baseline_path: %s", err)
+	}
+	if !info.IsDir() {
+		return loadBaselineState(baselinePath)
+	}
+
+	statePath := filepath.Join(baselinePath, globalvar.DefaultStateFilename)
+	if _, err := os.Stat(statePath); err == nil {
+		return loadBaselineState(statePath)
+	}
+
+	if _, err := os.Stat(filepath.Join(baselinePath, globalvar.ImportsFile)); err == nil {
+		return loadBaselineConfiguration(baselinePath)
+	}
+
+	return nil, fmt.Errorf("[ERROR] baseline_path %s has neither a %s nor an %s file, export it with 'generate_state' or with 'import_mode=%s'",
+		baselinePath, globalvar.DefaultStateFilename, globalvar.ImportsFile, tf_export.ImportModeBlocks)
+}
+
+func loadBaselineState(statePath string) (exportBaseline, error) {
+	stateBytes, err := ioutil.ReadFile(statePath)
+	if err != nil {
+		return nil, err
+	}
+	var state terraformStateV4
+	if err := json.Unmarshal(stateBytes, &state); err != nil {
+		return nil, fmt.Errorf("[ERROR] unable to parse baseline state file %s: %s", statePath, err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("[ERROR] unsupported version %d of baseline state file %s, only state files written by Terraform v0.12 and above are supported", state.Version, statePath)
+	}
+
+	baseline := exportBaseline{}
+	for _, resource := range state.Resources {
+		if resource.Mode != "managed" {
+			continue
+		}
+		for _, instance := range resource.Instances {
+			id, _ := instance.Attributes["id"].(string)
+			if id == "" {
+				continue
+			}
+			baseline[id] = &baselineResource{
+				Id:             id,
+				TerraformClass: resource.Type,
+				TerraformName:  resource.Name,
+				Attributes:     instance.Attributes,
+			}
+		}
+	}
+	utils.Logf("[INFO] loaded %d resources from baseline state file %s", len(baseline), statePath)
+	return baseline, nil
+}
+
+func loadBaselineConfiguration(baselineDir string) (exportBaseline, error) {
+	files, err := filepath.Glob(filepath.Join(baselineDir, "*.tf"))
+	if err != nil {
+		return nil, err
+	}
+	// The configuration of the resources is in child modules if the baseline was exported with the modules layout
+	moduleFiles, err := filepath.Glob(filepath.Join(baselineDir, globalvar.ModulesDir, "*", "*.tf"))
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, moduleFiles...)
+
+	importIds := map[string]string{}
+	resourceAttributes := map[string]map[string]interface{}{}
+	for _, file := range files {
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		hclFile, diags := hclsyntax.ParseConfig(src, file, hcl.Pos{Line: 1, Column: 1})
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("[ERROR] unable to parse baseline configuration file %s: %s", file, diags.Error())
+		}
+		body, ok := hclFile.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			switch {
+			case block.Type == "import":
+				if to, id := importBlockAddressAndId(block); to != "" && id != "" {
+					importIds[to] = id
+				}
+			case block.Type == "resource" && len(block.Labels) == 2:
+				resourceAttributes[block.Labels[0]+"."+block.Labels[1]] = literalBodyAttributes(block.Body)
+			}
+		}
+	}
+
+	baseline := exportBaseline{}
+	for address, id := range importIds {
+		addressParts := strings.SplitN(address, ".", 2)
+		if len(addressParts) != 2 {
+			continue
+		}
+		baseline[id] = &baselineResource{
+			Id:             id,
+			TerraformClass: addressParts[0],
+			TerraformName:  addressParts[1],
+			Attributes:     resourceAttributes[address],
+			Partial:        true,
+		}
+	}
+	utils.Logf("[INFO] loaded %d resources from the import blocks of baseline configuration %s", len(baseline), baselineDir)
+	return baseline, nil
+}
+
+// importBlockAddressAndId returns the address of the resource an import block imports to, without the module it is
+// in, and its ID
+func importBlockAddressAndId(block *hclsyntax.Block) (string, string) {
+	toAttribute, hasTo := block.Body.Attributes["to"]
+	idAttribute, hasId := block.Body.Attributes["id"]
+	if !hasTo || !hasId {
+		return "", ""
+	}
+	traversal, diags := hcl.AbsTraversalForExpr(toAttribute.Expr)
+	if diags.HasErrors() {
+		return "", ""
+	}
+	// e.g. module.core.oci_core_vcn.export_vcn, with the modules layout
+	if len(traversal) == 4 && traversal.RootName() == "module" {
+		class, ok := traversal[2].(hcl.TraverseAttr)
+		if !ok {
+			return "", ""
+		}
+		traversal = hcl.Traversal{hcl.TraverseRoot{Name: class.Name}, traversal[3]}
+	}
+	if len(traversal) != 2 {
+		return "", ""
+	}
+	name, ok := traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return "", ""
+	}
+	id, diags := idAttribute.Expr.Value(nil)
+	if diags.HasErrors() || !id.IsWhollyKnown() || id.IsNull() || !id.Type().Equals(cty.String) {
+		return "", ""
+	}
+	return traversal.RootName() + "." + name.Name, id.AsString()
+}
+
+// literalBodyAttributes returns the attributes of a block set to literal values, with its nested blocks as lists,
+// as in the state. The attributes referencing variables or other resources are left out.
+func literalBodyAttributes(body *hclsyntax.Body) map[string]interface{} {
+	attributes := map[string]interface{}{}
+	for name, attribute := range body.Attributes {
+		value, diags := attribute.Expr.Value(nil)
+		if diags.HasErrors() || !value.IsWhollyKnown() {
+			continue
+		}
+		valueJson, err := ctyjson.SimpleJSONValue{Value: value}.MarshalJSON()
+		if err != nil {
+			continue
+		}
+		var jsonValue interface{}
+		if err := json.Unmarshal(valueJson, &jsonValue); err == nil {
+			attributes[name] = jsonValue
+		}
+	}
+	for _, block := range body.Blocks {
+		if block.Type == "lifecycle" {
+			continue
+		}
+		blocks, _ := attributes[block.Type].([]interface{})
+		attributes[block.Type] = append(blocks, literalBodyAttributes(block.Body))
+	}
+	return attributes
+}
+
+// diffWithBaseline compares the discovered resources with the ones of the baseline, by ID
+func diffWithBaseline(baselinePath string, baseline exportBaseline, discoveredResources []*tf_export.OCIResource) *exportBaselineDiff {
+	diff := &exportBaselineDiff{
+		BaselinePath: baselinePath,
+		Added:        []*baselineDiffResource{},
+		Removed:      []*baselineDiffResource{},
+		Changed:      []*baselineDiffResource{},
+	}
+
+	discoveredIds := map[string]bool{}
+	for _, resource := range discoveredResources {
+		if resource.IsErrorResource || resource.Id == "" || (resource.TerraformTypeInfo != nil && resource.TerraformTypeInfo.IsDataSource) {
+			continue
+		}
+		discoveredIds[resource.Id] = true
+
+		diffResource := &baselineDiffResource{
+			Id:             resource.Id,
+			TerraformClass: resource.TerraformClass,
+			TerraformName:  resource.TerraformName,
+		}
+		baselineResource, exists := baseline[resource.Id]
+		if !exists {
+			diff.Added = append(diff.Added, diffResource)
+			continue
+		}
+		if diffResource.Attributes = diffAttributes(baselineResource, resource.SourceAttributes); len(diffResource.Attributes) > 0 {
+			diff.Changed = append(diff.Changed, diffResource)
+		}
+	}
+
+	for id, resource := range baseline {
+		if !discoveredIds[id] {
+			diff.Removed = append(diff.Removed, &baselineDiffResource{
+				Id:             id,
+				TerraformClass: resource.TerraformClass,
+				TerraformName:  resource.TerraformName,
+			})
+		}
+	}
+
+	for _, resources := range [][]*baselineDiffResource{diff.Added, diff.Removed, diff.Changed} {
+		sort.Slice(resources, func(i, j int) bool {
+			if resources[i].TerraformClass != resources[j].TerraformClass {
+				return resources[i].TerraformClass < resources[j].TerraformClass
+			}
+			return resources[i].Id < resources[j].Id
+		})
+	}
+	return diff
+}
+
+// diffAttributes returns the attributes that differ between the baseline resource and the discovered attributes.
+// Only the attributes of the baseline are compared if it is partial.
+func diffAttributes(baselineResource *baselineResource, attributes map[string]interface{}) []*baselineAttributeDiff {
+	oldAttributes := map[string]string{}
+	flattenAttributes("", baselineResource.Attributes, oldAttributes)
+	newAttributes := map[string]string{}
+	flattenAttributes("", attributes, newAttributes)
+
+	names := map[string]bool{}
+	for name := range oldAttributes {
+		names[name] = true
+	}
+	if !baselineResource.Partial {
+		for name := range newAttributes {
+			names[name] = true
+		}
+	}
+	delete(names, "id")
+
+	var diffs []*baselineAttributeDiff
+	for name := range names {
+		oldValue, oldExists := oldAttributes[name]
+		newValue, newExists := newAttributes[name]
+		if oldExists == newExists && oldValue == newValue {
+			continue
+		}
+		attributeDiff := &baselineAttributeDiff{Name: name}
+		if oldExists {
+			attributeDiff.Old = &oldValue
+		}
+		if newExists {
+			attributeDiff.New = &newValue
+		}
+		diffs = append(diffs, attributeDiff)
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Name < diffs[j].Name
+	})
+	return diffs
+}
+
+// flattenAttributes flattens the attributes into keys such as `create_vnic_details.0.display_name`, leaving out the
+// empty ones as the state and the discovered resources do not tell them apart from unset ones
+func flattenAttributes(prefix string, value interface{}, result map[string]string) {
+	key := func(name string) string {
+		if prefix == "" {
+			return name
+		}
+		return prefix + "." + name
+	}
+
+	switch v := value.(type) {
+	case nil:
+	case map[string]interface{}:
+		for name, item := range v {
+			flattenAttributes(key(name), item, result)
+		}
+	case map[string]string:
+		for name, item := range v {
+			flattenAttributes(key(name), item, result)
+		}
+	case []interface{}:
+		for idx, item := range v {
+			flattenAttributes(key(strconv.Itoa(idx)), item, result)
+		}
+	case []string:
+		for idx, item := range v {
+			flattenAttributes(key(strconv.Itoa(idx)), item, result)
+		}
+	case string:
+		if v != "" {
+			result[prefix] = v
+		}
+	case float64:
+		result[prefix] = strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		result[prefix] = strconv.FormatFloat(float64(v), 'f', -1, 32)
+	default:
+		result[prefix] = fmt.Sprint(v)
+	}
+}
+
+// writeBaselineDiff writes the report of the differences with the baseline in the output directory
+func writeBaselineDiff(ctx *tf_export.ResourceDiscoveryContext, diff *exportBaselineDiff) error {
+	diffOutputFile := filepath.Join(*ctx.OutputDir, globalvar.BaselineDiffFile)
+	diffJson, err := json.MarshalIndent(diff, "", "\t")
+	if err != nil {
+		return fmt.Errorf("[ERROR] error marshalling baseline diff to JSON: %v", err)
+	}
+	if err := ioutil.WriteFile(diffOutputFile, diffJson, 0644); err != nil {
+		return err
+	}
+
+	ctx.SummaryStatements = append(ctx.SummaryStatements, fmt.Sprintf("Compared with baseline '%s': %d added, %d removed and %d changed resources. Report generated under '%s'",
+		diff.BaselinePath, len(diff.Added), len(diff.Removed), len(diff.Changed), diffOutputFile))
+	return nil
+}
+
+// excludeBaselineResources leaves the resources of the baseline out of the configuration written by the steps, and
+// replaces the references to them with their values. Data sources are kept, as the new resources may reference them.
+func excludeBaselineResources(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep, baseline exportBaseline) {
+	for _, step := range steps {
+		baseStep := step.getBaseStep()
+		newResources := make([]*tf_export.OCIResource, 0, len(baseStep.discoveredResources))
+		for _, resource := range baseStep.discoveredResources {
+			isDataSource := resource.TerraformTypeInfo != nil && resource.TerraformTypeInfo.IsDataSource
+			if _, exists := baseline[resource.Id]; !exists || isDataSource {
+				newResources = append(newResources, resource)
+				continue
+			}
+			deleteReferencesToResource(tf_export.ReferenceMap, resource)
+			// The resource is still discovered, but not exported
+			ctx.DiscoveredResources = append(ctx.DiscoveredResources, resource)
+		}
+		baseStep.discoveredResources = newResources
+	}
+}
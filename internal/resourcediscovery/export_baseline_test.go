@@ -0,0 +1,270 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+func newBaselineTestResource(class string, name string, id string, attributes map[string]interface{}) *tf_export.OCIResource {
+	return &tf_export.OCIResource{
+		TerraformResource: tf_export.TerraformResource{
+			Id:             id,
+			TerraformClass: class,
+			TerraformName:  name,
+		},
+		SourceAttributes: attributes,
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitLoadExportBaseline_state(t *testing.T) {
+	baselineDir := t.TempDir()
+	state := `{
+	"version": 4,
+	"resources": [
+		{
+			"mode": "managed",
+			"type": "oci_core_vcn",
+			"name": "export_vcn1",
+			"instances": [{"attributes": {"id": "ocid1.vcn.oc1..aaaa", "display_name": "vcn1", "cidr_blocks": ["10.0.0.0/16"]}}]
+		},
+		{
+			"mode": "data",
+			"type": "oci_identity_availability_domain",
+			"name": "export_ad1",
+			"instances": [{"attributes": {"id": "ocid1.ad.oc1..aaaa"}}]
+		}
+	]
+}`
+	assert.NoError(t, os.WriteFile(filepath.Join(baselineDir, globalvar.DefaultStateFilename), []byte(state), 0644))
+
+	// The state file is loaded from the baseline directory, or from its path
+	for _, baselinePath := range []string{baselineDir, filepath.Join(baselineDir, globalvar.DefaultStateFilename)} {
+		baseline, err := loadExportBaseline(baselinePath)
+		assert.NoError(t, err)
+		assert.Equal(t, exportBaseline{
+			"ocid1.vcn.oc1..aaaa": {
+				Id:             "ocid1.vcn.oc1..aaaa",
+				TerraformClass: "oci_core_vcn",
+				TerraformName:  "export_vcn1",
+				Attributes:     map[string]interface{}{"id": "ocid1.vcn.oc1..aaaa", "display_name": "vcn1", "cidr_blocks": []interface{}{"10.0.0.0/16"}},
+			},
+		}, baseline)
+	}
+
+	assert.NoError(t, os.WriteFile(filepath.Join(baselineDir, globalvar.DefaultStateFilename), []byte(`{"version": 3}`), 0644))
+	_, err := loadExportBaseline(baselineDir)
+	assert.Error(t, err)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitLoadExportBaseline_importBlocks(t *testing.T) {
+	baselineDir := t.TempDir()
+	imports := `import {
+  to = oci_core_vcn.export_vcn1
+  id = "ocid1.vcn.oc1..aaaa"
+}
+
+import {
+  to = module.core.oci_core_subnet.export_subnet1
+  id = "ocid1.subnet.oc1..aaaa"
+}
+`
+	core := `resource oci_core_vcn export_vcn1 {
+  compartment_id = var.compartment_ocid
+  display_name   = "vcn1"
+  cidr_blocks    = ["10.0.0.0/16"]
+  lifecycle {
+    ignore_changes = [defined_tags]
+  }
+}
+`
+	// The subnet is in a child module, as exported with the modules layout
+	coreModule := `resource oci_core_subnet export_subnet1 {
+  vcn_id     = var.oci_core_vcn_export_vcn1_id
+  cidr_block = "10.0.1.0/24"
+  prohibit_internet_ingress = true
+}
+`
+	assert.NoError(t, os.WriteFile(filepath.Join(baselineDir, globalvar.ImportsFile), []byte(imports), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(baselineDir, "core.tf"), []byte(core), 0644))
+	assert.NoError(t, os.MkdirAll(filepath.Join(baselineDir, globalvar.ModulesDir, "core"), os.ModePerm))
+	assert.NoError(t, os.WriteFile(filepath.Join(baselineDir, globalvar.ModulesDir, "core", "core.tf"), []byte(coreModule), 0644))
+
+	baseline, err := loadExportBaseline(baselineDir)
+	assert.NoError(t, err)
+	assert.Equal(t, exportBaseline{
+		"ocid1.vcn.oc1..aaaa": {
+			Id:             "ocid1.vcn.oc1..aaaa",
+			TerraformClass: "oci_core_vcn",
+			TerraformName:  "export_vcn1",
+			Attributes:     map[string]interface{}{"display_name": "vcn1", "cidr_blocks": []interface{}{"10.0.0.0/16"}},
+			Partial:        true,
+		},
+		"ocid1.subnet.oc1..aaaa": {
+			Id:             "ocid1.subnet.oc1..aaaa",
+			TerraformClass: "oci_core_subnet",
+			TerraformName:  "export_subnet1",
+			Attributes:     map[string]interface{}{"cidr_block": "10.0.1.0/24", "prohibit_internet_ingress": true},
+			Partial:        true,
+		},
+	}, baseline)
+
+	// A directory without a state file or import blocks is not a baseline
+	_, err = loadExportBaseline(t.TempDir())
+	assert.Error(t, err)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitDiffWithBaseline(t *testing.T) {
+	baseline := exportBaseline{
+		"ocid1.vcn.oc1..aaaa": {
+			Id:             "ocid1.vcn.oc1..aaaa",
+			TerraformClass: "oci_core_vcn",
+			TerraformName:  "export_vcn1",
+			Attributes:     map[string]interface{}{"id": "ocid1.vcn.oc1..aaaa", "display_name": "vcn1", "cidr_blocks": []interface{}{"10.0.0.0/16"}, "dns_label": ""},
+		},
+		"ocid1.subnet.oc1..aaaa": {
+			Id:             "ocid1.subnet.oc1..aaaa",
+			TerraformClass: "oci_core_subnet",
+			TerraformName:  "export_subnet1",
+			Attributes:     map[string]interface{}{"cidr_block": "10.0.1.0/24"},
+			Partial:        true,
+		},
+		"ocid1.subnet.oc1..bbbb": {
+			Id:             "ocid1.subnet.oc1..bbbb",
+			TerraformClass: "oci_core_subnet",
+			TerraformName:  "export_subnet2",
+		},
+	}
+
+	failedResource := newBaselineTestResource("oci_core_subnet", "export_subnet3", "ocid1.subnet.oc1..dddd", nil)
+	failedResource.IsErrorResource = true
+	dataSource := newBaselineTestResource("oci_identity_availability_domain", "export_ad1", "ocid1.ad.oc1..aaaa", nil)
+	dataSource.TerraformTypeInfo = &tf_export.TerraformResourceHints{IsDataSource: true}
+	discoveredResources := []*tf_export.OCIResource{
+		newBaselineTestResource("oci_core_vcn", "export_vcn1", "ocid1.vcn.oc1..aaaa",
+			map[string]interface{}{"id": "ocid1.vcn.oc1..aaaa", "display_name": "vcn2", "cidr_blocks": []interface{}{"10.0.0.0/16", "10.1.0.0/16"}}),
+		// Only the attributes of the partial baseline are compared
+		newBaselineTestResource("oci_core_subnet", "export_subnet1", "ocid1.subnet.oc1..aaaa",
+			map[string]interface{}{"cidr_block": "10.0.1.0/24", "display_name": "subnet1"}),
+		newBaselineTestResource("oci_core_subnet", "export_subnet4", "ocid1.subnet.oc1..cccc", nil),
+		failedResource,
+		dataSource,
+	}
+
+	diff := diffWithBaseline("baseline", baseline, discoveredResources)
+	assert.Equal(t, &exportBaselineDiff{
+		BaselinePath: "baseline",
+		Added: []*baselineDiffResource{
+			{Id: "ocid1.subnet.oc1..cccc", TerraformClass: "oci_core_subnet", TerraformName: "export_subnet4"},
+		},
+		Removed: []*baselineDiffResource{
+			{Id: "ocid1.subnet.oc1..bbbb", TerraformClass: "oci_core_subnet", TerraformName: "export_subnet2"},
+		},
+		Changed: []*baselineDiffResource{
+			{
+				Id:             "ocid1.vcn.oc1..aaaa",
+				TerraformClass: "oci_core_vcn",
+				TerraformName:  "export_vcn1",
+				Attributes: []*baselineAttributeDiff{
+					{Name: "cidr_blocks.1", New: stringPtr("10.1.0.0/16")},
+					{Name: "display_name", Old: stringPtr("vcn1"), New: stringPtr("vcn2")},
+				},
+			},
+		},
+	}, diff)
+
+	ctx := getTestCtx()
+	defer os.RemoveAll(*ctx.OutputDir)
+	assert.NoError(t, writeBaselineDiff(ctx, diff))
+	diffJson, err := os.ReadFile(filepath.Join(*ctx.OutputDir, globalvar.BaselineDiffFile))
+	assert.NoError(t, err)
+	var writtenDiff exportBaselineDiff
+	assert.NoError(t, json.Unmarshal(diffJson, &writtenDiff))
+	assert.Equal(t, diff, &writtenDiff)
+	assert.Contains(t, ctx.SummaryStatements[len(ctx.SummaryStatements)-1], "1 added, 1 removed and 1 changed resources")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitFlattenAttributes(t *testing.T) {
+	result := map[string]string{}
+	flattenAttributes("", map[string]interface{}{
+		"display_name":        "vcn1",
+		"description":         "",
+		"is_ipv6enabled":      false,
+		"memory_in_gbs":       float64(16),
+		"ocpus":               1.5,
+		"defined_tags":        map[string]interface{}{"ns.key": "value"},
+		"freeform_tags":       map[string]string{"key": "value"},
+		"cidr_blocks":         []interface{}{"10.0.0.0/16"},
+		"nsg_ids":             []string{"ocid1.nsg.oc1..aaaa"},
+		"create_vnic_details": []interface{}{map[string]interface{}{"display_name": "vnic1", "private_ip": nil}},
+	}, result)
+	assert.Equal(t, map[string]string{
+		"display_name":                       "vcn1",
+		"is_ipv6enabled":                     "false",
+		"memory_in_gbs":                      "16",
+		"ocpus":                              "1.5",
+		"defined_tags.ns.key":                "value",
+		"freeform_tags.key":                  "value",
+		"cidr_blocks.0":                      "10.0.0.0/16",
+		"nsg_ids.0":                          "ocid1.nsg.oc1..aaaa",
+		"create_vnic_details.0.display_name": "vnic1",
+	}, result)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExcludeBaselineResources(t *testing.T) {
+	ctx := getTestCtx()
+	defer os.RemoveAll(*ctx.OutputDir)
+	defer func(referenceMap map[string]string, failedResourceReferenceSet map[string]bool, resourceNameSet map[string]bool) {
+		tf_export.ReferenceMap = referenceMap
+		tf_export.FailedResourceReferenceSet = failedResourceReferenceSet
+		referenceResourceNameSet = resourceNameSet
+	}(tf_export.ReferenceMap, tf_export.FailedResourceReferenceSet, referenceResourceNameSet)
+	referenceResourceNameSet = nil
+	tf_export.ReferenceMap = map[string]string{
+		"ocid1.vcn.oc1..aaaa":    "oci_core_vcn.export_vcn1.id",
+		"ocid1.subnet.oc1..cccc": "oci_core_subnet.export_subnet4.id",
+	}
+	tf_export.FailedResourceReferenceSet = map[string]bool{}
+
+	vcn := newBaselineTestResource("oci_core_vcn", "export_vcn1", "ocid1.vcn.oc1..aaaa", nil)
+	newSubnet := newBaselineTestResource("oci_core_subnet", "export_subnet4", "ocid1.subnet.oc1..cccc", nil)
+	dataSource := newBaselineTestResource("oci_identity_availability_domain", "export_ad1", "ocid1.ad.oc1..aaaa", nil)
+	dataSource.TerraformTypeInfo = &tf_export.TerraformResourceHints{IsDataSource: true}
+	step := &resourceDiscoveryWithGraph{
+		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+			ctx:                 ctx,
+			name:                "test",
+			discoveredResources: []*tf_export.OCIResource{vcn, newSubnet, dataSource},
+		},
+	}
+	baseline := exportBaseline{
+		"ocid1.vcn.oc1..aaaa": {Id: "ocid1.vcn.oc1..aaaa", TerraformClass: "oci_core_vcn", TerraformName: "export_vcn1"},
+		"ocid1.ad.oc1..aaaa":  {Id: "ocid1.ad.oc1..aaaa", TerraformClass: "oci_identity_availability_domain", TerraformName: "export_ad1"},
+	}
+
+	excludeBaselineResources(ctx, []resourceDiscoveryStep{step}, baseline)
+	assert.Equal(t, []*tf_export.OCIResource{newSubnet, dataSource}, step.discoveredResources)
+	assert.Equal(t, []*tf_export.OCIResource{vcn}, ctx.DiscoveredResources)
+	// The new resources reference the values of the resources left out
+	assert.NotContains(t, tf_export.ReferenceMap, "ocid1.vcn.oc1..aaaa")
+	assert.Contains(t, tf_export.ReferenceMap, "ocid1.subnet.oc1..cccc")
+	assert.True(t, tf_export.FailedResourceReferenceSet["oci_core_vcn.export_vcn1"])
+}
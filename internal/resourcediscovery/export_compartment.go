@@ -19,6 +19,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/hcl2/hclwrite"
 
 	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
 
@@ -132,7 +133,11 @@ func init() {
 	tf_export.IsMissingRequiredAttributes = false
 }
 
-func printResourceGraphResources(resourceGraphs map[string]tf_export.TerraformResourceGraph, scope string) error {
+func printResourceGraphResources(resourceGraphs map[string]tf_export.TerraformResourceGraph, scope string, outputFormat tf_export.OutputFormatEnum) error {
+	if outputFormat == tf_export.OutputFormatJson {
+		return printResourceGraphsJson(resourceGraphs, scope)
+	}
+
 	for graphName, resourceGraph := range resourceGraphs {
 		// Need a set here because the same resource type may have multiple associations in the same graph
 		// This avoids adding duplicates of those resource types
@@ -165,17 +170,27 @@ func printResourceGraphResources(resourceGraphs map[string]tf_export.TerraformRe
 	return nil
 }
 
-func RunListExportableResourcesCommand() error {
+// RunListExportableResourcesCommand prints the discoverable resources of each service, or, with OutputFormatJson,
+// the resource graphs of the tenancy and compartment scopes as a JSON document each
+func RunListExportableResourcesCommand(outputFormat tf_export.OutputFormatEnum) error {
+	switch outputFormat {
+	case "", tf_export.OutputFormatHcl, tf_export.OutputFormatJson:
+	default:
+		return fmt.Errorf("[ERROR] invalid value for argument output_format '%s', supported values: %s, %s", outputFormat, tf_export.OutputFormatHcl, tf_export.OutputFormatJson)
+	}
+
 	tf_export.ResourcesMap = tf_provider.ResourcesMap()
 	tf_export.DatasourcesMap = tf_provider.DataSourcesMap()
 
-	utils.Logln("List of Discoverable Oracle Cloud Infrastructure Resources")
+	if outputFormat != tf_export.OutputFormatJson {
+		utils.Logln("List of Discoverable Oracle Cloud Infrastructure Resources")
+	}
 
-	if err := printResourceGraphResources(tf_export.TenancyResourceGraphs, "tenancy"); err != nil {
+	if err := printResourceGraphResources(tf_export.TenancyResourceGraphs, TenancyScope, outputFormat); err != nil {
 		return err
 	}
 
-	if err := printResourceGraphResources(tf_export.CompartmentResourceGraphs, "compartment"); err != nil {
+	if err := printResourceGraphResources(tf_export.CompartmentResourceGraphs, CompartmentScope, outputFormat); err != nil {
 		return err
 	}
 	return nil
@@ -324,6 +339,7 @@ func RunExportCommand(args *tf_export.ExportCommandArgs) (err error, status Stat
 	}
 
 	utils.Logf("[INFO] resource discovery retry timeout duration set to %v", tfresource.ShortRetryTime)
+	defer tfresource.LogServiceHealthSummary()
 
 	if err := runExportCommand(ctx); err != nil {
 		utils.Logln(err.Error())
@@ -403,6 +419,16 @@ func runExportCommand(ctx *tf_export.ResourceDiscoveryContext) error {
 	defer ctx.PrintSummary()
 	exportStart := time.Now()
 	defer elapsed("entire export command", nil, 0)()
+
+	// Load the baseline before discovering resources, as it can not be compared with them if it fails to load
+	var baseline exportBaseline
+	if ctx.BaselinePath != "" {
+		var err error
+		if baseline, err = loadExportBaseline(ctx.BaselinePath); err != nil {
+			return err
+		}
+	}
+
 	steps, err := getDiscoverResourceSteps(ctx)
 	if err != nil {
 		return err
@@ -489,6 +515,19 @@ func runExportCommand(ctx *tf_export.ResourceDiscoveryContext) error {
 	// Reset discovered resources if already set by writeTmpConfigurationForImport
 	ctx.DiscoveredResources = make([]*tf_export.OCIResource, 0)
 
+	if baseline != nil && ctx.BaselineNewResourcesOnly {
+		excludeBaselineResources(ctx, steps, baseline)
+	}
+
+	moduleLayout = nil
+	if ctx.OutputLayout == tf_export.OutputLayoutModules {
+		moduleLayout = newExportModuleLayout(ctx, steps)
+	}
+
+	if compartmentLayout != nil {
+		compartmentLayout.addResources(steps)
+	}
+
 	/*
 		sem allows number of steps equals to arg.Parallelism to execute in parallel.
 		arg.Parallelism is very less compare to total number of steps
@@ -547,18 +586,48 @@ func runExportCommand(ctx *tf_export.ResourceDiscoveryContext) error {
 		return errs
 	}
 
+	if baseline != nil {
+		if err := writeBaselineDiff(ctx, diffWithBaseline(ctx.BaselinePath, baseline, ctx.DiscoveredResources)); err != nil {
+			return err
+		}
+	}
+
+	if ctx.OutputFormat == tf_export.OutputFormatJson || ctx.OutputFormat == tf_export.OutputFormatYaml {
+		if err := writeExportInventory(ctx); err != nil {
+			return err
+		}
+	}
+
 	region, err := exportConfigProvider.Region()
 	if err != nil {
 		return err
 	}
 	tf_export.Vars["region"] = fmt.Sprintf("\"%s\"", region)
 
-	if err := generateProviderFile(ctx.OutputDir); err != nil {
-		return err
-	}
+	if compartmentLayout != nil {
+		if err := generateCompartmentsFiles(ctx); err != nil {
+			return err
+		}
+	} else {
+		if err := generateProviderFile(ctx.OutputDir); err != nil {
+			return err
+		}
 
-	if err := generateVarsFile(tf_export.Vars, ctx.OutputDir); err != nil {
-		return err
+		if err := generateVarsFile(tf_export.Vars, ctx.OutputDir); err != nil {
+			return err
+		}
+
+		if moduleLayout != nil {
+			if err := generateModulesFiles(ctx); err != nil {
+				return err
+			}
+		}
+
+		if ctx.ImportMode == tf_export.ImportModeBlocks {
+			if err := generateImportsFile(ctx, steps); err != nil {
+				return err
+			}
+		}
 	}
 
 	if tf_export.IsMissingRequiredAttributes {
@@ -787,10 +856,7 @@ func importResource(ctx *tf_export.ResourceDiscoveryContext, resource *tf_export
 		return
 	}
 
-	importId := resource.ImportId
-	if len(importId) == 0 {
-		importId = resource.Id
-	}
+	importId := getImportId(resource)
 
 	importArgs := []tfexec.ImportOption{
 		tfexecConfigVar(*ctx.OutputDir),
@@ -852,6 +918,16 @@ func getDiscoverResourceWithGraphSteps(ctx *tf_export.ResourceDiscoveryContext)
 	}
 	var result []resourceDiscoveryStep
 
+	// Walk the sub-compartments before building the steps, so that their resources are discovered with the same parallelism
+	compartmentLayout = nil
+	if ctx.Recursive {
+		layout, err := newExportCompartmentLayout(ctx)
+		if err != nil {
+			return nil, err
+		}
+		compartmentLayout = layout
+	}
+
 	// Discover tenancy scope resources only if compartmentId is tenancy ocid
 	if *ctx.CompartmentId == ctx.TenancyOcid {
 		tenancyResource := &tf_export.OCIResource{
@@ -899,6 +975,29 @@ func getDiscoverResourceWithGraphSteps(ctx *tf_export.ResourceDiscoveryContext)
 		}
 	}
 
+	// The sub-compartments are referenced as `var.compartment_ocid` in their own directory only
+	if compartmentLayout != nil {
+		for _, compartmentId := range compartmentLayout.compartmentIds[1:] {
+			subCompartmentResource := &tf_export.OCIResource{
+				CompartmentId: compartmentId,
+				TerraformResource: tf_export.TerraformResource{
+					Id:             compartmentId,
+					TerraformClass: "oci_identity_compartment",
+					TerraformName:  "export",
+				},
+			}
+			for _, mode := range ctx.Services {
+				if resourceGraph, exists := tf_export.CompartmentResourceGraphs[mode]; exists {
+					result = append(result, &resourceDiscoveryWithGraph{
+						root:                      subCompartmentResource,
+						resourceGraph:             resourceGraph,
+						resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: mode, ctx: ctx},
+					})
+				}
+			}
+		}
+	}
+
 	return result, nil
 }
 
@@ -1112,6 +1211,83 @@ func generateProviderFile(outputDir *string) error {
 	return nil
 }
 
+/*
+generateImportsFile writes an `import` block for each of the exported resources that can be imported, which
+Terraform v1.5+ imports on plan and apply, instead of running terraform import for each of them
+*/
+func generateImportsFile(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep) error {
+	var resources []*tf_export.OCIResource
+	for _, step := range steps {
+		resources = append(resources, step.getDiscoveredResources()...)
+	}
+	return writeImportsFile(ctx, *ctx.OutputDir, resources)
+}
+
+func writeImportsFile(ctx *tf_export.ResourceDiscoveryContext, outputDir string, resources []*tf_export.OCIResource) error {
+	importsTmpFile := fmt.Sprintf("%s%s%s.tmp", outputDir, string(os.PathSeparator), globalvar.ImportsFile)
+	importsOutputFile := fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), globalvar.ImportsFile)
+	file, err := os.OpenFile(importsTmpFile, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+
+	builder := &strings.Builder{}
+	builder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+	importCount := 0
+	for _, resource := range resources {
+		if !isImportable(resource) {
+			continue
+		}
+		builder.WriteString(getImportBlockHclString(resource))
+		importCount++
+	}
+
+	if _, err := file.WriteString(string(hclwrite.Format([]byte(builder.String())))); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if fErr := file.Close(); fErr != nil {
+		return fErr
+	}
+
+	if err := os.Rename(importsTmpFile, importsOutputFile); err != nil {
+		return err
+	}
+
+	ctx.SummaryStatements = append(ctx.SummaryStatements, fmt.Sprintf("Generated import blocks for %d resources under '%s'", importCount, importsOutputFile))
+	return nil
+}
+
+// isImportable returns true if the resource is a Terraform OCI resource that was exported and supports import
+func isImportable(resource *tf_export.OCIResource) bool {
+	if resource.IsErrorResource || resource.OmitFromExport || (resource.TerraformTypeInfo != nil && resource.TerraformTypeInfo.IsDataSource) {
+		return false
+	}
+	resourceDefinition, exists := tf_export.ResourcesMap[resource.TerraformClass]
+	if !exists {
+		return false
+	}
+	if resourceDefinition.Importer == nil {
+		utils.Logf("[WARN] unable to write an import block for '%s' because import is not supported for '%s'", resource.GetTerraformReference(), resource.TerraformClass)
+		return false
+	}
+	return true
+}
+
+func getImportBlockHclString(resource *tf_export.OCIResource) string {
+	importId := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "${", "$${", "%{", "%%{").Replace(getImportId(resource))
+	return fmt.Sprintf("import {\n\tto = %s\n\tid = \"%s\"\n}\n\n", getResourceAddress(resource), importId)
+}
+
+// getImportId returns the ID to import the resource with, which is its import ID if it has one, or its ID
+func getImportId(resource *tf_export.OCIResource) string {
+	if len(resource.ImportId) > 0 {
+		return resource.ImportId
+	}
+	return resource.Id
+}
+
 //func getOciResource(d *schema.ResourceData, resourceSchema map[string]*schema.Schema, compartmentId string, resourceHint *tf_export.TerraformResourceHints, resourceId string) (*tf_export.OCIResource, error) {
 //	resourceMap, err := tf_export.ConvertDatasourceItemToMap(d, "", resourceSchema)
 //	if err != nil {
@@ -1245,6 +1421,18 @@ func getTenancyOcidFromCompartment(clients *tf_client.OracleClients, compartment
 }
 
 func deleteInvalidReferences(referenceMap map[string]string, discoveredResources []*tf_export.OCIResource) {
+	initReferenceSets(referenceMap)
+
+	for _, resource := range discoveredResources {
+
+		// delete the entry if key is an OCID for a failed resource
+		if resource.IsErrorResource {
+			deleteReferencesToResource(referenceMap, resource)
+		}
+	}
+}
+
+func initReferenceSets(referenceMap map[string]string) {
 	// intialize referenceResourceNameSet
 	// This set contains unique terraform names for resource references
 	if referenceResourceNameSet == nil {
@@ -1260,29 +1448,29 @@ func deleteInvalidReferences(referenceMap map[string]string, discoveredResources
 	if tf_export.FailedResourceReferenceSet == nil {
 		tf_export.FailedResourceReferenceSet = make(map[string]bool)
 	}
+}
 
-	for _, resource := range discoveredResources {
+// deleteReferencesToResource deletes the references to a resource that is not in the generated configuration, so
+// that its values are written instead
+func deleteReferencesToResource(referenceMap map[string]string, resource *tf_export.OCIResource) {
+	initReferenceSets(referenceMap)
 
-		// delete the entry if key is an OCID for a failed resource
-		if resource.IsErrorResource {
-			// store failed resource reference, will be used later to remove InterpolationString type values when generating config
-			tf_export.FailedResourceReferenceSet[resource.GetTerraformReference()] = true
-			if _, ok := referenceMap[resource.Id]; ok {
-				delete(referenceMap, resource.Id)
-			}
+	// store failed resource reference, will be used later to remove InterpolationString type values when generating config
+	tf_export.FailedResourceReferenceSet[resource.GetTerraformReference()] = true
+	if _, ok := referenceMap[resource.Id]; ok {
+		delete(referenceMap, resource.Id)
+	}
 
-			// delete any entries that have references to a failed resource
-			// e.g. oci_core_instance.instance1.volume_id should be replaced by volume ocid if instance import failed
-			if ok := referenceResourceNameSet[resource.TerraformName]; ok {
-				for key, value := range referenceMap {
-					valueParts := strings.Split(value, ".")
-					if len(valueParts) < 3 {
-						continue
-					}
-					if valueParts[1] == resource.TerraformName {
-						delete(referenceMap, key)
-					}
-				}
+	// delete any entries that have references to a failed resource
+	// e.g. oci_core_instance.instance1.volume_id should be replaced by volume ocid if instance import failed
+	if ok := referenceResourceNameSet[resource.TerraformName]; ok {
+		for key, value := range referenceMap {
+			valueParts := strings.Split(value, ".")
+			if len(valueParts) < 3 {
+				continue
+			}
+			if valueParts[1] == resource.TerraformName {
+				delete(referenceMap, key)
 			}
 		}
 	}
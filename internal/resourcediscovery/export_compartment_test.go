@@ -2427,14 +2427,20 @@ func TestUnitPrintResourceGraphResources(t *testing.T) {
 	resourceGraphs := map[string]tf_export.TerraformResourceGraph{
 		"tenancyTestingResourceGraph": tenancyTestingResourceGraph,
 	}
-	err := printResourceGraphResources(resourceGraphs, "testing")
+	err := printResourceGraphResources(resourceGraphs, "testing", tf_export.OutputFormatHcl)
 	assert.NoError(t, err, "error not expected for tenancyTestingResourceGraph")
+
+	err = printResourceGraphResources(resourceGraphs, "testing", tf_export.OutputFormatJson)
+	assert.NoError(t, err, "error not expected for tenancyTestingResourceGraph as JSON")
 }
 
 func TestUnitRunListExportableResourcesCommand(t *testing.T) {
 
-	err := RunListExportableResourcesCommand()
+	err := RunListExportableResourcesCommand(tf_export.OutputFormatHcl)
 	assert.NoError(t, err, "error not expected")
+
+	err = RunListExportableResourcesCommand(tf_export.OutputFormatYaml)
+	assert.Error(t, err, "error expected for output_format yaml")
 }
 
 func TestUnitGenerateStateParallel(t *testing.T) {
@@ -2742,6 +2748,138 @@ func TestUnitImportResource(t *testing.T) {
 	assert.Equal(t, 1, len(ctx.ErrorList.Errors))
 }
 
+// issue-routing-tag: terraform/default
+func TestUnitGenerateImportsFile(t *testing.T) {
+	ctx := getTestCtx()
+	defer os.RemoveAll(*ctx.OutputDir)
+	tf_export.ResourcesMap = mockResourcesMap()
+	tf_export.ResourcesMap["oci_resource_type2"] = &schema.Resource{}
+
+	newResource := func(class string, name string, id string, importId string) *tf_export.OCIResource {
+		return &tf_export.OCIResource{
+			TerraformResource: tf_export.TerraformResource{
+				Id:             id,
+				ImportId:       importId,
+				TerraformClass: class,
+				TerraformName:  name,
+			},
+		}
+	}
+	failedResource := newResource("oci_resource_type1", "type1_res3", "ocid1.a.b.e", "")
+	failedResource.IsErrorResource = true
+	dataSource := newResource("oci_resource_type1", "type1_res4", "ocid1.a.b.f", "")
+	dataSource.TerraformTypeInfo = &tf_export.TerraformResourceHints{IsDataSource: true}
+
+	step := &resourceDiscoveryWithGraph{
+		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+			ctx:  ctx,
+			name: "test",
+			discoveredResources: []*tf_export.OCIResource{
+				newResource("oci_resource_type1", "type1_res1", "ocid1.a.b.c", ""),
+				newResource("oci_resource_type1", "type1_res2", "ocid1.a.b.d", `namespaces/n/buckets/"b"`),
+				failedResource,
+				dataSource,
+				// Without importer
+				newResource("oci_resource_type2", "type2_res1", "ocid1.a.b.g", ""),
+				// Not a Terraform OCI resource
+				newResource("oci_resource_type3", "type3_res1", "ocid1.a.b.h", ""),
+			},
+		},
+	}
+
+	assert.NoError(t, generateImportsFile(ctx, []resourceDiscoveryStep{step}))
+
+	imports, err := os.ReadFile(path.Join(*ctx.OutputDir, globalvar.ImportsFile))
+	assert.NoError(t, err)
+	assert.Equal(t, `## This configuration was generated by terraform-provider-oci
+
+import {
+  to = oci_resource_type1.type1_res1
+  id = "ocid1.a.b.c"
+}
+
+import {
+  to = oci_resource_type1.type1_res2
+  id = "namespaces/n/buckets/\"b\""
+}
+
+`, string(imports))
+	assert.Contains(t, ctx.SummaryStatements[len(ctx.SummaryStatements)-1], "Generated import blocks for 2 resources")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateImportMode(t *testing.T) {
+	outputDir, _ := createOutputDir()
+	defer os.RemoveAll(outputDir)
+	var tfVersion11 tf_export.TfHclVersion = &tf_export.TfHclVersion11{Value: tf_export.TfVersion11}
+	var tfVersion12 tf_export.TfHclVersion = &tf_export.TfHclVersion12{Value: tf_export.TfVersion12}
+
+	tests := []struct {
+		name          string
+		importMode    tf_export.ImportModeEnum
+		generateState bool
+		tfVersion     *tf_export.TfHclVersion
+		wantErr       bool
+	}{
+		{name: "Test default import mode", tfVersion: &tfVersion12},
+		{name: "Test state import mode", importMode: tf_export.ImportModeState, generateState: true, tfVersion: &tfVersion12},
+		{name: "Test blocks import mode", importMode: tf_export.ImportModeBlocks, tfVersion: &tfVersion12},
+		{name: "Test blocks import mode with generate_state", importMode: tf_export.ImportModeBlocks, generateState: true, tfVersion: &tfVersion12, wantErr: true},
+		{name: "Test blocks import mode with tf_version 0.11", importMode: tf_export.ImportModeBlocks, tfVersion: &tfVersion11, wantErr: true},
+		{name: "Test invalid import mode", importMode: "terraform", tfVersion: &tfVersion12, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := &tf_export.ExportCommandArgs{
+				OutputDir:     &outputDir,
+				GenerateState: tt.generateState,
+				TFVersion:     tt.tfVersion,
+				Parallelism:   1,
+				ImportMode:    tt.importMode,
+			}
+			if err := args.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateBaseline(t *testing.T) {
+	outputDir, _ := createOutputDir()
+	defer os.RemoveAll(outputDir)
+
+	tests := []struct {
+		name                     string
+		baselinePath             string
+		baselineNewResourcesOnly bool
+		generateState            bool
+		wantErr                  bool
+	}{
+		{name: "Test without baseline"},
+		{name: "Test baseline", baselinePath: outputDir},
+		{name: "Test baseline with new resources only", baselinePath: outputDir, baselineNewResourcesOnly: true},
+		{name: "Test baseline that does not exist", baselinePath: path.Join(outputDir, "baseline"), wantErr: true},
+		{name: "Test new resources only without baseline", baselineNewResourcesOnly: true, wantErr: true},
+		{name: "Test new resources only with generate_state", baselinePath: outputDir, baselineNewResourcesOnly: true, generateState: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := &tf_export.ExportCommandArgs{
+				OutputDir:                &outputDir,
+				GenerateState:            tt.generateState,
+				TFVersion:                &tf_export.TfHclVersionvar,
+				Parallelism:              1,
+				BaselinePath:             tt.baselinePath,
+				BaselineNewResourcesOnly: tt.baselineNewResourcesOnly,
+			}
+			if err := args.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func mockResourcesMap() map[string]*schema.Resource {
 	r := &schema.Resource{
 		Schema: tf_provider.SchemaMap(),
@@ -0,0 +1,249 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+// inventorySchemaVersion is the version of the schema of the inventory and of the resource graphs written as JSON or
+// YAML. It changes when fields are renamed or removed, not when fields are added.
+const inventorySchemaVersion = "1.0"
+
+// exportInventory is the machine-readable inventory of the exported resources
+type exportInventory struct {
+	SchemaVersion string `json:"schema_version" yaml:"schema_version"`
+	CompartmentId string `json:"compartment_id" yaml:"compartment_id"`
+	TenancyId     string `json:"tenancy_id" yaml:"tenancy_id"`
+	// Nodes are the exported resources
+	Nodes []*inventoryNode `json:"nodes" yaml:"nodes"`
+	// Edges are the parent/child relations between the resources, along which they were discovered
+	Edges []*inventoryEdge `json:"edges" yaml:"edges"`
+	// References are the attributes of the resources that reference other resources in their configuration
+	References []*inventoryReference `json:"references" yaml:"references"`
+}
+
+type inventoryNode struct {
+	Id             string `json:"id" yaml:"id"`
+	TerraformClass string `json:"terraform_class" yaml:"terraform_class"`
+	TerraformName  string `json:"terraform_name" yaml:"terraform_name"`
+	// Address is the address of the resource in the configuration, e.g. `module.core.oci_core_vcn.export_vcn`
+	Address       string                 `json:"address" yaml:"address"`
+	CompartmentId string                 `json:"compartment_id" yaml:"compartment_id"`
+	IsDataSource  bool                   `json:"is_data_source,omitempty" yaml:"is_data_source,omitempty"`
+	Attributes    map[string]interface{} `json:"attributes" yaml:"attributes"`
+}
+
+type inventoryEdge struct {
+	Parent string `json:"parent" yaml:"parent"`
+	Child  string `json:"child" yaml:"child"`
+}
+
+// inventoryReference is an attribute, e.g. `create_vnic_details.0.subnet_id`, of a resource that references another
+// resource, e.g. `oci_core_subnet.export_subnet.id`
+type inventoryReference struct {
+	From      string `json:"from" yaml:"from"`
+	Attribute string `json:"attribute" yaml:"attribute"`
+	To        string `json:"to" yaml:"to"`
+	Reference string `json:"reference" yaml:"reference"`
+}
+
+/*
+getExportInventory returns the inventory of the exported resources, with:
+- a node per resource, with its source attributes
+- an edge per resource discovered as the child of another exported resource in the resource graph
+- a reference per attribute of a resource whose value is in tf_export.ReferenceMap, and references another exported resource
+*/
+func getExportInventory(ctx *tf_export.ResourceDiscoveryContext, resources []*tf_export.OCIResource) *exportInventory {
+	inventory := &exportInventory{
+		SchemaVersion: inventorySchemaVersion,
+		TenancyId:     ctx.TenancyOcid,
+		Nodes:         []*inventoryNode{},
+		Edges:         []*inventoryEdge{},
+		References:    []*inventoryReference{},
+	}
+	if ctx.CompartmentId != nil {
+		inventory.CompartmentId = *ctx.CompartmentId
+	}
+
+	resourceIds := map[string]string{}
+	for _, resource := range resources {
+		if resource.IsErrorResource || resource.Id == "" {
+			continue
+		}
+		resourceIds[resource.GetTerraformReference()] = resource.Id
+	}
+
+	for _, resource := range resources {
+		if resource.IsErrorResource || resource.Id == "" {
+			continue
+		}
+		inventory.Nodes = append(inventory.Nodes, &inventoryNode{
+			Id:             resource.Id,
+			TerraformClass: resource.TerraformClass,
+			TerraformName:  resource.TerraformName,
+			Address:        getResourceAddress(resource),
+			CompartmentId:  resource.CompartmentId,
+			IsDataSource:   resource.TerraformTypeInfo != nil && resource.TerraformTypeInfo.IsDataSource,
+			Attributes:     resource.SourceAttributes,
+		})
+
+		if resource.Parent != nil {
+			if _, exists := resourceIds[resource.Parent.GetTerraformReference()]; exists {
+				inventory.Edges = append(inventory.Edges, &inventoryEdge{Parent: resource.Parent.Id, Child: resource.Id})
+			}
+		}
+
+		attributes := map[string]string{}
+		flattenAttributes("", resource.SourceAttributes, attributes)
+		for attribute, value := range attributes {
+			reference, exists := tf_export.ReferenceMap[value]
+			if !exists {
+				continue
+			}
+			referencedId, exists := resourceIds[getReferencedResource(reference)]
+			if !exists || referencedId == resource.Id {
+				continue
+			}
+			inventory.References = append(inventory.References, &inventoryReference{
+				From:      resource.Id,
+				Attribute: attribute,
+				To:        referencedId,
+				Reference: reference,
+			})
+		}
+	}
+
+	sort.Slice(inventory.Nodes, func(i, j int) bool {
+		return inventory.Nodes[i].Address < inventory.Nodes[j].Address
+	})
+	sort.Slice(inventory.Edges, func(i, j int) bool {
+		if inventory.Edges[i].Parent != inventory.Edges[j].Parent {
+			return inventory.Edges[i].Parent < inventory.Edges[j].Parent
+		}
+		return inventory.Edges[i].Child < inventory.Edges[j].Child
+	})
+	sort.Slice(inventory.References, func(i, j int) bool {
+		if inventory.References[i].From != inventory.References[j].From {
+			return inventory.References[i].From < inventory.References[j].From
+		}
+		return inventory.References[i].Attribute < inventory.References[j].Attribute
+	})
+	return inventory
+}
+
+// writeExportInventory writes the inventory of the exported resources in the output directory, as JSON or YAML
+func writeExportInventory(ctx *tf_export.ResourceDiscoveryContext) error {
+	inventory := getExportInventory(ctx, ctx.DiscoveredResources)
+
+	var inventoryOutputFile string
+	var content []byte
+	var err error
+	switch ctx.OutputFormat {
+	case tf_export.OutputFormatJson:
+		inventoryOutputFile = filepath.Join(*ctx.OutputDir, globalvar.InventoryJsonFile)
+		if content, err = json.MarshalIndent(inventory, "", "\t"); err != nil {
+			return fmt.Errorf("[ERROR] error marshalling inventory to JSON: %v", err)
+		}
+	case tf_export.OutputFormatYaml:
+		inventoryOutputFile = filepath.Join(*ctx.OutputDir, globalvar.InventoryYamlFile)
+		if content, err = yaml.Marshal(inventory); err != nil {
+			return fmt.Errorf("[ERROR] error marshalling inventory to YAML: %v", err)
+		}
+	default:
+		return nil
+	}
+
+	if err := ioutil.WriteFile(inventoryOutputFile, content, 0644); err != nil {
+		return err
+	}
+	ctx.SummaryStatements = append(ctx.SummaryStatements, fmt.Sprintf("Generated an inventory of %d resources, %d edges and %d references under '%s'",
+		len(inventory.Nodes), len(inventory.Edges), len(inventory.References), inventoryOutputFile))
+	return nil
+}
+
+// exportResourceGraphs are the resource graphs of a scope, written as JSON by printResourceGraphResources
+type exportResourceGraphs struct {
+	SchemaVersion string                 `json:"schema_version"`
+	Scope         string                 `json:"scope"`
+	Services      []*exportResourceGraph `json:"services"`
+}
+
+// exportResourceGraph is the resource graph of a service
+type exportResourceGraph struct {
+	Name string `json:"name"`
+	// Resources are the resource classes the service discovers
+	Resources []string `json:"resources"`
+	// Edges are the resources discovered from each parent resource
+	Edges []*exportResourceGraphEdge `json:"edges"`
+}
+
+type exportResourceGraphEdge struct {
+	Parent                string            `json:"parent"`
+	Child                 string            `json:"child"`
+	DatasourceClass       string            `json:"datasource_class,omitempty"`
+	DatasourceQueryParams map[string]string `json:"datasource_query_params,omitempty"`
+}
+
+func getExportResourceGraphs(resourceGraphs map[string]tf_export.TerraformResourceGraph, scope string) *exportResourceGraphs {
+	graphs := &exportResourceGraphs{
+		SchemaVersion: inventorySchemaVersion,
+		Scope:         scope,
+		Services:      []*exportResourceGraph{},
+	}
+	for graphName, resourceGraph := range resourceGraphs {
+		graph := &exportResourceGraph{
+			Name:      graphName,
+			Resources: []string{},
+			Edges:     []*exportResourceGraphEdge{},
+		}
+		resourceSet := map[string]bool{}
+		for parentClass, association := range resourceGraph {
+			for _, hint := range association {
+				if _, isResource := tf_export.ResourcesMap[hint.ResourceClass]; isResource && !resourceSet[hint.ResourceClass] {
+					resourceSet[hint.ResourceClass] = true
+					graph.Resources = append(graph.Resources, hint.ResourceClass)
+				}
+				graph.Edges = append(graph.Edges, &exportResourceGraphEdge{
+					Parent:                parentClass,
+					Child:                 hint.ResourceClass,
+					DatasourceClass:       hint.DatasourceClass,
+					DatasourceQueryParams: hint.DatasourceQueryParams,
+				})
+			}
+		}
+		sort.Strings(graph.Resources)
+		sort.SliceStable(graph.Edges, func(i, j int) bool {
+			if graph.Edges[i].Parent != graph.Edges[j].Parent {
+				return graph.Edges[i].Parent < graph.Edges[j].Parent
+			}
+			return graph.Edges[i].Child < graph.Edges[j].Child
+		})
+		graphs.Services = append(graphs.Services, graph)
+	}
+	sort.Slice(graphs.Services, func(i, j int) bool {
+		return graphs.Services[i].Name < graphs.Services[j].Name
+	})
+	return graphs
+}
+
+// printResourceGraphsJson prints the resource graphs of a scope as a JSON document on a single line
+func printResourceGraphsJson(resourceGraphs map[string]tf_export.TerraformResourceGraph, scope string) error {
+	graphsJson, err := json.Marshal(getExportResourceGraphs(resourceGraphs, scope))
+	if err != nil {
+		return fmt.Errorf("[ERROR] error marshalling resource graphs to JSON: %v", err)
+	}
+	utils.Logln(string(graphsJson))
+	return nil
+}
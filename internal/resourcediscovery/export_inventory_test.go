@@ -0,0 +1,160 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+// getInventoryTestResources returns a parent, its child, and another child whose parent is not exported
+func getInventoryTestResources(compartmentId string) []*tf_export.OCIResource {
+	parent := newModuleTestResource("oci_test_parent", "export_parent1", "ocid1.parent.oc1..aaaa", compartmentId,
+		map[string]interface{}{"compartment_id": compartmentId, "display_name": "parent1"})
+	child := newModuleTestResource("oci_test_child", "export_child1", "ocid1.child.oc1..aaaa", compartmentId,
+		map[string]interface{}{"compartment_id": compartmentId, "parent_id": "ocid1.parent.oc1..aaaa",
+			"details": []interface{}{map[string]interface{}{"source_id": "ocid1.parent.oc1..aaaa"}}})
+	child.Parent = parent
+	orphan := newModuleTestResource("oci_test_child", "export_child2", "ocid1.child.oc1..bbbb", compartmentId,
+		map[string]interface{}{"compartment_id": compartmentId, "parent_id": "ocid1.parent.oc1..bbbb"})
+	orphan.Parent = newModuleTestResource("oci_test_parent", "export_parent2", "ocid1.parent.oc1..bbbb", compartmentId, nil)
+	failed := newModuleTestResource("oci_test_child", "export_child3", "", compartmentId, nil)
+	failed.IsErrorResource = true
+	return []*tf_export.OCIResource{child, parent, orphan, failed}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetExportInventory(t *testing.T) {
+	defer func(referenceMap map[string]string) {
+		tf_export.ReferenceMap = referenceMap
+	}(tf_export.ReferenceMap)
+
+	ctx := getTestCtx()
+	defer os.RemoveAll(*ctx.OutputDir)
+	compartmentId := *ctx.CompartmentId
+	tf_export.ReferenceMap = map[string]string{
+		compartmentId:            "var.compartment_ocid",
+		"ocid1.parent.oc1..aaaa": "oci_test_parent.export_parent1.id",
+		"ocid1.parent.oc1..bbbb": "oci_test_parent.export_parent2.id",
+	}
+
+	inventory := getExportInventory(ctx, getInventoryTestResources(compartmentId))
+	assert.Equal(t, inventorySchemaVersion, inventory.SchemaVersion)
+	assert.Equal(t, compartmentId, inventory.CompartmentId)
+	assert.Equal(t, "tenancyOcid", inventory.TenancyId)
+
+	var addresses []string
+	for _, node := range inventory.Nodes {
+		addresses = append(addresses, node.Address)
+	}
+	assert.Equal(t, []string{"oci_test_child.export_child1", "oci_test_child.export_child2", "oci_test_parent.export_parent1"}, addresses)
+	assert.Equal(t, "parent1", inventory.Nodes[2].Attributes["display_name"])
+
+	assert.Equal(t, []*inventoryEdge{{Parent: "ocid1.parent.oc1..aaaa", Child: "ocid1.child.oc1..aaaa"}}, inventory.Edges)
+	assert.Equal(t, []*inventoryReference{
+		{From: "ocid1.child.oc1..aaaa", Attribute: "details.0.source_id", To: "ocid1.parent.oc1..aaaa", Reference: "oci_test_parent.export_parent1.id"},
+		{From: "ocid1.child.oc1..aaaa", Attribute: "parent_id", To: "ocid1.parent.oc1..aaaa", Reference: "oci_test_parent.export_parent1.id"},
+	}, inventory.References)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitWriteExportInventory(t *testing.T) {
+	defer func(referenceMap map[string]string) {
+		tf_export.ReferenceMap = referenceMap
+	}(tf_export.ReferenceMap)
+
+	tests := []struct {
+		name         string
+		outputFormat tf_export.OutputFormatEnum
+		wantFile     string
+		unmarshal    func([]byte, interface{}) error
+	}{
+		{name: "Test JSON inventory", outputFormat: tf_export.OutputFormatJson, wantFile: globalvar.InventoryJsonFile, unmarshal: json.Unmarshal},
+		{name: "Test YAML inventory", outputFormat: tf_export.OutputFormatYaml, wantFile: globalvar.InventoryYamlFile, unmarshal: yaml.Unmarshal},
+		{name: "Test HCL only", outputFormat: tf_export.OutputFormatHcl},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := getTestCtx()
+			defer os.RemoveAll(*ctx.OutputDir)
+			ctx.OutputFormat = tt.outputFormat
+			ctx.DiscoveredResources = getInventoryTestResources(*ctx.CompartmentId)
+			tf_export.ReferenceMap = map[string]string{"ocid1.parent.oc1..aaaa": "oci_test_parent.export_parent1.id"}
+
+			assert.NoError(t, writeExportInventory(ctx))
+			if tt.wantFile == "" {
+				assert.NoFileExists(t, filepath.Join(*ctx.OutputDir, globalvar.InventoryJsonFile))
+				assert.NoFileExists(t, filepath.Join(*ctx.OutputDir, globalvar.InventoryYamlFile))
+				return
+			}
+
+			content, err := os.ReadFile(filepath.Join(*ctx.OutputDir, tt.wantFile))
+			assert.NoError(t, err)
+			inventory := &exportInventory{}
+			assert.NoError(t, tt.unmarshal(content, inventory))
+			assert.Equal(t, inventorySchemaVersion, inventory.SchemaVersion)
+			assert.Len(t, inventory.Nodes, 3)
+			assert.Len(t, inventory.Edges, 1)
+			assert.Len(t, inventory.References, 2)
+			assert.Equal(t, "oci_test_child", inventory.Nodes[0].TerraformClass)
+		})
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetExportResourceGraphs(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+
+	graphs := getExportResourceGraphs(map[string]tf_export.TerraformResourceGraph{
+		"tenancy_testing": tenancyTestingResourceGraph,
+	}, TenancyScope)
+	assert.Equal(t, inventorySchemaVersion, graphs.SchemaVersion)
+	assert.Equal(t, TenancyScope, graphs.Scope)
+	assert.Len(t, graphs.Services, 1)
+	assert.Equal(t, "tenancy_testing", graphs.Services[0].Name)
+	assert.Equal(t, []string{"oci_test_child", "oci_test_parent"}, graphs.Services[0].Resources)
+	assert.Equal(t, []*exportResourceGraphEdge{
+		{Parent: "oci_identity_tenancy", Child: "oci_test_parent", DatasourceClass: "oci_test_parents"},
+		{Parent: "oci_test_parent", Child: "oci_test_child", DatasourceClass: "oci_test_children", DatasourceQueryParams: map[string]string{"parent_id": "id"}},
+	}, graphs.Services[0].Edges)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateOutputFormat(t *testing.T) {
+	outputDir, _ := createOutputDir()
+	defer os.RemoveAll(outputDir)
+
+	tests := []struct {
+		name         string
+		outputFormat tf_export.OutputFormatEnum
+		wantErr      bool
+	}{
+		{name: "Test default output format"},
+		{name: "Test hcl output format", outputFormat: tf_export.OutputFormatHcl},
+		{name: "Test json output format", outputFormat: tf_export.OutputFormatJson},
+		{name: "Test yaml output format", outputFormat: tf_export.OutputFormatYaml},
+		{name: "Test invalid output format", outputFormat: "xml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := &tf_export.ExportCommandArgs{
+				OutputDir:    &outputDir,
+				Parallelism:  1,
+				OutputFormat: tt.outputFormat,
+			}
+			if err := args.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
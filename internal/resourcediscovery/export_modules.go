@@ -0,0 +1,343 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl2/hcl"
+	"github.com/hashicorp/hcl2/hcl/hclsyntax"
+	"github.com/hashicorp/hcl2/hclwrite"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+// moduleLayout is the layout of the exported resources in child modules, when the output layout is modules
+var moduleLayout *exportModuleLayout
+
+// exportModuleLayout assigns the exported resources to child modules, and turns the references between the resources
+// of different modules into module inputs and outputs
+type exportModuleLayout struct {
+	// modules of the resources, keyed by their Terraform reference
+	resourceModules map[string]string
+	// interpolation maps of the modules, in which the references to the resources of other modules are module inputs
+	interpolationMaps map[string]map[string]string
+	// inputs of the modules, keyed by their name
+	inputs map[string]*moduleInput
+}
+
+// moduleInput is a reference to the resource of another module, e.g. `oci_core_vcn.export_vcn.id`, that the module
+// of the resource outputs
+type moduleInput struct {
+	module    string
+	reference string
+}
+
+var moduleNameRegex = regexp.MustCompile(`[^a-zA-Z0-9\-\_]+`)
+
+func newExportModuleLayout(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep) *exportModuleLayout {
+	layout := &exportModuleLayout{
+		resourceModules:   map[string]string{},
+		interpolationMaps: map[string]map[string]string{},
+		inputs:            map[string]*moduleInput{},
+	}
+
+	var compartmentModules map[string]string
+	if ctx.ModuleGrouping == tf_export.ModuleGroupingCompartment {
+		compartmentModules = getCompartmentModuleNames(ctx, steps)
+	}
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			module := step.getBaseStep().name
+			if compartmentModules != nil {
+				module = compartmentModules[getResourceCompartmentId(ctx, resource)]
+			}
+			layout.resourceModules[resource.GetTerraformReference()] = module
+		}
+	}
+
+	for _, module := range layout.resourceModules {
+		if _, exists := layout.interpolationMaps[module]; !exists {
+			layout.interpolationMaps[module] = layout.getModuleInterpolationMap(module)
+		}
+	}
+	return layout
+}
+
+/*
+getCompartmentModuleNames names the module of each compartment of the resources after:
+- the Terraform name of the compartment, for the sub-compartments that are exported
+- `tenancy` or `compartment`, for the exported compartment
+- the OCID of the compartment otherwise
+*/
+func getCompartmentModuleNames(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep) map[string]string {
+	names := map[string]string{}
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			if resource.TerraformClass == "oci_identity_compartment" && !resource.IsErrorResource {
+				names[resource.Id] = resource.TerraformName
+			}
+		}
+	}
+
+	if ctx.CompartmentId != nil && *ctx.CompartmentId != "" && *ctx.CompartmentId != ctx.TenancyOcid {
+		names[*ctx.CompartmentId] = "compartment"
+	}
+	names[ctx.TenancyOcid] = "tenancy"
+
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			compartmentId := getResourceCompartmentId(ctx, resource)
+			if _, exists := names[compartmentId]; !exists {
+				ocidParts := strings.Split(compartmentId, ".")
+				names[compartmentId] = "compartment_" + moduleNameRegex.ReplaceAllString(ocidParts[len(ocidParts)-1], "-")
+			}
+		}
+	}
+	return names
+}
+
+func getResourceCompartmentId(ctx *tf_export.ResourceDiscoveryContext, resource *tf_export.OCIResource) string {
+	if resource.CompartmentId != "" {
+		return resource.CompartmentId
+	}
+	if ctx.CompartmentId != nil && *ctx.CompartmentId != "" {
+		return *ctx.CompartmentId
+	}
+	return ctx.TenancyOcid
+}
+
+// getModuleInterpolationMap returns the references of the module, which are the ones of tf_export.ReferenceMap with
+// the references to the resources of other modules replaced by module inputs
+func (l *exportModuleLayout) getModuleInterpolationMap(module string) map[string]string {
+	interpolationMap := make(map[string]string, len(tf_export.ReferenceMap))
+	for value, reference := range tf_export.ReferenceMap {
+		referencedModule, exists := l.resourceModules[getReferencedResource(reference)]
+		if !exists || referencedModule == module {
+			interpolationMap[value] = reference
+			continue
+		}
+		inputName := getModuleInputName(reference)
+		l.inputs[inputName] = &moduleInput{module: referencedModule, reference: reference}
+		interpolationMap[value] = tf_export.TfHclVersionvar.GetVarHclString(inputName)
+	}
+	return interpolationMap
+}
+
+// getReferencedResource returns the Terraform reference of the resource of a reference, e.g. `oci_core_vcn.export_vcn`
+// for `oci_core_vcn.export_vcn.id`
+func getReferencedResource(reference string) string {
+	referenceParts := strings.Split(strings.TrimPrefix(reference, "data."), ".")
+	if len(referenceParts) < 2 {
+		return ""
+	}
+	return referenceParts[0] + "." + referenceParts[1]
+}
+
+// getModuleInputName returns the name of the input, and of the output, for a reference, e.g. `oci_core_vcn_export_vcn_id`
+// for `oci_core_vcn.export_vcn.id`
+func getModuleInputName(reference string) string {
+	return moduleNameRegex.ReplaceAllString(strings.ReplaceAll(reference, ".", "_"), "_")
+}
+
+// getResourceAddress returns the address of the resource in the root module, e.g. `module.core.oci_core_vcn.export_vcn`
+// when the resources are exported in modules
+func getResourceAddress(resource *tf_export.OCIResource) string {
+	if moduleLayout != nil {
+		if module, exists := moduleLayout.resourceModules[resource.GetTerraformReference()]; exists {
+			return fmt.Sprintf("module.%s.%s", module, resource.GetTerraformReference())
+		}
+	}
+	return resource.GetTerraformReference()
+}
+
+// writeModulesConfiguration writes the configuration of the resources of the step in the directory of their modules
+func (r *resourceDiscoveryBaseStep) writeModulesConfiguration() error {
+	moduleResources := map[string][]*tf_export.OCIResource{}
+	for _, resource := range r.discoveredResources {
+		module := moduleLayout.resourceModules[resource.GetTerraformReference()]
+		moduleResources[module] = append(moduleResources[module], resource)
+	}
+
+	modules := make([]string, 0, len(moduleResources))
+	for module := range moduleResources {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		moduleDir := filepath.Join(*r.ctx.OutputDir, globalvar.ModulesDir, module)
+		if err := os.MkdirAll(moduleDir, os.ModePerm); err != nil {
+			return err
+		}
+		configOutputFile := filepath.Join(moduleDir, fmt.Sprintf("%s.tf", r.name))
+		exportedResourceCount, err := r.writeResourcesConfiguration(configOutputFile, moduleResources[module], moduleLayout.interpolationMaps[module])
+		if err != nil {
+			return err
+		}
+		r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Found %d '%s' resources for module '%s'. Generated under '%s'", exportedResourceCount, r.name, module, configOutputFile))
+	}
+	r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Time taken for discovery: %v, generating state: %v", r.timeTakenForDiscovery, r.timeTakenForGeneratingState))
+	return nil
+}
+
+/*
+generateModulesFiles writes, once the configuration of all the modules is written:
+- the variables of each module, which are the variables of the root module and the inputs it uses
+- the outputs of each module, which are the inputs of the other modules referencing its resources
+- the root module calling the child modules
+*/
+func generateModulesFiles(ctx *tf_export.ResourceDiscoveryContext) error {
+	modules := make([]string, 0, len(moduleLayout.interpolationMaps))
+	for module := range moduleLayout.interpolationMaps {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	moduleVariables := map[string][]string{}
+	moduleOutputs := map[string][]string{}
+	for _, module := range modules {
+		variables, err := getModuleVariables(filepath.Join(*ctx.OutputDir, globalvar.ModulesDir, module))
+		if err != nil {
+			return err
+		}
+		moduleVariables[module] = variables
+		for _, variable := range variables {
+			if input, exists := moduleLayout.inputs[variable]; exists {
+				moduleOutputs[input.module] = append(moduleOutputs[input.module], variable)
+			}
+		}
+	}
+
+	rootBuilder := &strings.Builder{}
+	rootBuilder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+	for _, module := range modules {
+		moduleDir := filepath.Join(*ctx.OutputDir, globalvar.ModulesDir, module)
+
+		variablesBuilder := &strings.Builder{}
+		variablesBuilder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+		for _, variable := range moduleVariables[module] {
+			variablesBuilder.WriteString(fmt.Sprintf("variable %s {}\n", variable))
+		}
+		if err := writeModuleFile(filepath.Join(moduleDir, globalvar.ModuleVariablesFile), variablesBuilder.String()); err != nil {
+			return err
+		}
+
+		outputs := moduleOutputs[module]
+		sort.Strings(outputs)
+		outputsBuilder := &strings.Builder{}
+		outputsBuilder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+		for _, output := range outputs {
+			outputsBuilder.WriteString(fmt.Sprintf("output %s {\n\tvalue = %s\n}\n\n", output, moduleLayout.inputs[output].reference))
+		}
+		if err := writeModuleFile(filepath.Join(moduleDir, globalvar.ModuleOutputsFile), outputsBuilder.String()); err != nil {
+			return err
+		}
+
+		rootBuilder.WriteString(fmt.Sprintf("module %s {\n\tsource = \"./%s/%s\"\n", module, globalvar.ModulesDir, module))
+		for _, variable := range moduleVariables[module] {
+			if input, exists := moduleLayout.inputs[variable]; exists {
+				rootBuilder.WriteString(fmt.Sprintf("\t%s = module.%s.%s\n", variable, input.module, variable))
+			} else {
+				rootBuilder.WriteString(fmt.Sprintf("\t%s = %s\n", variable, tf_export.TfHclVersionvar.GetVarHclString(variable)))
+			}
+		}
+		rootBuilder.WriteString("}\n\n")
+	}
+
+	modulesOutputFile := filepath.Join(*ctx.OutputDir, globalvar.ModulesFile)
+	if err := writeModuleFile(modulesOutputFile, rootBuilder.String()); err != nil {
+		return err
+	}
+	ctx.SummaryStatements = append(ctx.SummaryStatements, fmt.Sprintf("Generated %d modules under '%s', called from '%s'", len(modules),
+		filepath.Join(*ctx.OutputDir, globalvar.ModulesDir), modulesOutputFile))
+	return nil
+}
+
+// getModuleVariables returns the variables referenced in the configuration of the resources of a module
+func getModuleVariables(moduleDir string) ([]string, error) {
+	traversals, err := getConfigurationTraversals(moduleDir, globalvar.ModuleVariablesFile, globalvar.ModuleOutputsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	variableSet := map[string]bool{}
+	for _, traversal := range traversals {
+		if traversal.RootName() != "var" || len(traversal) < 2 {
+			continue
+		}
+		if name, ok := traversal[1].(hcl.TraverseAttr); ok {
+			variableSet[name.Name] = true
+		}
+	}
+
+	variables := make([]string, 0, len(variableSet))
+	for variable := range variableSet {
+		variables = append(variables, variable)
+	}
+	sort.Strings(variables)
+	return variables, nil
+}
+
+// getConfigurationTraversals returns the references, e.g. `var.compartment_ocid`, of the configuration files in a
+// directory, except the excluded ones
+func getConfigurationTraversals(configDir string, excludedFiles ...string) ([]hcl.Traversal, error) {
+	files, err := filepath.Glob(filepath.Join(configDir, "*.tf"))
+	if err != nil {
+		return nil, err
+	}
+
+	var traversals []hcl.Traversal
+	for _, file := range files {
+		if isExcludedFile(filepath.Base(file), excludedFiles) {
+			continue
+		}
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		hclFile, diags := hclsyntax.ParseConfig(src, file, hcl.Pos{Line: 1, Column: 1})
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("[ERROR] unable to parse configuration file %s: %s", file, diags.Error())
+		}
+		if body, ok := hclFile.Body.(*hclsyntax.Body); ok {
+			traversals = appendBodyTraversals(body, traversals)
+		}
+	}
+	return traversals, nil
+}
+
+func isExcludedFile(name string, excludedFiles []string) bool {
+	for _, excludedFile := range excludedFiles {
+		if name == excludedFile {
+			return true
+		}
+	}
+	return false
+}
+
+func appendBodyTraversals(body *hclsyntax.Body, traversals []hcl.Traversal) []hcl.Traversal {
+	for _, attribute := range body.Attributes {
+		traversals = append(traversals, attribute.Expr.Variables()...)
+	}
+	for _, block := range body.Blocks {
+		traversals = appendBodyTraversals(block.Body, traversals)
+	}
+	return traversals
+}
+
+func writeModuleFile(outputFile string, content string) error {
+	tmpOutputFile := fmt.Sprintf("%s.tmp", outputFile)
+	if err := ioutil.WriteFile(tmpOutputFile, hclwrite.Format([]byte(content)), 0666); err != nil {
+		return err
+	}
+	return os.Rename(tmpOutputFile, outputFile)
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+const moduleTestSubCompartmentOcid = "ocid1.compartment.oc1..sub"
+
+func newModuleTestStep(ctx *tf_export.ResourceDiscoveryContext, name string, resources ...*tf_export.OCIResource) resourceDiscoveryStep {
+	return &resourceDiscoveryWithGraph{
+		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+			ctx:                 ctx,
+			name:                name,
+			discoveredResources: resources,
+		},
+	}
+}
+
+func newModuleTestResource(class string, name string, id string, compartmentId string, attributes map[string]interface{}) *tf_export.OCIResource {
+	return &tf_export.OCIResource{
+		CompartmentId: compartmentId,
+		TerraformResource: tf_export.TerraformResource{
+			Id:             id,
+			TerraformClass: class,
+			TerraformName:  name,
+		},
+		SourceAttributes: attributes,
+	}
+}
+
+// getModuleTestSteps returns a parent in the exported compartment, and its child in a sub-compartment, which are
+// exported by different services
+func getModuleTestSteps(ctx *tf_export.ResourceDiscoveryContext) []resourceDiscoveryStep {
+	compartmentId := *ctx.CompartmentId
+	return []resourceDiscoveryStep{
+		newModuleTestStep(ctx, "compartment_testing", newModuleTestResource("oci_test_parent", "export_parent1", "ocid1.parent.oc1..aaaa", compartmentId,
+			map[string]interface{}{"compartment_id": compartmentId, "display_name": "parent1"})),
+		newModuleTestStep(ctx, "children", newModuleTestResource("oci_test_child", "export_child1", "ocid1.child.oc1..aaaa", moduleTestSubCompartmentOcid,
+			map[string]interface{}{"compartment_id": moduleTestSubCompartmentOcid, "parent_id": "ocid1.parent.oc1..aaaa"})),
+		newModuleTestStep(ctx, "identity", newModuleTestResource("oci_identity_compartment", "export_sub", moduleTestSubCompartmentOcid, compartmentId,
+			map[string]interface{}{"compartment_id": compartmentId, "name": "sub", "description": "sub"})),
+	}
+}
+
+func readModuleTestFile(t *testing.T, path ...string) string {
+	content, err := os.ReadFile(filepath.Join(path...))
+	assert.NoError(t, err)
+	return string(content)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportModuleLayout(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	defer func(referenceMap map[string]string, vars map[string]string) {
+		tf_export.ReferenceMap = referenceMap
+		tf_export.Vars = vars
+		moduleLayout = nil
+	}(tf_export.ReferenceMap, tf_export.Vars)
+
+	tests := []struct {
+		name             string
+		grouping         tf_export.ModuleGroupingEnum
+		wantModules      map[string]string
+		wantChildModule  string
+		wantParentModule string
+	}{
+		{
+			name:     "Test modules per service",
+			grouping: tf_export.ModuleGroupingService,
+			wantModules: map[string]string{
+				"oci_test_parent.export_parent1":      "compartment_testing",
+				"oci_test_child.export_child1":        "children",
+				"oci_identity_compartment.export_sub": "identity",
+			},
+			wantChildModule:  "children",
+			wantParentModule: "compartment_testing",
+		},
+		{
+			name:     "Test modules per compartment",
+			grouping: tf_export.ModuleGroupingCompartment,
+			wantModules: map[string]string{
+				"oci_test_parent.export_parent1":      "compartment",
+				"oci_test_child.export_child1":        "export_sub",
+				"oci_identity_compartment.export_sub": "compartment",
+			},
+			wantChildModule:  "export_sub",
+			wantParentModule: "compartment",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := getTestCtx()
+			defer os.RemoveAll(*ctx.OutputDir)
+			ctx.OutputLayout = tf_export.OutputLayoutModules
+			ctx.ModuleGrouping = tt.grouping
+			tf_export.Vars = map[string]string{"compartment_ocid": `"dummy_compartment_id"`}
+			tf_export.ReferenceMap = map[string]string{
+				*ctx.CompartmentId:           "var.compartment_ocid",
+				"ocid1.parent.oc1..aaaa":     "oci_test_parent.export_parent1.id",
+				moduleTestSubCompartmentOcid: "oci_identity_compartment.export_sub.id",
+			}
+
+			steps := getModuleTestSteps(ctx)
+			moduleLayout = newExportModuleLayout(ctx, steps)
+			assert.Equal(t, tt.wantModules, moduleLayout.resourceModules)
+
+			for _, step := range steps {
+				assert.NoError(t, step.writeConfiguration())
+			}
+			assert.NoError(t, generateModulesFiles(ctx))
+
+			modulesDir := filepath.Join(*ctx.OutputDir, globalvar.ModulesDir)
+			child := readModuleTestFile(t, modulesDir, tt.wantChildModule, "children.tf")
+			assert.Contains(t, child, "compartment_id = var.oci_identity_compartment_export_sub_id")
+			assert.Contains(t, child, "parent_id      = var.oci_test_parent_export_parent1_id")
+			assert.Equal(t, `## This configuration was generated by terraform-provider-oci
+
+variable oci_identity_compartment_export_sub_id {}
+variable oci_test_parent_export_parent1_id {}
+`, readModuleTestFile(t, modulesDir, tt.wantChildModule, globalvar.ModuleVariablesFile))
+
+			parent := readModuleTestFile(t, modulesDir, tt.wantParentModule, "compartment_testing.tf")
+			assert.Contains(t, parent, "compartment_id = var.compartment_ocid")
+			assert.Contains(t, readModuleTestFile(t, modulesDir, tt.wantParentModule, globalvar.ModuleOutputsFile), `output oci_test_parent_export_parent1_id {
+  value = oci_test_parent.export_parent1.id
+}`)
+
+			root := readModuleTestFile(t, *ctx.OutputDir, globalvar.ModulesFile)
+			assert.Contains(t, root, "module "+tt.wantChildModule+` {
+  source                                 = "./modules/`+tt.wantChildModule+`"
+  oci_identity_compartment_export_sub_id = module.`+tt.wantModules["oci_identity_compartment.export_sub"]+`.oci_identity_compartment_export_sub_id
+  oci_test_parent_export_parent1_id      = module.`+tt.wantParentModule+`.oci_test_parent_export_parent1_id
+}`)
+			assert.Contains(t, root, "compartment_ocid = var.compartment_ocid")
+
+			assert.Equal(t, "module."+tt.wantChildModule+".oci_test_child.export_child1", getResourceAddress(steps[1].getDiscoveredResources()[0]))
+		})
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetModuleInputName(t *testing.T) {
+	assert.Equal(t, "oci_core_vcn_export_vcn_id", getModuleInputName("oci_core_vcn.export_vcn.id"))
+	assert.Equal(t, "data_oci_identity_availability_domain_export_ad-1_name", getModuleInputName("data.oci_identity_availability_domain.export_ad-1.name"))
+	assert.Equal(t, "oci_identity_availability_domain.export_ad-1", getReferencedResource("data.oci_identity_availability_domain.export_ad-1.name"))
+	assert.Equal(t, "var.compartment_ocid", getReferencedResource("var.compartment_ocid"))
+	assert.Equal(t, "", getReferencedResource("compartment_ocid"))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateOutputLayout(t *testing.T) {
+	outputDir, _ := createOutputDir()
+	defer os.RemoveAll(outputDir)
+	var tfVersion11 tf_export.TfHclVersion = &tf_export.TfHclVersion11{Value: tf_export.TfVersion11}
+	var tfVersion12 tf_export.TfHclVersion = &tf_export.TfHclVersion12{Value: tf_export.TfVersion12}
+
+	tests := []struct {
+		name           string
+		outputLayout   tf_export.OutputLayoutEnum
+		moduleGrouping tf_export.ModuleGroupingEnum
+		generateState  bool
+		tfVersion      *tf_export.TfHclVersion
+		wantErr        bool
+	}{
+		{name: "Test default output layout", tfVersion: &tfVersion12},
+		{name: "Test flat output layout", outputLayout: tf_export.OutputLayoutFlat, moduleGrouping: tf_export.ModuleGroupingService, generateState: true, tfVersion: &tfVersion11},
+		{name: "Test modules output layout", outputLayout: tf_export.OutputLayoutModules, tfVersion: &tfVersion12},
+		{name: "Test modules output layout per compartment", outputLayout: tf_export.OutputLayoutModules, moduleGrouping: tf_export.ModuleGroupingCompartment, tfVersion: &tfVersion12},
+		{name: "Test modules output layout with generate_state", outputLayout: tf_export.OutputLayoutModules, generateState: true, tfVersion: &tfVersion12, wantErr: true},
+		{name: "Test modules output layout with tf_version 0.11", outputLayout: tf_export.OutputLayoutModules, tfVersion: &tfVersion11, wantErr: true},
+		{name: "Test module grouping without modules output layout", moduleGrouping: tf_export.ModuleGroupingCompartment, tfVersion: &tfVersion12, wantErr: true},
+		{name: "Test invalid module grouping", outputLayout: tf_export.OutputLayoutModules, moduleGrouping: "resource", tfVersion: &tfVersion12, wantErr: true},
+		{name: "Test invalid output layout", outputLayout: "directories", tfVersion: &tfVersion12, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := &tf_export.ExportCommandArgs{
+				OutputDir:      &outputDir,
+				GenerateState:  tt.generateState,
+				TFVersion:      tt.tfVersion,
+				Parallelism:    1,
+				OutputLayout:   tt.outputLayout,
+				ModuleGrouping: tt.moduleGrouping,
+			}
+			if err := args.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl2/hcl"
+	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+// compartmentLayout is the layout of the exported resources in a directory per compartment, when the export is recursive
+var compartmentLayout *exportCompartmentLayout
+
+// exportCompartmentLayout assigns the exported resources to the directory of their compartment, and turns the
+// references between the resources of different directories into reads of their terraform_remote_state
+type exportCompartmentLayout struct {
+	// compartments to export, the exported compartment first and then its sub-compartments as they are walked
+	compartmentIds []string
+	// directories of the compartments relative to the output directory, keyed by their OCID. The exported compartment
+	// is in the output directory itself, and each sub-compartment in a directory under the one of its parent.
+	compartmentDirs map[string]string
+	// directories of the resources, keyed by their Terraform reference
+	resourceDirs map[string]string
+	// interpolation maps of the directories, in which the references to the resources of other directories are remote
+	// state outputs
+	interpolationMaps map[string]map[string]string
+	// outputs of the directories that other directories reference, keyed by their name
+	outputs map[string]map[string]string
+}
+
+const remoteStateRootName = "root"
+
+var compartmentDirRegex = regexp.MustCompile(`[^a-zA-Z0-9\-\_\.]+`)
+
+// newExportCompartmentLayout walks the active sub-compartments of the exported compartment
+func newExportCompartmentLayout(ctx *tf_export.ResourceDiscoveryContext) (*exportCompartmentLayout, error) {
+	layout := &exportCompartmentLayout{
+		compartmentIds:    []string{*ctx.CompartmentId},
+		compartmentDirs:   map[string]string{*ctx.CompartmentId: ""},
+		resourceDirs:      map[string]string{},
+		interpolationMaps: map[string]map[string]string{},
+		outputs:           map[string]map[string]string{},
+	}
+	if err := layout.walkCompartments(ctx, *ctx.CompartmentId, ""); err != nil {
+		return nil, err
+	}
+	utils.Logf("[INFO] found %d sub-compartments to export", len(layout.compartmentIds)-1)
+	return layout, nil
+}
+
+func (l *exportCompartmentLayout) walkCompartments(ctx *tf_export.ResourceDiscoveryContext, parentId string, parentDir string) error {
+	req := oci_identity.ListCompartmentsRequest{
+		CompartmentId:  &parentId,
+		LifecycleState: oci_identity.CompartmentLifecycleStateActive,
+	}
+
+	var children []oci_identity.Compartment
+	for {
+		resp, err := identityClientListCompartmentsVar(ctx.Clients, req)
+		if err != nil {
+			return fmt.Errorf("[ERROR] unable to list the sub-compartments of %s: %s", parentId, err.Error())
+		}
+		children = append(children, resp.Items...)
+
+		if resp.OpcNextPage == nil {
+			break
+		}
+		req.Page = resp.OpcNextPage
+	}
+
+	for _, child := range children {
+		if child.Id == nil {
+			continue
+		}
+		name := *child.Id
+		if child.Name != nil && *child.Name != "" {
+			name = *child.Name
+		}
+		dir := filepath.Join(parentDir, compartmentDirRegex.ReplaceAllString(name, "_"))
+
+		l.compartmentIds = append(l.compartmentIds, *child.Id)
+		l.compartmentDirs[*child.Id] = dir
+		if err := l.walkCompartments(ctx, *child.Id, dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addResources assigns the discovered resources to the directory of the compartment the step discovered them in
+func (l *exportCompartmentLayout) addResources(steps []resourceDiscoveryStep) {
+	for _, step := range steps {
+		dir := ""
+		if graphStep, ok := step.(*resourceDiscoveryWithGraph); ok && graphStep.root != nil {
+			dir = l.compartmentDirs[graphStep.root.Id]
+		}
+		for _, resource := range step.getDiscoveredResources() {
+			l.resourceDirs[resource.GetTerraformReference()] = dir
+		}
+	}
+
+	for compartmentId, dir := range l.compartmentDirs {
+		l.interpolationMaps[dir] = l.getCompartmentInterpolationMap(compartmentId, dir)
+	}
+}
+
+// getCompartmentInterpolationMap returns the references of the directory of a compartment, which are the ones of
+// tf_export.ReferenceMap with:
+// - the compartment referenced as `var.compartment_ocid`, and the other compartments no longer referenced by it
+// - the references to the resources of other directories replaced by outputs of their remote state
+func (l *exportCompartmentLayout) getCompartmentInterpolationMap(compartmentId string, dir string) map[string]string {
+	compartmentVar := tf_export.TfHclVersionvar.GetVarHclString("compartment_ocid")
+	interpolationMap := make(map[string]string, len(tf_export.ReferenceMap)+1)
+	interpolationMap[compartmentId] = compartmentVar
+	for value, reference := range tf_export.ReferenceMap {
+		if value == compartmentId || reference == compartmentVar {
+			continue
+		}
+		referencedDir, exists := l.resourceDirs[getReferencedResource(reference)]
+		if !exists || referencedDir == dir {
+			interpolationMap[value] = reference
+			continue
+		}
+		outputName := getModuleInputName(reference)
+		if _, exists := l.outputs[referencedDir]; !exists {
+			l.outputs[referencedDir] = map[string]string{}
+		}
+		l.outputs[referencedDir][outputName] = reference
+		interpolationMap[value] = fmt.Sprintf("data.terraform_remote_state.%s.outputs.%s", getRemoteStateName(referencedDir), outputName)
+	}
+	return interpolationMap
+}
+
+// getRemoteStateName returns the name of the terraform_remote_state of a directory, e.g. `compartment_network_prod`
+// for `network/prod`
+func getRemoteStateName(dir string) string {
+	if dir == "" {
+		return remoteStateRootName
+	}
+	return "compartment_" + moduleNameRegex.ReplaceAllString(strings.ReplaceAll(filepath.ToSlash(dir), ".", "_"), "_")
+}
+
+// writeCompartmentsConfiguration writes the configuration of the resources of the step in the directory of their compartments
+func (r *resourceDiscoveryBaseStep) writeCompartmentsConfiguration() error {
+	dirResources := map[string][]*tf_export.OCIResource{}
+	for _, resource := range r.discoveredResources {
+		dir := compartmentLayout.resourceDirs[resource.GetTerraformReference()]
+		dirResources[dir] = append(dirResources[dir], resource)
+	}
+
+	dirs := make([]string, 0, len(dirResources))
+	for dir := range dirResources {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	for _, dir := range dirs {
+		compartmentDir := filepath.Join(*r.ctx.OutputDir, dir)
+		if err := os.MkdirAll(compartmentDir, os.ModePerm); err != nil {
+			return err
+		}
+		configOutputFile := filepath.Join(compartmentDir, fmt.Sprintf("%s.tf", r.name))
+		exportedResourceCount, err := r.writeResourcesConfiguration(configOutputFile, dirResources[dir], compartmentLayout.interpolationMaps[dir])
+		if err != nil {
+			return err
+		}
+		r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Found %d '%s' resources. Generated under '%s'", exportedResourceCount, r.name, configOutputFile))
+	}
+	r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Time taken for discovery: %v, generating state: %v", r.timeTakenForDiscovery, r.timeTakenForGeneratingState))
+	return nil
+}
+
+/*
+generateCompartmentsFiles writes, once the configuration of all the compartments is written, in the directory of each
+compartment with resources and in the output directory:
+- the provider and the variables, with `compartment_ocid` set to the compartment
+- the outputs that the other directories read from its remote state
+- the terraform_remote_state of the other directories it reads outputs from
+- the import blocks of its resources, with import_mode blocks
+*/
+func generateCompartmentsFiles(ctx *tf_export.ResourceDiscoveryContext) error {
+	dirSet := map[string]bool{"": true}
+	for _, dir := range compartmentLayout.resourceDirs {
+		dirSet[dir] = true
+	}
+	dirs := make([]string, 0, len(dirSet))
+	for dir := range dirSet {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	remoteStateDirs := map[string]string{}
+	for _, dir := range dirs {
+		remoteStateDirs[getRemoteStateName(dir)] = dir
+	}
+
+	// the remote state outputs read by each directory, keyed by the name of the remote state
+	dirRemoteStates := map[string]map[string][]string{}
+	usedOutputs := map[string]map[string]bool{}
+	for _, dir := range dirs {
+		traversals, err := getConfigurationTraversals(filepath.Join(*ctx.OutputDir, dir), globalvar.ModuleOutputsFile, globalvar.RemoteStateFile)
+		if err != nil {
+			return err
+		}
+		dirRemoteStates[dir] = getRemoteStateOutputs(traversals)
+		for name, outputs := range dirRemoteStates[dir] {
+			remoteStateDir, exists := remoteStateDirs[name]
+			if !exists {
+				continue
+			}
+			if _, exists := usedOutputs[remoteStateDir]; !exists {
+				usedOutputs[remoteStateDir] = map[string]bool{}
+			}
+			for _, output := range outputs {
+				usedOutputs[remoteStateDir][output] = true
+			}
+		}
+	}
+
+	dirCompartments := map[string]string{}
+	for compartmentId, dir := range compartmentLayout.compartmentDirs {
+		dirCompartments[dir] = compartmentId
+	}
+
+	dirResources := map[string][]*tf_export.OCIResource{}
+	for _, resource := range ctx.DiscoveredResources {
+		dir := compartmentLayout.resourceDirs[resource.GetTerraformReference()]
+		dirResources[dir] = append(dirResources[dir], resource)
+	}
+
+	for _, dir := range dirs {
+		outputDir := filepath.Join(*ctx.OutputDir, dir)
+
+		vars := make(map[string]string, len(tf_export.Vars))
+		for variable, defaultVal := range tf_export.Vars {
+			vars[variable] = defaultVal
+		}
+		if compartmentId, exists := dirCompartments[dir]; exists {
+			vars["compartment_ocid"] = fmt.Sprintf("\"%s\"", compartmentId)
+		}
+		if err := generateProviderFile(&outputDir); err != nil {
+			return err
+		}
+		if err := generateVarsFile(vars, &outputDir); err != nil {
+			return err
+		}
+
+		outputs := make([]string, 0, len(usedOutputs[dir]))
+		for output := range usedOutputs[dir] {
+			outputs = append(outputs, output)
+		}
+		sort.Strings(outputs)
+		if len(outputs) > 0 {
+			outputsBuilder := &strings.Builder{}
+			outputsBuilder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+			for _, output := range outputs {
+				outputsBuilder.WriteString(fmt.Sprintf("output %s {\n\tvalue = %s\n}\n\n", output, compartmentLayout.outputs[dir][output]))
+			}
+			if err := writeModuleFile(filepath.Join(outputDir, globalvar.ModuleOutputsFile), outputsBuilder.String()); err != nil {
+				return err
+			}
+		}
+
+		remoteStates := make([]string, 0, len(dirRemoteStates[dir]))
+		for name := range dirRemoteStates[dir] {
+			if _, exists := remoteStateDirs[name]; exists {
+				remoteStates = append(remoteStates, name)
+			}
+		}
+		sort.Strings(remoteStates)
+		if len(remoteStates) > 0 {
+			remoteStateBuilder := &strings.Builder{}
+			remoteStateBuilder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+			for _, name := range remoteStates {
+				statePath, err := filepath.Rel(outputDir, filepath.Join(*ctx.OutputDir, remoteStateDirs[name], globalvar.DefaultStateFilename))
+				if err != nil {
+					return err
+				}
+				remoteStateBuilder.WriteString(fmt.Sprintf("data terraform_remote_state %s {\n\tbackend = \"local\"\n\tconfig = {\n\t\tpath = \"%s\"\n\t}\n}\n\n", name, filepath.ToSlash(statePath)))
+			}
+			if err := writeModuleFile(filepath.Join(outputDir, globalvar.RemoteStateFile), remoteStateBuilder.String()); err != nil {
+				return err
+			}
+		}
+
+		if ctx.ImportMode == tf_export.ImportModeBlocks {
+			if err := writeImportsFile(ctx, outputDir, dirResources[dir]); err != nil {
+				return err
+			}
+		}
+	}
+
+	ctx.SummaryStatements = append(ctx.SummaryStatements, fmt.Sprintf("Exported %d compartments into %d directories under '%s'", len(compartmentLayout.compartmentIds), len(dirs), *ctx.OutputDir))
+	return nil
+}
+
+// getRemoteStateOutputs returns the outputs read from each terraform_remote_state, e.g. `vcn_id` for
+// `data.terraform_remote_state.root.outputs.vcn_id`, keyed by the name of the remote state
+func getRemoteStateOutputs(traversals []hcl.Traversal) map[string][]string {
+	outputSets := map[string]map[string]bool{}
+	for _, traversal := range traversals {
+		if traversal.RootName() != "data" || len(traversal) < 5 {
+			continue
+		}
+		class, classOk := traversal[1].(hcl.TraverseAttr)
+		name, nameOk := traversal[2].(hcl.TraverseAttr)
+		attr, attrOk := traversal[3].(hcl.TraverseAttr)
+		output, outputOk := traversal[4].(hcl.TraverseAttr)
+		if !classOk || !nameOk || !attrOk || !outputOk || class.Name != "terraform_remote_state" || attr.Name != "outputs" {
+			continue
+		}
+		if _, exists := outputSets[name.Name]; !exists {
+			outputSets[name.Name] = map[string]bool{}
+		}
+		outputSets[name.Name][output.Name] = true
+	}
+
+	outputs := make(map[string][]string, len(outputSets))
+	for name, outputSet := range outputSets {
+		for output := range outputSet {
+			outputs[name] = append(outputs[name], output)
+		}
+		sort.Strings(outputs[name])
+	}
+	return outputs
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/stretchr/testify/assert"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+const (
+	recursiveTestNetworkOcid = "ocid1.compartment.oc1..network"
+	recursiveTestProdOcid    = "ocid1.compartment.oc1..prod"
+	recursiveTestAppOcid     = "ocid1.compartment.oc1..app"
+)
+
+// mockRecursiveTestCompartments mocks the sub-compartments `network`, and `network/prod` across two pages, and `app`
+func mockRecursiveTestCompartments(rootCompartmentId string) {
+	identityClientListCompartmentsVar = func(clients *tf_client.OracleClients, req oci_identity.ListCompartmentsRequest) (oci_identity.ListCompartmentsResponse, error) {
+		newCompartment := func(id string, name string) oci_identity.Compartment {
+			return oci_identity.Compartment{Id: &id, Name: &name}
+		}
+		switch *req.CompartmentId {
+		case rootCompartmentId:
+			if req.Page == nil {
+				nextPage := "2"
+				return oci_identity.ListCompartmentsResponse{Items: []oci_identity.Compartment{newCompartment(recursiveTestNetworkOcid, "network")}, OpcNextPage: &nextPage}, nil
+			}
+			return oci_identity.ListCompartmentsResponse{Items: []oci_identity.Compartment{newCompartment(recursiveTestAppOcid, "app")}}, nil
+		case recursiveTestNetworkOcid:
+			return oci_identity.ListCompartmentsResponse{Items: []oci_identity.Compartment{newCompartment(recursiveTestProdOcid, "prod")}}, nil
+		}
+		return oci_identity.ListCompartmentsResponse{}, nil
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetDiscoverResourceWithGraphSteps_recursive(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	defer func(listCompartments func(*tf_client.OracleClients, oci_identity.ListCompartmentsRequest) (oci_identity.ListCompartmentsResponse, error)) {
+		identityClientListCompartmentsVar = listCompartments
+		compartmentLayout = nil
+	}(identityClientListCompartmentsVar)
+
+	ctx := getTestCtx()
+	defer os.RemoveAll(*ctx.OutputDir)
+	ctx.Recursive = true
+	mockRecursiveTestCompartments(*ctx.CompartmentId)
+
+	steps, err := getDiscoverResourceWithGraphSteps(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{*ctx.CompartmentId, recursiveTestNetworkOcid, recursiveTestProdOcid, recursiveTestAppOcid}, compartmentLayout.compartmentIds)
+	assert.Equal(t, map[string]string{
+		*ctx.CompartmentId:       "",
+		recursiveTestNetworkOcid: "network",
+		recursiveTestProdOcid:    filepath.Join("network", "prod"),
+		recursiveTestAppOcid:     "app",
+	}, compartmentLayout.compartmentDirs)
+
+	var stepCompartments []string
+	for _, step := range steps {
+		stepCompartments = append(stepCompartments, step.(*resourceDiscoveryWithGraph).root.CompartmentId)
+	}
+	assert.Equal(t, compartmentLayout.compartmentIds, stepCompartments)
+	assert.Equal(t, "var.compartment_ocid", tf_export.ReferenceMap[*ctx.CompartmentId])
+	assert.NotContains(t, tf_export.ReferenceMap, recursiveTestNetworkOcid)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCompartmentLayout(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	defer func(referenceMap map[string]string, vars map[string]string) {
+		tf_export.ReferenceMap = referenceMap
+		tf_export.Vars = vars
+		compartmentLayout = nil
+	}(tf_export.ReferenceMap, tf_export.Vars)
+
+	ctx := getTestCtx()
+	defer os.RemoveAll(*ctx.OutputDir)
+	ctx.Recursive = true
+	ctx.ImportMode = tf_export.ImportModeBlocks
+	compartmentId := *ctx.CompartmentId
+	tf_export.Vars = map[string]string{"compartment_ocid": `"dummy_compartment_id"`}
+	tf_export.ReferenceMap = map[string]string{
+		compartmentId:            "var.compartment_ocid",
+		"ocid1.parent.oc1..aaaa": "oci_test_parent.export_parent1.id",
+	}
+
+	compartmentLayout = &exportCompartmentLayout{
+		compartmentIds:    []string{compartmentId, recursiveTestNetworkOcid, recursiveTestProdOcid},
+		compartmentDirs:   map[string]string{compartmentId: "", recursiveTestNetworkOcid: "network", recursiveTestProdOcid: filepath.Join("network", "prod")},
+		resourceDirs:      map[string]string{},
+		interpolationMaps: map[string]map[string]string{},
+		outputs:           map[string]map[string]string{},
+	}
+	newStep := func(compartmentId string, name string, resources ...*tf_export.OCIResource) resourceDiscoveryStep {
+		step := newModuleTestStep(ctx, name, resources...).(*resourceDiscoveryWithGraph)
+		step.root = &tf_export.OCIResource{CompartmentId: compartmentId, TerraformResource: tf_export.TerraformResource{Id: compartmentId, TerraformClass: "oci_identity_compartment"}}
+		return step
+	}
+	steps := []resourceDiscoveryStep{
+		newStep(compartmentId, "compartment_testing", newModuleTestResource("oci_test_parent", "export_parent1", "ocid1.parent.oc1..aaaa", compartmentId,
+			map[string]interface{}{"compartment_id": compartmentId, "display_name": "parent1"})),
+		newStep(recursiveTestProdOcid, "compartment_testing", newModuleTestResource("oci_test_child", "export_child1", "ocid1.child.oc1..aaaa", recursiveTestProdOcid,
+			map[string]interface{}{"compartment_id": recursiveTestProdOcid, "parent_id": "ocid1.parent.oc1..aaaa"})),
+	}
+
+	compartmentLayout.addResources(steps)
+	assert.Equal(t, map[string]string{
+		"oci_test_parent.export_parent1": "",
+		"oci_test_child.export_child1":   filepath.Join("network", "prod"),
+	}, compartmentLayout.resourceDirs)
+
+	for _, step := range steps {
+		assert.NoError(t, step.writeConfiguration())
+	}
+	assert.NoError(t, generateCompartmentsFiles(ctx))
+
+	prodDir := filepath.Join(*ctx.OutputDir, "network", "prod")
+	child := readModuleTestFile(t, prodDir, "compartment_testing.tf")
+	assert.Contains(t, child, "compartment_id = var.compartment_ocid")
+	assert.Contains(t, child, "parent_id      = data.terraform_remote_state.root.outputs.oci_test_parent_export_parent1_id")
+	assert.Contains(t, readModuleTestFile(t, prodDir, globalvar.RemoteStateFile), `data terraform_remote_state root {
+  backend = "local"
+  config = {
+    path = "../../terraform.tfstate"
+  }
+}`)
+	assert.Contains(t, readModuleTestFile(t, prodDir, globalvar.VarsFile), `variable compartment_ocid { default = "`+recursiveTestProdOcid+`" }`)
+	assert.Contains(t, readModuleTestFile(t, prodDir, globalvar.ImportsFile), `to = oci_test_child.export_child1`)
+	assert.FileExists(t, filepath.Join(prodDir, globalvar.ProviderFile))
+	assert.NoFileExists(t, filepath.Join(prodDir, globalvar.ModuleOutputsFile))
+
+	parent := readModuleTestFile(t, *ctx.OutputDir, "compartment_testing.tf")
+	assert.Contains(t, parent, "compartment_id = var.compartment_ocid")
+	assert.Contains(t, readModuleTestFile(t, *ctx.OutputDir, globalvar.ModuleOutputsFile), `output oci_test_parent_export_parent1_id {
+  value = oci_test_parent.export_parent1.id
+}`)
+	assert.Contains(t, readModuleTestFile(t, *ctx.OutputDir, globalvar.VarsFile), `variable compartment_ocid { default = "dummy_compartment_id" }`)
+	assert.NoFileExists(t, filepath.Join(*ctx.OutputDir, globalvar.RemoteStateFile))
+	assert.NoFileExists(t, filepath.Join(*ctx.OutputDir, "network", globalvar.ProviderFile))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetRemoteStateName(t *testing.T) {
+	assert.Equal(t, "root", getRemoteStateName(""))
+	assert.Equal(t, "compartment_network_prod", getRemoteStateName(filepath.Join("network", "prod")))
+	assert.Equal(t, "compartment_dev_1", getRemoteStateName("dev.1"))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateRecursive(t *testing.T) {
+	outputDir, _ := createOutputDir()
+	defer os.RemoveAll(outputDir)
+	var tfVersion11 tf_export.TfHclVersion = &tf_export.TfHclVersion11{Value: tf_export.TfVersion11}
+	var tfVersion12 tf_export.TfHclVersion = &tf_export.TfHclVersion12{Value: tf_export.TfVersion12}
+
+	tests := []struct {
+		name          string
+		ids           []string
+		generateState bool
+		outputLayout  tf_export.OutputLayoutEnum
+		baselinePath  string
+		tfVersion     *tf_export.TfHclVersion
+		wantErr       bool
+	}{
+		{name: "Test recursive", tfVersion: &tfVersion12},
+		{name: "Test recursive with ids", ids: []string{"oci_core_vcn:ocid1.vcn.oc1..aaaa"}, tfVersion: &tfVersion12, wantErr: true},
+		{name: "Test recursive with generate_state", generateState: true, tfVersion: &tfVersion12, wantErr: true},
+		{name: "Test recursive with modules output layout", outputLayout: tf_export.OutputLayoutModules, tfVersion: &tfVersion12, wantErr: true},
+		{name: "Test recursive with baseline_path", baselinePath: outputDir, tfVersion: &tfVersion12, wantErr: true},
+		{name: "Test recursive with tf_version 0.11", tfVersion: &tfVersion11, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := &tf_export.ExportCommandArgs{
+				OutputDir:     &outputDir,
+				IDs:           tt.ids,
+				GenerateState: tt.generateState,
+				TFVersion:     tt.tfVersion,
+				Parallelism:   1,
+				OutputLayout:  tt.outputLayout,
+				BaselinePath:  tt.baselinePath,
+				Recursive:     true,
+			}
+			if err := args.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -21,11 +21,13 @@ import (
 
 	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
 
 	"github.com/hashicorp/hcl2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var isInitDone bool
@@ -303,13 +305,39 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 	if len(r.getDiscoveredResources()) == 0 {
 		return nil
 	}
+
+	if r.ctx.OutputLayout == tf_export.OutputLayoutModules {
+		return r.writeModulesConfiguration()
+	}
+
+	if compartmentLayout != nil {
+		return r.writeCompartmentsConfiguration()
+	}
+
 	configOutputFile := fmt.Sprintf("%s%s%s.tf", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
-	tmpConfigOutputFile := fmt.Sprintf("%s%s%s.tf.tmp", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
-	file, err := os.OpenFile(tmpConfigOutputFile, os.O_CREATE|os.O_RDWR, 0666)
+	exportedResourceCount, err := r.writeResourcesConfiguration(configOutputFile, r.discoveredResources, tf_export.ReferenceMap)
 	if err != nil {
 		return err
 	}
 
+	if r.ctx.TargetSpecificResources {
+		r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Found %d resources. Generated under '%s'", exportedResourceCount, configOutputFile))
+	} else {
+		r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Found %d '%s' resources. Generated under '%s'", exportedResourceCount, r.name, configOutputFile))
+	}
+	r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Time taken for discovery: %v, generating state: %v", r.timeTakenForDiscovery, r.timeTakenForGeneratingState))
+	return nil
+}
+
+// writeResourcesConfiguration writes the configuration of the resources to the file, with the references in the
+// interpolation map, and returns the number of resources written
+func (r *resourceDiscoveryBaseStep) writeResourcesConfiguration(configOutputFile string, resources []*tf_export.OCIResource, interpolationMap map[string]string) (int, error) {
+	tmpConfigOutputFile := fmt.Sprintf("%s.tmp", configOutputFile)
+	file, err := os.OpenFile(tmpConfigOutputFile, os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return 0, err
+	}
+
 	// Build the HCL config
 	// Note that we still build a TF file even if no resources were discovered for this TF file.
 	// A user may run this command multiple times and may see stale resources if we don't overwrite the file with
@@ -318,14 +346,14 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 	builder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
 
 	exportedResourceCount := 0
-	for _, resource := range r.discoveredResources {
+	for _, resource := range resources {
 
 		// Skip writing the config for resources for which import command failed
 		if !resource.IsErrorResource {
 			utils.Logf("[INFO] ===> Generating resource '%s'", resource.GetTerraformReference())
-			if err := resource.GetHCLString(builder, tf_export.ReferenceMap); err != nil {
+			if err := resource.GetHCLString(builder, interpolationMap); err != nil {
 				_ = file.Close()
-				return err
+				return 0, err
 			}
 
 			if resource.TerraformTypeInfo != nil && len(resource.TerraformTypeInfo.IgnorableRequiredMissingAttributes) > 0 {
@@ -341,7 +369,9 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 				missingAttributesPerResourceLock.Unlock()
 			}
 
+			r.ctx.CtxLock.Lock()
 			r.ctx.DiscoveredResources = append(r.ctx.DiscoveredResources, resource)
+			r.ctx.CtxLock.Unlock()
 			exportedResourceCount++
 		} else {
 			// remove missing attributes info if present for a failed resource
@@ -359,24 +389,17 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 	_, err = file.WriteString(string(formattedString))
 	if err != nil {
 		_ = file.Close()
-		return err
+		return 0, err
 	}
 
 	if fErr := file.Close(); fErr != nil {
-		return fErr
+		return 0, fErr
 	}
 
 	if err := os.Rename(tmpConfigOutputFile, configOutputFile); err != nil {
-		return err
-	}
-
-	if r.ctx.TargetSpecificResources {
-		r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Found %d resources. Generated under '%s'", exportedResourceCount, configOutputFile))
-	} else {
-		r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Found %d '%s' resources. Generated under '%s'", exportedResourceCount, r.name, configOutputFile))
+		return 0, err
 	}
-	r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Time taken for discovery: %v, generating state: %v", r.timeTakenForDiscovery, r.timeTakenForGeneratingState))
-	return nil
+	return exportedResourceCount, nil
 }
 
 func (r *resourceDiscoveryBaseStep) getOmittedResources() []*tf_export.OCIResource {
@@ -479,14 +502,14 @@ func (r *resourceDiscoveryWithTargetIds) discover() error {
 
 		utils.Logf("===> Finding resource with ID '%s' and type '%s'", resourceId, resourceClass)
 		resourceSchema, exists := tf_export.ResourcesMap[resourceClass]
-		if !exists || resourceSchema.Read == nil {
+		if !exists || (resourceSchema.Read == nil && resourceSchema.ReadContext == nil) {
 			utils.Logf("[WARN] No valid resource schema could be found. Skipping.")
 			continue
 		}
 
 		d := resourceSchema.Data(nil)
 		d.SetId(resourceId)
-		if err := resourceSchema.Read(d, r.ctx.Clients); err != nil {
+		if err := readResource(resourceSchema, d, r.ctx.Clients); err != nil {
 			utils.Logf("[WARN] Unable to read resource due to error: %v", err)
 			continue
 		}
@@ -603,3 +626,11 @@ func (r *resourceDiscoveryBaseStep) mergeGeneratedStateFile() error {
 	return nil
 
 }
+
+// readResource reads the resource with its Read function, or its ReadContext function if it takes a context
+func readResource(resourceSchema *schema.Resource, d *schema.ResourceData, clients interface{}) error {
+	if resourceSchema.Read != nil {
+		return resourceSchema.Read(d, clients)
+	}
+	return tfresource.ErrorFromDiagnostics(resourceSchema.ReadContext(context.Background(), d, clients))
+}
@@ -341,6 +341,7 @@ func (s *AdmKnowledgeBaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.KnowledgeBase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -376,6 +377,8 @@ func (s *AdmKnowledgeBaseResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.KnowledgeBaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
 	response, err := s.Client.UpdateKnowledgeBase(context.Background(), request)
@@ -393,6 +396,8 @@ func (s *AdmKnowledgeBaseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.KnowledgeBaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
 	response, err := s.Client.DeleteKnowledgeBase(context.Background(), request)
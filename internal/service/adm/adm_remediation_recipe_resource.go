@@ -664,6 +664,7 @@ func (s *AdmRemediationRecipeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.RemediationRecipe
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -753,6 +754,8 @@ func (s *AdmRemediationRecipeResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
 	response, err := s.Client.UpdateRemediationRecipe(context.Background(), request)
@@ -770,6 +773,8 @@ func (s *AdmRemediationRecipeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RemediationRecipeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
 	response, err := s.Client.DeleteRemediationRecipe(context.Background(), request)
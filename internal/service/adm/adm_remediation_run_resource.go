@@ -273,6 +273,7 @@ func (s *AdmRemediationRunResourceCrud) Get() error {
 	}
 
 	s.Res = &response.RemediationRun
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -315,6 +316,8 @@ func (s *AdmRemediationRunResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RemediationRunId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
 	response, err := s.Client.UpdateRemediationRun(context.Background(), request)
@@ -354,6 +357,8 @@ func (s *AdmRemediationRunResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RemediationRunId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
 	_, err = s.Client.DeleteRemediationRun(context.Background(), request)
@@ -513,6 +513,7 @@ func (s *AdmVulnerabilityAuditResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VulnerabilityAudit
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -548,6 +549,8 @@ func (s *AdmVulnerabilityAuditResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VulnerabilityAuditId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
 	response, err := s.Client.UpdateVulnerabilityAudit(context.Background(), request)
@@ -565,6 +568,8 @@ func (s *AdmVulnerabilityAuditResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VulnerabilityAuditId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
 	_, err := s.Client.DeleteVulnerabilityAudit(context.Background(), request)
@@ -382,6 +382,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AiPrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -430,6 +431,8 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
 	response, err := s.Client.UpdateAiPrivateEndpoint(context.Background(), request)
@@ -447,6 +450,8 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AiPrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
 	response, err := s.Client.DeleteAiPrivateEndpoint(context.Background(), request)
@@ -422,6 +422,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DataAsset
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -462,6 +463,8 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
 	response, err := s.Client.UpdateDataAsset(context.Background(), request)
@@ -479,6 +482,8 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DataAssetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
 	_, err := s.Client.DeleteDataAsset(context.Background(), request)
@@ -406,6 +406,7 @@ func (s *AiAnomalyDetectionDetectAnomalyJobResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DetectAnomalyJob
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -434,6 +435,8 @@ func (s *AiAnomalyDetectionDetectAnomalyJobResourceCrud) Update() error {
 		request.DisplayName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
 	response, err := s.Client.UpdateDetectAnomalyJob(context.Background(), request)
@@ -451,6 +454,8 @@ func (s *AiAnomalyDetectionDetectAnomalyJobResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DetectAnomalyJobId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
 	_, err := s.Client.DeleteDetectAnomalyJob(context.Background(), request)
@@ -535,6 +535,7 @@ func (s *AiAnomalyDetectionModelResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Model
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -575,6 +576,8 @@ func (s *AiAnomalyDetectionModelResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
 	response, err := s.Client.UpdateModel(context.Background(), request)
@@ -592,6 +595,8 @@ func (s *AiAnomalyDetectionModelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
 	response, err := s.Client.DeleteModel(context.Background(), request)
@@ -334,6 +334,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Project
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -374,6 +375,8 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ProjectId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
 	response, err := s.Client.UpdateProject(context.Background(), request)
@@ -391,6 +394,8 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ProjectId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
 	response, err := s.Client.DeleteProject(context.Background(), request)
@@ -813,6 +813,7 @@ func (s *AiDocumentModelResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Model
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -853,6 +854,8 @@ func (s *AiDocumentModelResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_document")
 
 	response, err := s.Client.UpdateModel(context.Background(), request)
@@ -875,6 +878,8 @@ func (s *AiDocumentModelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_document")
 
 	response, err := s.Client.DeleteModel(context.Background(), request)
@@ -338,6 +338,7 @@ func (s *AiDocumentProjectResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Project
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -378,6 +379,8 @@ func (s *AiDocumentProjectResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ProjectId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_document")
 
 	response, err := s.Client.UpdateProject(context.Background(), request)
@@ -395,6 +398,8 @@ func (s *AiDocumentProjectResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ProjectId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_document")
 
 	response, err := s.Client.DeleteProject(context.Background(), request)
@@ -359,6 +359,7 @@ func (s *AiLanguageEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Endpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -409,6 +410,8 @@ func (s *AiLanguageEndpointResourceCrud) Update() error {
 		request.ModelId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_language")
 
 	// response, err := s.Client.UpdateEndpoint(context.Background(), request)
@@ -435,6 +438,8 @@ func (s *AiLanguageEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.EndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_language")
 
 	response, err := s.Client.DeleteEndpoint(context.Background(), request)
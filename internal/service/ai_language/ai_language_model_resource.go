@@ -865,6 +865,7 @@ func (s *AiLanguageModelResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Model
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -905,6 +906,8 @@ func (s *AiLanguageModelResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_language")
 
 	_, err := s.Client.UpdateModel(context.Background(), request)
@@ -929,6 +932,8 @@ func (s *AiLanguageModelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_language")
 
 	response, err := s.Client.DeleteModel(context.Background(), request)
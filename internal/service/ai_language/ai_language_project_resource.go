@@ -336,6 +336,7 @@ func (s *AiLanguageProjectResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Project
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -376,6 +377,8 @@ func (s *AiLanguageProjectResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ProjectId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_language")
 
 	_, err := s.Client.UpdateProject(context.Background(), request)
@@ -401,6 +404,8 @@ func (s *AiLanguageProjectResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ProjectId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_language")
 
 	response, err := s.Client.DeleteProject(context.Background(), request)
@@ -6,7 +6,7 @@ package ai_language
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_ai_language_endpoint", AiLanguageEndpointResource())
-	tfresource.RegisterResource("oci_ai_language_model", AiLanguageModelResource())
-	tfresource.RegisterResource("oci_ai_language_project", AiLanguageProjectResource())
+	tfresource.RegisterWorkRequestResource("oci_ai_language_endpoint", AiLanguageEndpointResource())
+	tfresource.RegisterWorkRequestResource("oci_ai_language_model", AiLanguageModelResource())
+	tfresource.RegisterWorkRequestResource("oci_ai_language_project", AiLanguageProjectResource())
 }
@@ -617,6 +617,7 @@ func (s *AiVisionModelResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Model
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -657,6 +658,8 @@ func (s *AiVisionModelResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_vision")
 
 	response, err := s.Client.UpdateModel(context.Background(), request)
@@ -674,6 +677,8 @@ func (s *AiVisionModelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_vision")
 
 	response, err := s.Client.DeleteModel(context.Background(), request)
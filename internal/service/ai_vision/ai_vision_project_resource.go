@@ -343,6 +343,7 @@ func (s *AiVisionProjectResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Project
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -383,6 +384,8 @@ func (s *AiVisionProjectResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ProjectId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_vision")
 
 	response, err := s.Client.UpdateProject(context.Background(), request)
@@ -400,6 +403,8 @@ func (s *AiVisionProjectResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ProjectId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_vision")
 
 	response, err := s.Client.DeleteProject(context.Background(), request)
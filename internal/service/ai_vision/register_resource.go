@@ -6,6 +6,6 @@ package ai_vision
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_ai_vision_model", AiVisionModelResource())
-	tfresource.RegisterResource("oci_ai_vision_project", AiVisionProjectResource())
+	tfresource.RegisterWorkRequestResource("oci_ai_vision_model", AiVisionModelResource())
+	tfresource.RegisterWorkRequestResource("oci_ai_vision_project", AiVisionProjectResource())
 }
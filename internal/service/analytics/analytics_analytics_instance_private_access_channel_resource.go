@@ -443,6 +443,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Get() error
 	}
 
 	s.Res = &response.PrivateAccessChannel
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -552,6 +553,8 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Update() er
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
 	response, err := s.Client.UpdatePrivateAccessChannel(context.Background(), request)
@@ -576,6 +579,8 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelResourceCrud) Delete() er
 		request.PrivateAccessChannelKey = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
 	response, err := s.Client.DeletePrivateAccessChannel(context.Background(), request)
@@ -649,6 +649,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AnalyticsInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -718,6 +719,8 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Update() error {
 		request.LicenseType = oci_analytics.LicenseTypeEnum(licenseType.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
 	response, err := s.Client.UpdateAnalyticsInstance(context.Background(), request)
@@ -763,6 +766,8 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AnalyticsInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
 	response, err := s.Client.DeleteAnalyticsInstance(context.Background(), request)
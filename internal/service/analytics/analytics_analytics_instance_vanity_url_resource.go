@@ -430,6 +430,8 @@ func (s *AnalyticsAnalyticsInstanceVanityUrlResourceCrud) Update() error {
 		request.VanityUrlKey = &vanityUrlKey
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
 	response, err := s.Client.UpdateVanityUrl(context.Background(), request)
@@ -454,6 +456,8 @@ func (s *AnalyticsAnalyticsInstanceVanityUrlResourceCrud) Delete() error {
 		request.VanityUrlKey = &vanityUrlKey
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
 	response, err := s.Client.DeleteVanityUrl(context.Background(), request)
@@ -286,6 +286,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AnnouncementSubscription
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -341,6 +342,8 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Update() erro
 		request.PreferredTimeZone = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
 	response, err := s.Client.UpdateAnnouncementSubscription(context.Background(), request)
@@ -358,6 +361,8 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Delete() erro
 	tmp := s.D.Id()
 	request.AnnouncementSubscriptionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
 	_, err := s.Client.DeleteAnnouncementSubscription(context.Background(), request)
@@ -177,6 +177,8 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) U
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
 	response, err := s.Client.UpdateFilterGroup(context.Background(), request)
@@ -201,6 +203,8 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) D
 		request.FilterGroupName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
 	_, err := s.Client.DeleteFilterGroup(context.Background(), request)
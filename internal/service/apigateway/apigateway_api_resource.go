@@ -369,6 +369,7 @@ func (s *ApigatewayApiResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Api
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -409,6 +410,8 @@ func (s *ApigatewayApiResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	response, err := s.Client.UpdateApi(context.Background(), request)
@@ -430,6 +433,8 @@ func (s *ApigatewayApiResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ApiId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	response, err := s.Client.DeleteApi(context.Background(), request)
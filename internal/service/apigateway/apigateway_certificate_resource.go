@@ -376,6 +376,7 @@ func (s *ApigatewayCertificateResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Certificate
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -411,6 +412,8 @@ func (s *ApigatewayCertificateResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	response, err := s.Client.UpdateCertificate(context.Background(), request)
@@ -428,6 +431,8 @@ func (s *ApigatewayCertificateResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CertificateId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	_, err := s.Client.DeleteCertificate(context.Background(), request)
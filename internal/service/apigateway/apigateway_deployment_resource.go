@@ -3306,6 +3306,7 @@ func (s *ApigatewayDeploymentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Deployment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -3352,6 +3353,8 @@ func (s *ApigatewayDeploymentResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	response, err := s.Client.UpdateDeployment(context.Background(), request)
@@ -3369,6 +3372,8 @@ func (s *ApigatewayDeploymentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DeploymentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	response, err := s.Client.DeleteDeployment(context.Background(), request)
@@ -554,6 +554,7 @@ func (s *ApigatewayGatewayResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Gateway
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -636,6 +637,8 @@ func (s *ApigatewayGatewayResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	response, err := s.Client.UpdateGateway(context.Background(), request)
@@ -653,6 +656,8 @@ func (s *ApigatewayGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.GatewayId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	response, err := s.Client.DeleteGateway(context.Background(), request)
@@ -390,6 +390,7 @@ func (s *ApigatewaySubscriberResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Subscriber
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -455,6 +456,8 @@ func (s *ApigatewaySubscriberResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	response, err := s.Client.UpdateSubscriber(context.Background(), request)
@@ -472,6 +475,8 @@ func (s *ApigatewaySubscriberResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SubscriberId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	response, err := s.Client.DeleteSubscriber(context.Background(), request)
@@ -445,6 +445,7 @@ func (s *ApigatewayUsagePlanResourceCrud) Get() error {
 	}
 
 	s.Res = &response.UsagePlan
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -497,6 +498,8 @@ func (s *ApigatewayUsagePlanResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.UsagePlanId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	response, err := s.Client.UpdateUsagePlan(context.Background(), request)
@@ -514,6 +517,8 @@ func (s *ApigatewayUsagePlanResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.UsagePlanId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
 	response, err := s.Client.DeleteUsagePlan(context.Background(), request)
@@ -6,10 +6,10 @@ package apigateway
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_apigateway_api", ApigatewayApiResource())
-	tfresource.RegisterResource("oci_apigateway_certificate", ApigatewayCertificateResource())
-	tfresource.RegisterResource("oci_apigateway_deployment", ApigatewayDeploymentResource())
-	tfresource.RegisterResource("oci_apigateway_gateway", ApigatewayGatewayResource())
-	tfresource.RegisterResource("oci_apigateway_subscriber", ApigatewaySubscriberResource())
-	tfresource.RegisterResource("oci_apigateway_usage_plan", ApigatewayUsagePlanResource())
+	tfresource.RegisterWorkRequestResource("oci_apigateway_api", ApigatewayApiResource())
+	tfresource.RegisterWorkRequestResource("oci_apigateway_certificate", ApigatewayCertificateResource())
+	tfresource.RegisterWorkRequestResource("oci_apigateway_deployment", ApigatewayDeploymentResource())
+	tfresource.RegisterWorkRequestResource("oci_apigateway_gateway", ApigatewayGatewayResource())
+	tfresource.RegisterWorkRequestResource("oci_apigateway_subscriber", ApigatewaySubscriberResource())
+	tfresource.RegisterWorkRequestResource("oci_apigateway_usage_plan", ApigatewayUsagePlanResource())
 }
@@ -347,6 +347,7 @@ func (s *ApmApmDomainResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ApmDomain
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -387,6 +388,8 @@ func (s *ApmApmDomainResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
 	response, err := s.Client.UpdateApmDomain(context.Background(), request)
@@ -404,6 +407,8 @@ func (s *ApmApmDomainResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ApmDomainId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
 	response, err := s.Client.DeleteApmDomain(context.Background(), request)
@@ -348,6 +348,7 @@ func (s *ApmConfigConfigResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Config
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -358,6 +359,8 @@ func (s *ApmConfigConfigResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
 
 	response, err := s.Client.UpdateConfig(context.Background(), request)
@@ -382,6 +385,8 @@ func (s *ApmConfigConfigResourceCrud) Delete() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
 
 	_, err := s.Client.DeleteConfig(context.Background(), request)
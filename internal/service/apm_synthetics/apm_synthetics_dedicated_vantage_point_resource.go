@@ -274,6 +274,7 @@ func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DedicatedVantagePoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -328,6 +329,8 @@ func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Update() error {
 		request.Status = oci_apm_synthetics.DedicatedVantagePointStatusEnum(status.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
 	response, err := s.Client.UpdateDedicatedVantagePoint(context.Background(), request)
@@ -357,6 +360,8 @@ func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Delete() error {
 		log.Printf("[WARN] Delete() unable to parse current ID: %s apmDomainId: %s", s.D.Id(), apmDomainId)
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
 	_, err := s.Client.DeleteDedicatedVantagePoint(context.Background(), request)
@@ -1083,6 +1083,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Monitor
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1241,6 +1242,8 @@ func (s *ApmSyntheticsMonitorResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
 	response, err := s.Client.UpdateMonitor(context.Background(), request)
@@ -1267,6 +1270,8 @@ func (s *ApmSyntheticsMonitorResourceCrud) Delete() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
 	_, err := s.Client.DeleteMonitor(context.Background(), request)
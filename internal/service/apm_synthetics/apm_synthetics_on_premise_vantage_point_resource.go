@@ -251,6 +251,7 @@ func (s *ApmSyntheticsOnPremiseVantagePointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OnPremiseVantagePoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -287,6 +288,8 @@ func (s *ApmSyntheticsOnPremiseVantagePointResourceCrud) Update() error {
 		log.Printf("[WARN] Update() unable to parse current ID: %s", s.D.Id())
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
 	response, err := s.Client.UpdateOnPremiseVantagePoint(context.Background(), request)
@@ -314,6 +317,8 @@ func (s *ApmSyntheticsOnPremiseVantagePointResourceCrud) Delete() error {
 		log.Printf("[WARN] Delete() unable to parse current ID: %s", s.D.Id())
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
 	_, err := s.Client.DeleteOnPremiseVantagePoint(context.Background(), request)
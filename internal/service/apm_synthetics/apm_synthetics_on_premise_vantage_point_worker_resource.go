@@ -367,6 +367,7 @@ func (s *ApmSyntheticsOnPremiseVantagePointWorkerResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Worker
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -434,6 +435,8 @@ func (s *ApmSyntheticsOnPremiseVantagePointWorkerResourceCrud) Update() error {
 		log.Printf("[WARN] Update() unable to parse current ID: %s", s.D.Id())
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
 	response, err := s.Client.UpdateWorker(context.Background(), request)
@@ -472,6 +475,8 @@ func (s *ApmSyntheticsOnPremiseVantagePointWorkerResourceCrud) Delete() error {
 		log.Printf("[WARN] Delete() unable to parse current ID: %s", s.D.Id())
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
 	_, err := s.Client.DeleteWorker(context.Background(), request)
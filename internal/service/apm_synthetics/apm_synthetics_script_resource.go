@@ -305,6 +305,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Script
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -374,6 +375,8 @@ func (s *ApmSyntheticsScriptResourceCrud) Update() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
 	response, err := s.Client.UpdateScript(context.Background(), request)
@@ -405,6 +408,8 @@ func (s *ApmSyntheticsScriptResourceCrud) Delete() error {
 			log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 		}
 	}
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
 	_, err := s.Client.DeleteScript(context.Background(), request)
@@ -129,6 +129,7 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ContainerConfiguration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -145,6 +146,8 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Update() error {
 		request.IsRepositoryCreatedOnFirstPush = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
 	response, err := s.Client.UpdateContainerConfiguration(context.Background(), request)
@@ -243,6 +243,7 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ContainerImageSignature
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -264,6 +265,8 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ImageSignatureId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
 	response, err := s.Client.UpdateContainerImageSignature(context.Background(), request)
@@ -281,6 +284,8 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ImageSignatureId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
 	_, err := s.Client.DeleteContainerImageSignature(context.Background(), request)
@@ -270,6 +270,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ContainerRepository
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -321,6 +322,8 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RepositoryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
 	response, err := s.Client.UpdateContainerRepository(context.Background(), request)
@@ -338,6 +341,8 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RepositoryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
 	_, err := s.Client.DeleteContainerRepository(context.Background(), request)
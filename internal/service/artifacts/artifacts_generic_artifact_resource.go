@@ -205,6 +205,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Get() error {
 	}
 
 	s.Res = &response.GenericArtifact
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -228,6 +229,8 @@ func (s *ArtifactsGenericArtifactResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
 	response, err := s.Client.UpdateGenericArtifact(context.Background(), request)
@@ -247,6 +250,8 @@ func (s *ArtifactsGenericArtifactResourceCrud) Delete() error {
 		request.ArtifactId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
 	_, err := s.Client.DeleteGenericArtifact(context.Background(), request)
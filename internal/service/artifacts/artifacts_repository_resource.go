@@ -180,6 +180,7 @@ func (s *ArtifactsRepositoryResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Repository
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -199,6 +200,8 @@ func (s *ArtifactsRepositoryResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
 	response, err := s.Client.UpdateRepository(context.Background(), request)
@@ -216,6 +219,8 @@ func (s *ArtifactsRepositoryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RepositoryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
 	_, err := s.Client.DeleteRepository(context.Background(), request)
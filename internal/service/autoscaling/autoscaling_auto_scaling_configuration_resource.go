@@ -495,6 +495,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AutoScalingConfiguration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -549,6 +550,8 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Update() error {
 		return s.Get()
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
 	response, err := s.Client.UpdateAutoScalingConfiguration(context.Background(), request)
@@ -566,6 +569,8 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AutoScalingConfigurationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
 	_, err := s.Client.DeleteAutoScalingConfiguration(context.Background(), request)
@@ -427,6 +427,7 @@ func (s *BastionBastionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Bastion
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -488,6 +489,8 @@ func (s *BastionBastionResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
 	response, err := s.Client.UpdateBastion(context.Background(), request)
@@ -505,6 +508,8 @@ func (s *BastionBastionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BastionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
 	response, err := s.Client.DeleteBastion(context.Background(), request)
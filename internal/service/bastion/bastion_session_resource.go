@@ -436,6 +436,7 @@ func (s *BastionSessionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Session
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -450,6 +451,8 @@ func (s *BastionSessionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SessionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
 	response, err := s.Client.UpdateSession(context.Background(), request)
@@ -467,6 +470,8 @@ func (s *BastionSessionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SessionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
 	response, err := s.Client.DeleteSession(context.Background(), request)
@@ -924,6 +924,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AutoScalingConfiguration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -975,6 +976,8 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
 	response, err := s.Client.UpdateAutoScalingConfiguration(context.Background(), request)
@@ -376,6 +376,7 @@ func (s *BdsBdsInstanceApiKeyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BdsApiKey
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -390,6 +391,8 @@ func (s *BdsBdsInstanceApiKeyResourceCrud) Delete() error {
 		request.BdsInstanceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
 	response, err := s.Client.DeleteBdsApiKey(context.Background(), request)
@@ -385,6 +385,7 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BdsMetastoreConfiguration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -427,6 +428,8 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Update() error {
 		request.DisplayName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
 	response, err := s.Client.UpdateBdsMetastoreConfiguration(context.Background(), request)
@@ -449,6 +452,8 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Delete() error {
 		request.BdsInstanceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
 	response, err := s.Client.DeleteBdsMetastoreConfiguration(context.Background(), request)
@@ -1398,6 +1398,7 @@ func (s *BdsBdsInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BdsInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1815,6 +1816,8 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 		request.KmsKeyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
 	response, err := s.Client.UpdateBdsInstance(context.Background(), request)
@@ -1943,6 +1946,8 @@ func (s *BdsBdsInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BdsInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
 	response, err := s.Client.DeleteBdsInstance(context.Background(), request)
@@ -6,11 +6,11 @@ package bds
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_bds_auto_scaling_configuration", BdsAutoScalingConfigurationResource())
-	tfresource.RegisterResource("oci_bds_bds_instance", BdsBdsInstanceResource())
-	tfresource.RegisterResource("oci_bds_bds_instance_api_key", BdsBdsInstanceApiKeyResource())
-	tfresource.RegisterResource("oci_bds_bds_instance_metastore_config", BdsBdsInstanceMetastoreConfigResource())
-	tfresource.RegisterResource("oci_bds_bds_instance_operation_certificate_managements_management", BdsBdsInstanceOperationCertificateManagementsManagementResource())
-	tfresource.RegisterResource("oci_bds_bds_instance_patch_action", BdsBdsInstancePatchActionResource())
-	tfresource.RegisterResource("oci_bds_bds_instance_os_patch_action", BdsBdsInstanceOSPatchActionResource())
+	tfresource.RegisterWorkRequestResource("oci_bds_auto_scaling_configuration", BdsAutoScalingConfigurationResource())
+	tfresource.RegisterWorkRequestResource("oci_bds_bds_instance", BdsBdsInstanceResource())
+	tfresource.RegisterWorkRequestResource("oci_bds_bds_instance_api_key", BdsBdsInstanceApiKeyResource())
+	tfresource.RegisterWorkRequestResource("oci_bds_bds_instance_metastore_config", BdsBdsInstanceMetastoreConfigResource())
+	tfresource.RegisterWorkRequestResource("oci_bds_bds_instance_operation_certificate_managements_management", BdsBdsInstanceOperationCertificateManagementsManagementResource())
+	tfresource.RegisterWorkRequestResource("oci_bds_bds_instance_patch_action", BdsBdsInstancePatchActionResource())
+	tfresource.RegisterWorkRequestResource("oci_bds_bds_instance_os_patch_action", BdsBdsInstanceOSPatchActionResource())
 }
@@ -644,6 +644,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BlockchainPlatform
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -731,6 +732,8 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
 	response, err := s.Client.UpdateBlockchainPlatform(context.Background(), request)
@@ -748,6 +751,8 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BlockchainPlatformId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
 	response, err := s.Client.DeleteBlockchainPlatform(context.Background(), request)
@@ -383,6 +383,7 @@ func (s *BlockchainPeerResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Peer
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -408,6 +409,8 @@ func (s *BlockchainPeerResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.PeerId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
 	response, err := s.Client.UpdatePeer(context.Background(), request)
@@ -437,6 +440,8 @@ func (s *BlockchainPeerResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PeerId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
 	response, err := s.Client.DeletePeer(context.Background(), request)
@@ -6,7 +6,7 @@ package blockchain
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_blockchain_blockchain_platform", BlockchainBlockchainPlatformResource())
-	tfresource.RegisterResource("oci_blockchain_osn", BlockchainOsnResource())
-	tfresource.RegisterResource("oci_blockchain_peer", BlockchainPeerResource())
+	tfresource.RegisterWorkRequestResource("oci_blockchain_blockchain_platform", BlockchainBlockchainPlatformResource())
+	tfresource.RegisterWorkRequestResource("oci_blockchain_osn", BlockchainOsnResource())
+	tfresource.RegisterWorkRequestResource("oci_blockchain_peer", BlockchainPeerResource())
 }
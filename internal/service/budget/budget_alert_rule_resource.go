@@ -258,6 +258,7 @@ func (s *BudgetAlertRuleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AlertRule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -317,6 +318,8 @@ func (s *BudgetAlertRuleResourceCrud) Update() error {
 		request.Type = oci_budget.AlertTypeEnum(type_.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
 	response, err := s.Client.UpdateAlertRule(context.Background(), request)
@@ -339,6 +342,8 @@ func (s *BudgetAlertRuleResourceCrud) Delete() error {
 		request.BudgetId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
 	_, err := s.Client.DeleteAlertRule(context.Background(), request)
@@ -323,6 +323,7 @@ func (s *BudgetBudgetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Budget
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -388,6 +389,8 @@ func (s *BudgetBudgetResourceCrud) Update() error {
 		request.StartDate = &oci_common.SDKTime{Time: tmp}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
 	response, err := s.Client.UpdateBudget(context.Background(), request)
@@ -405,6 +408,8 @@ func (s *BudgetBudgetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BudgetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
 	_, err := s.Client.DeleteBudget(context.Background(), request)
@@ -322,6 +322,7 @@ func (s *CapacityManagementOccAvailabilityCatalogResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OccAvailabilityCatalog
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -353,6 +354,8 @@ func (s *CapacityManagementOccAvailabilityCatalogResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.OccAvailabilityCatalogId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "capacity_management")
 
 	response, err := s.Client.UpdateOccAvailabilityCatalog(context.Background(), request)
@@ -370,6 +373,8 @@ func (s *CapacityManagementOccAvailabilityCatalogResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OccAvailabilityCatalogId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "capacity_management")
 
 	_, err := s.Client.DeleteOccAvailabilityCatalog(context.Background(), request)
@@ -472,6 +472,7 @@ func (s *CapacityManagementOccCapacityRequestResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OccCapacityRequest
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -502,6 +503,8 @@ func (s *CapacityManagementOccCapacityRequestResourceCrud) Update() error {
 		request.RequestState = oci_capacity_management.UpdateOccCapacityRequestDetailsRequestStateEnum(requestState.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "capacity_management")
 
 	response, err := s.Client.UpdateOccCapacityRequest(context.Background(), request)
@@ -519,6 +522,8 @@ func (s *CapacityManagementOccCapacityRequestResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OccCapacityRequestId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "capacity_management")
 
 	_, err := s.Client.DeleteOccCapacityRequest(context.Background(), request)
@@ -217,6 +217,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CaBundle
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -231,6 +232,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Update() error {
 		}
 	}
 	request := oci_certificates_management.UpdateCaBundleRequest{}
+	request.IfMatch = tfresource.IfMatchETag(s.D)
 
 	tmp := s.D.Id()
 	request.CaBundleId = &tmp
@@ -277,6 +279,8 @@ func (s *CertificatesManagementCaBundleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CaBundleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
 	_, err := s.Client.DeleteCaBundle(context.Background(), request)
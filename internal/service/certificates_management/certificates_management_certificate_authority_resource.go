@@ -723,6 +723,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CertificateAuthority
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -779,6 +780,8 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Update() error
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
 	// only update if request has updates
@@ -766,6 +766,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Certificate
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -818,6 +819,8 @@ func (s *CertificatesManagementCertificateResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
 	// only update if request has updates
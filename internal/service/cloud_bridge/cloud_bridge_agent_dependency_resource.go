@@ -418,6 +418,7 @@ func (s *CloudBridgeAgentDependencyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AgentDependency
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -491,6 +492,8 @@ func (s *CloudBridgeAgentDependencyResourceCrud) Update() error {
 		request.SystemTags = convertedSystemTags
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	response, err := s.Client.UpdateAgentDependency(context.Background(), request)
@@ -508,6 +511,8 @@ func (s *CloudBridgeAgentDependencyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AgentDependencyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	_, err := s.Client.DeleteAgentDependency(context.Background(), request)
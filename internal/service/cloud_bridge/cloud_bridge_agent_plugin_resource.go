@@ -210,6 +210,7 @@ func (s *CloudBridgeAgentPluginResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Plugin
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -230,6 +231,8 @@ func (s *CloudBridgeAgentPluginResourceCrud) Update() error {
 		request.PluginName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	response, err := s.Client.UpdatePlugin(context.Background(), request)
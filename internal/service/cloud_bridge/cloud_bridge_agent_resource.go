@@ -436,6 +436,7 @@ func (s *CloudBridgeAgentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Agent
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -471,6 +472,8 @@ func (s *CloudBridgeAgentResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	response, err := s.Client.UpdateAgent(context.Background(), request)
@@ -488,6 +491,8 @@ func (s *CloudBridgeAgentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AgentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	_, err := s.Client.DeleteAgent(context.Background(), request)
@@ -724,6 +724,7 @@ func (s *CloudBridgeAssetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Asset
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -743,6 +744,8 @@ func (s *CloudBridgeAssetResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	_, err = s.Client.UpdateAsset(context.Background(), request)
@@ -779,6 +782,8 @@ func (s *CloudBridgeAssetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AssetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	_, err := s.Client.DeleteAsset(context.Background(), request)
@@ -412,6 +412,7 @@ func (s *CloudBridgeAssetSourceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AssetSource
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -431,6 +432,8 @@ func (s *CloudBridgeAssetSourceResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	response, err := s.Client.UpdateAssetSource(context.Background(), request)
@@ -448,6 +451,8 @@ func (s *CloudBridgeAssetSourceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AssetSourceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	response, err := s.Client.DeleteAssetSource(context.Background(), request)
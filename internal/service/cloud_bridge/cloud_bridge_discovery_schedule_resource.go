@@ -200,6 +200,7 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DiscoverySchedule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -240,6 +241,8 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	response, err := s.Client.UpdateDiscoverySchedule(context.Background(), request)
@@ -257,6 +260,8 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DiscoveryScheduleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	_, err := s.Client.DeleteDiscoverySchedule(context.Background(), request)
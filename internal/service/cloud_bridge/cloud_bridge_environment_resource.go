@@ -333,6 +333,7 @@ func (s *CloudBridgeEnvironmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Environment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -368,6 +369,8 @@ func (s *CloudBridgeEnvironmentResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	response, err := s.Client.UpdateEnvironment(context.Background(), request)
@@ -385,6 +388,8 @@ func (s *CloudBridgeEnvironmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.EnvironmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	_, err := s.Client.DeleteEnvironment(context.Background(), request)
@@ -350,6 +350,7 @@ func (s *CloudBridgeInventoryResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Inventory
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -376,6 +377,8 @@ func (s *CloudBridgeInventoryResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.InventoryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	response, err := s.Client.UpdateInventory(context.Background(), request)
@@ -393,6 +396,8 @@ func (s *CloudBridgeInventoryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InventoryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
 	response, err := s.Client.DeleteInventory(context.Background(), request)
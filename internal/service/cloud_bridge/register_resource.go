@@ -7,11 +7,11 @@ import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
 	tfresource.RegisterResource("oci_cloud_bridge_agent", CloudBridgeAgentResource())
-	tfresource.RegisterResource("oci_cloud_bridge_agent_dependency", CloudBridgeAgentDependencyResource())
+	tfresource.RegisterWorkRequestResource("oci_cloud_bridge_agent_dependency", CloudBridgeAgentDependencyResource())
 	tfresource.RegisterResource("oci_cloud_bridge_agent_plugin", CloudBridgeAgentPluginResource())
 	tfresource.RegisterResource("oci_cloud_bridge_asset", CloudBridgeAssetResource())
-	tfresource.RegisterResource("oci_cloud_bridge_asset_source", CloudBridgeAssetSourceResource())
+	tfresource.RegisterWorkRequestResource("oci_cloud_bridge_asset_source", CloudBridgeAssetSourceResource())
 	tfresource.RegisterResource("oci_cloud_bridge_discovery_schedule", CloudBridgeDiscoveryScheduleResource())
 	tfresource.RegisterResource("oci_cloud_bridge_environment", CloudBridgeEnvironmentResource())
-	tfresource.RegisterResource("oci_cloud_bridge_inventory", CloudBridgeInventoryResource())
+	tfresource.RegisterWorkRequestResource("oci_cloud_bridge_inventory", CloudBridgeInventoryResource())
 }
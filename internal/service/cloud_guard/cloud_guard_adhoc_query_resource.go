@@ -292,6 +292,7 @@ func (s *CloudGuardAdhocQueryResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AdhocQuery
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -301,6 +302,8 @@ func (s *CloudGuardAdhocQueryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AdhocQueryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	_, err := s.Client.DeleteAdhocQuery(context.Background(), request)
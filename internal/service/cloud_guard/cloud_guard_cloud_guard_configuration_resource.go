@@ -147,6 +147,7 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Configuration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -172,6 +173,8 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Update() error {
 		request.Status = oci_cloud_guard.CloudGuardStatusEnum(status.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.UpdateConfiguration(context.Background(), request)
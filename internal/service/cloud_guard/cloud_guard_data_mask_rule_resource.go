@@ -297,6 +297,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DataMaskRule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -361,6 +362,8 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.UpdateDataMaskRule(context.Background(), request)
@@ -378,6 +381,8 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DataMaskRuleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	_, err := s.Client.DeleteDataMaskRule(context.Background(), request)
@@ -573,6 +573,7 @@ func (s *CloudGuardDataSourceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DataSource
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -623,6 +624,8 @@ func (s *CloudGuardDataSourceResourceCrud) Update() error {
 		request.Status = oci_cloud_guard.DataSourceStatusEnum(status.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.UpdateDataSource(context.Background(), request)
@@ -640,6 +643,8 @@ func (s *CloudGuardDataSourceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DataSourceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.DeleteDataSource(context.Background(), request)
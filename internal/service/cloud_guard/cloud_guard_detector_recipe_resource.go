@@ -765,6 +765,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DetectorRecipe
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -822,6 +823,8 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.UpdateDetectorRecipe(context.Background(), request)
@@ -839,6 +842,8 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DetectorRecipeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	_, err := s.Client.DeleteDetectorRecipe(context.Background(), request)
@@ -254,6 +254,7 @@ func (s *CloudGuardManagedListResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ManagedList
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -307,6 +308,8 @@ func (s *CloudGuardManagedListResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ManagedListId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.UpdateManagedList(context.Background(), request)
@@ -324,6 +327,8 @@ func (s *CloudGuardManagedListResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagedListId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	_, err := s.Client.DeleteManagedList(context.Background(), request)
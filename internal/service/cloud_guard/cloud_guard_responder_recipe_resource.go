@@ -467,6 +467,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ResponderRecipe
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -524,6 +525,8 @@ func (s *CloudGuardResponderRecipeResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.UpdateResponderRecipe(context.Background(), request)
@@ -541,6 +544,8 @@ func (s *CloudGuardResponderRecipeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ResponderRecipeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	_, err := s.Client.DeleteResponderRecipe(context.Background(), request)
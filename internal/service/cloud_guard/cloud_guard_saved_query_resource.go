@@ -209,6 +209,7 @@ func (s *CloudGuardSavedQueryResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SavedQuery
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -254,6 +255,8 @@ func (s *CloudGuardSavedQueryResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SavedQueryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.UpdateSavedQuery(context.Background(), request)
@@ -271,6 +274,8 @@ func (s *CloudGuardSavedQueryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SavedQueryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	_, err := s.Client.DeleteSavedQuery(context.Background(), request)
@@ -223,6 +223,7 @@ func (s *CloudGuardSecurityRecipeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SecurityRecipe
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -276,6 +277,8 @@ func (s *CloudGuardSecurityRecipeResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SecurityRecipeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.UpdateSecurityRecipe(context.Background(), request)
@@ -293,6 +296,8 @@ func (s *CloudGuardSecurityRecipeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SecurityRecipeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	_, err := s.Client.DeleteSecurityRecipe(context.Background(), request)
@@ -219,6 +219,7 @@ func (s *CloudGuardSecurityZoneResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SecurityZone
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -264,6 +265,8 @@ func (s *CloudGuardSecurityZoneResourceCrud) Update() error {
 		request.SecurityZoneRecipeId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.UpdateSecurityZone(context.Background(), request)
@@ -281,6 +284,8 @@ func (s *CloudGuardSecurityZoneResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SecurityZoneId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	_, err := s.Client.DeleteSecurityZone(context.Background(), request)
@@ -1121,6 +1121,7 @@ func (s *CloudGuardTargetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Target
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1185,6 +1186,8 @@ func (s *CloudGuardTargetResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.UpdateTarget(context.Background(), request)
@@ -1202,6 +1205,8 @@ func (s *CloudGuardTargetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TargetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	_, err := s.Client.DeleteTarget(context.Background(), request)
@@ -195,6 +195,7 @@ func (s *CloudGuardWlpAgentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.WlpAgent
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -221,6 +222,8 @@ func (s *CloudGuardWlpAgentResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.WlpAgentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	response, err := s.Client.UpdateWlpAgent(context.Background(), request)
@@ -238,6 +241,8 @@ func (s *CloudGuardWlpAgentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.WlpAgentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
 	_, err := s.Client.DeleteWlpAgent(context.Background(), request)
@@ -9,7 +9,7 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_cloud_guard_adhoc_query", CloudGuardAdhocQueryResource())
 	tfresource.RegisterResource("oci_cloud_guard_cloud_guard_configuration", CloudGuardCloudGuardConfigurationResource())
 	tfresource.RegisterResource("oci_cloud_guard_data_mask_rule", CloudGuardDataMaskRuleResource())
-	tfresource.RegisterResource("oci_cloud_guard_data_source", CloudGuardDataSourceResource())
+	tfresource.RegisterWorkRequestResource("oci_cloud_guard_data_source", CloudGuardDataSourceResource())
 	tfresource.RegisterResource("oci_cloud_guard_detector_recipe", CloudGuardDetectorRecipeResource())
 	tfresource.RegisterResource("oci_cloud_guard_managed_list", CloudGuardManagedListResource())
 	tfresource.RegisterResource("oci_cloud_guard_responder_recipe", CloudGuardResponderRecipeResource())
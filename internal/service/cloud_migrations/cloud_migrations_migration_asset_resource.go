@@ -416,6 +416,7 @@ func (s *CloudMigrationsMigrationAssetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MigrationAsset
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -448,6 +449,8 @@ func (s *CloudMigrationsMigrationAssetResourceCrud) Update() error {
 		request.ReplicationScheduleId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
 	response, err := s.Client.UpdateMigrationAsset(context.Background(), request)
@@ -465,6 +468,8 @@ func (s *CloudMigrationsMigrationAssetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MigrationAssetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
 	response, err := s.Client.DeleteMigrationAsset(context.Background(), request)
@@ -686,6 +686,7 @@ func (s *CloudMigrationsMigrationPlanResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MigrationPlan
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -755,6 +756,8 @@ func (s *CloudMigrationsMigrationPlanResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
 	response, err := s.Client.UpdateMigrationPlan(context.Background(), request)
@@ -772,6 +775,8 @@ func (s *CloudMigrationsMigrationPlanResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MigrationPlanId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
 	response, err := s.Client.DeleteMigrationPlan(context.Background(), request)
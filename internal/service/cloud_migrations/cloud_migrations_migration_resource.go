@@ -348,6 +348,7 @@ func (s *CloudMigrationsMigrationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Migration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -393,6 +394,8 @@ func (s *CloudMigrationsMigrationResourceCrud) Update() error {
 		request.ReplicationScheduleId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
 	response, err := s.Client.UpdateMigration(context.Background(), request)
@@ -410,6 +413,8 @@ func (s *CloudMigrationsMigrationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MigrationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
 	response, err := s.Client.DeleteMigration(context.Background(), request)
@@ -342,6 +342,7 @@ func (s *CloudMigrationsReplicationScheduleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ReplicationSchedule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -382,6 +383,8 @@ func (s *CloudMigrationsReplicationScheduleResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ReplicationScheduleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
 	response, err := s.Client.UpdateReplicationSchedule(context.Background(), request)
@@ -399,6 +402,8 @@ func (s *CloudMigrationsReplicationScheduleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ReplicationScheduleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
 	response, err := s.Client.DeleteReplicationSchedule(context.Background(), request)
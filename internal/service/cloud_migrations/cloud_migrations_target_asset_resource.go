@@ -1559,6 +1559,7 @@ func (s *CloudMigrationsTargetAssetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.TargetAsset
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1569,6 +1570,8 @@ func (s *CloudMigrationsTargetAssetResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
 	response, err := s.Client.UpdateTargetAsset(context.Background(), request)
@@ -1586,6 +1589,8 @@ func (s *CloudMigrationsTargetAssetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TargetAssetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
 	response, err := s.Client.DeleteTargetAsset(context.Background(), request)
@@ -7,8 +7,8 @@ import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
 	tfresource.RegisterResource("oci_cloud_migrations_migration", CloudMigrationsMigrationResource())
-	tfresource.RegisterResource("oci_cloud_migrations_migration_asset", CloudMigrationsMigrationAssetResource())
-	tfresource.RegisterResource("oci_cloud_migrations_migration_plan", CloudMigrationsMigrationPlanResource())
-	tfresource.RegisterResource("oci_cloud_migrations_replication_schedule", CloudMigrationsReplicationScheduleResource())
-	tfresource.RegisterResource("oci_cloud_migrations_target_asset", CloudMigrationsTargetAssetResource())
+	tfresource.RegisterWorkRequestResource("oci_cloud_migrations_migration_asset", CloudMigrationsMigrationAssetResource())
+	tfresource.RegisterWorkRequestResource("oci_cloud_migrations_migration_plan", CloudMigrationsMigrationPlanResource())
+	tfresource.RegisterWorkRequestResource("oci_cloud_migrations_replication_schedule", CloudMigrationsReplicationScheduleResource())
+	tfresource.RegisterWorkRequestResource("oci_cloud_migrations_target_asset", CloudMigrationsTargetAssetResource())
 }
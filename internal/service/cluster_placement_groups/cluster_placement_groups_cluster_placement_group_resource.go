@@ -517,6 +517,7 @@ func (s *ClusterPlacementGroupsClusterPlacementGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ClusterPlacementGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -552,6 +553,8 @@ func (s *ClusterPlacementGroupsClusterPlacementGroupResourceCrud) Update() error
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cluster_placement_groups")
 
 	response, err := s.Client.UpdateClusterPlacementGroup(context.Background(), request)
@@ -569,6 +572,8 @@ func (s *ClusterPlacementGroupsClusterPlacementGroupResourceCrud) Delete() error
 	tmp := s.D.Id()
 	request.ClusterPlacementGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cluster_placement_groups")
 
 	response, err := s.Client.DeleteClusterPlacementGroup(context.Background(), request)
@@ -6,5 +6,5 @@ package cluster_placement_groups
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_cluster_placement_groups_cluster_placement_group", ClusterPlacementGroupsClusterPlacementGroupResource())
+	tfresource.RegisterWorkRequestResource("oci_cluster_placement_groups_cluster_placement_group", ClusterPlacementGroupsClusterPlacementGroupResource())
 }
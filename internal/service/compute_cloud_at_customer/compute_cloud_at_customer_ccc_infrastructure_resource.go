@@ -466,6 +466,7 @@ func (s *ComputeCloudAtCustomerCccInfrastructureResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CccInfrastructure
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -525,6 +526,8 @@ func (s *ComputeCloudAtCustomerCccInfrastructureResourceCrud) Update() error {
 		request.SubnetId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "compute_cloud_at_customer")
 
 	response, err := s.Client.UpdateCccInfrastructure(context.Background(), request)
@@ -542,6 +545,8 @@ func (s *ComputeCloudAtCustomerCccInfrastructureResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CccInfrastructureId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "compute_cloud_at_customer")
 
 	_, err := s.Client.DeleteCccInfrastructure(context.Background(), request)
@@ -263,6 +263,7 @@ func (s *ComputeCloudAtCustomerCccUpgradeScheduleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CccUpgradeSchedule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -320,6 +321,8 @@ func (s *ComputeCloudAtCustomerCccUpgradeScheduleResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "compute_cloud_at_customer")
 
 	response, err := s.Client.UpdateCccUpgradeSchedule(context.Background(), request)
@@ -337,6 +340,8 @@ func (s *ComputeCloudAtCustomerCccUpgradeScheduleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CccUpgradeScheduleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "compute_cloud_at_customer")
 
 	_, err := s.Client.DeleteCccUpgradeSchedule(context.Background(), request)
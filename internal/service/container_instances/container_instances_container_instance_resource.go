@@ -1233,6 +1233,7 @@ func (s *ContainerInstancesContainerInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ContainerInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1268,6 +1269,8 @@ func (s *ContainerInstancesContainerInstanceResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerinstance")
 
 	if vnics, ok := s.D.GetOkExists("vnics"); ok && s.D.HasChange("vnics") {
@@ -1399,6 +1402,8 @@ func (s *ContainerInstancesContainerInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ContainerInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerinstance")
 
 	response, err := s.Client.DeleteContainerInstance(context.Background(), request)
@@ -394,6 +394,7 @@ func (s *ContainerengineAddonResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Addon
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -432,6 +433,8 @@ func (s *ContainerengineAddonResourceCrud) Update() error {
 		request.Version = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	response, err := s.Client.UpdateAddon(context.Background(), request)
@@ -840,6 +840,7 @@ func (s *ContainerengineClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Cluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -913,6 +914,8 @@ func (s *ContainerengineClusterResourceCrud) Update() error {
 		request.Type = oci_containerengine.ClusterTypeEnum(type_.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	response, err := s.Client.UpdateCluster(context.Background(), request)
@@ -972,6 +975,8 @@ func (s *ContainerengineClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	response, err := s.Client.DeleteCluster(context.Background(), request)
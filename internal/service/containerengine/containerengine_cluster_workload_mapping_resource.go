@@ -205,6 +205,7 @@ func (s *ContainerengineClusterWorkloadMappingResourceCrud) Get() error {
 	}
 
 	s.Res = &response.WorkloadMapping
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -236,6 +237,8 @@ func (s *ContainerengineClusterWorkloadMappingResourceCrud) Update() error {
 		request.MappedCompartmentId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	response, err := s.Client.UpdateWorkloadMapping(context.Background(), request)
@@ -258,6 +261,8 @@ func (s *ContainerengineClusterWorkloadMappingResourceCrud) Delete() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	_, err = s.Client.DeleteWorkloadMapping(context.Background(), request)
@@ -1036,6 +1036,7 @@ func (s *ContainerengineNodePoolResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NodePool
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1182,6 +1183,8 @@ func (s *ContainerengineNodePoolResourceCrud) Update() error {
 		request.SubnetIds = tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	response, err := s.Client.UpdateNodePool(context.Background(), request)
@@ -1209,6 +1212,8 @@ func (s *ContainerengineNodePoolResourceCrud) Delete() error {
 		request.OverrideEvictionGraceDuration = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	response, err := s.Client.DeleteNodePool(context.Background(), request)
@@ -596,6 +596,7 @@ func (s *ContainerengineVirtualNodePoolResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VirtualNodePool
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -714,6 +715,8 @@ func (s *ContainerengineVirtualNodePoolResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	response, err := s.Client.UpdateVirtualNodePool(context.Background(), request)
@@ -741,6 +744,8 @@ func (s *ContainerengineVirtualNodePoolResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VirtualNodePoolId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
 
 	response, err := s.Client.DeleteVirtualNodePool(context.Background(), request)
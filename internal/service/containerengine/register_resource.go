@@ -6,11 +6,11 @@ package containerengine
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_containerengine_addon", ContainerengineAddonResource())
-	tfresource.RegisterResource("oci_containerengine_cluster", ContainerengineClusterResource())
+	tfresource.RegisterWorkRequestResource("oci_containerengine_addon", ContainerengineAddonResource())
+	tfresource.RegisterWorkRequestResource("oci_containerengine_cluster", ContainerengineClusterResource())
 	tfresource.RegisterResource("oci_containerengine_cluster_workload_mapping", ContainerengineClusterWorkloadMappingResource())
-	tfresource.RegisterResource("oci_containerengine_cluster_complete_credential_rotation_management", ContainerengineClusterCompleteCredentialRotationManagementResource())
-	tfresource.RegisterResource("oci_containerengine_cluster_start_credential_rotation_management", ContainerengineClusterStartCredentialRotationManagementResource())
-	tfresource.RegisterResource("oci_containerengine_node_pool", ContainerengineNodePoolResource())
-	tfresource.RegisterResource("oci_containerengine_virtual_node_pool", ContainerengineVirtualNodePoolResource())
+	tfresource.RegisterWorkRequestResource("oci_containerengine_cluster_complete_credential_rotation_management", ContainerengineClusterCompleteCredentialRotationManagementResource())
+	tfresource.RegisterWorkRequestResource("oci_containerengine_cluster_start_credential_rotation_management", ContainerengineClusterStartCredentialRotationManagementResource())
+	tfresource.RegisterWorkRequestResource("oci_containerengine_node_pool", ContainerengineNodePoolResource())
+	tfresource.RegisterWorkRequestResource("oci_containerengine_virtual_node_pool", ContainerengineVirtualNodePoolResource())
 }
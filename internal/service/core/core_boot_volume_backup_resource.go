@@ -394,6 +394,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BootVolumeBackup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -446,6 +447,8 @@ func (s *CoreBootVolumeBackupResourceCrud) Update() error {
 		request.KmsKeyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateBootVolumeBackup(context.Background(), request)
@@ -463,6 +466,8 @@ func (s *CoreBootVolumeBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BootVolumeBackupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteBootVolumeBackup(context.Background(), request)
@@ -449,6 +449,7 @@ func (s *CoreBootVolumeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BootVolume
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -565,6 +566,8 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 		request.VpusPerGB = &tmpInt64
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateBootVolume(context.Background(), request)
@@ -582,6 +585,8 @@ func (s *CoreBootVolumeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BootVolumeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteBootVolume(context.Background(), request)
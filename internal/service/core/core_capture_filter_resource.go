@@ -629,6 +629,7 @@ func (s *CoreCaptureFilterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CaptureFilter
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -698,6 +699,8 @@ func (s *CoreCaptureFilterResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateCaptureFilter(context.Background(), request)
@@ -715,6 +718,8 @@ func (s *CoreCaptureFilterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CaptureFilterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteCaptureFilter(context.Background(), request)
@@ -620,6 +620,7 @@ func (s *CoreClusterNetworkResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ClusterNetwork
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -672,6 +673,8 @@ func (s *CoreClusterNetworkResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateClusterNetwork(context.Background(), request)
@@ -346,6 +346,7 @@ func (s *CoreComputeCapacityReservationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ComputeCapacityReservation
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -403,6 +404,8 @@ func (s *CoreComputeCapacityReservationResourceCrud) Update() error {
 		request.IsDefaultReservation = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateComputeCapacityReservation(context.Background(), request)
@@ -427,6 +430,8 @@ func (s *CoreComputeCapacityReservationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CapacityReservationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.DeleteComputeCapacityReservation(context.Background(), request)
@@ -266,6 +266,7 @@ func (s *CoreComputeCapacityTopologyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ComputeCapacityTopology
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -312,6 +313,8 @@ func (s *CoreComputeCapacityTopologyResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateComputeCapacityTopology(context.Background(), request)
@@ -335,6 +338,8 @@ func (s *CoreComputeCapacityTopologyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ComputeCapacityTopologyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.DeleteComputeCapacityTopology(context.Background(), request)
@@ -189,6 +189,7 @@ func (s *CoreComputeClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ComputeCluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -224,6 +225,8 @@ func (s *CoreComputeClusterResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateComputeCluster(context.Background(), request)
@@ -241,6 +244,8 @@ func (s *CoreComputeClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ComputeClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteComputeCluster(context.Background(), request)
@@ -199,6 +199,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ComputeImageCapabilitySchema
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -242,6 +243,8 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Update() error {
 		request.SchemaData = schemaData
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateComputeImageCapabilitySchema(context.Background(), request)
@@ -259,6 +262,8 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ComputeImageCapabilitySchemaId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteComputeImageCapabilitySchema(context.Background(), request)
@@ -180,6 +180,7 @@ func (s *CoreConsoleHistoryResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ConsoleHistory
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -206,6 +207,8 @@ func (s *CoreConsoleHistoryResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateConsoleHistory(context.Background(), request)
@@ -223,6 +226,8 @@ func (s *CoreConsoleHistoryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleHistoryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteConsoleHistory(context.Background(), request)
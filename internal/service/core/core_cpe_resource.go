@@ -185,6 +185,7 @@ func (s *CoreCpeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Cpe
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -225,6 +226,8 @@ func (s *CoreCpeResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateCpe(context.Background(), request)
@@ -242,6 +245,8 @@ func (s *CoreCpeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CpeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteCpe(context.Background(), request)
@@ -289,6 +289,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CrossConnectGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -340,6 +341,8 @@ func (s *CoreCrossConnectGroupResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateCrossConnectGroup(context.Background(), request)
@@ -357,6 +360,8 @@ func (s *CoreCrossConnectGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CrossConnectGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteCrossConnectGroup(context.Background(), request)
@@ -370,6 +370,7 @@ func (s *CoreCrossConnectResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CrossConnect
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -430,6 +431,8 @@ func (s *CoreCrossConnectResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateCrossConnect(context.Background(), request)
@@ -447,6 +450,8 @@ func (s *CoreCrossConnectResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CrossConnectId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteCrossConnect(context.Background(), request)
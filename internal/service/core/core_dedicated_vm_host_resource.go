@@ -254,6 +254,7 @@ func (s *CoreDedicatedVmHostResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DedicatedVmHost
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -289,6 +290,8 @@ func (s *CoreDedicatedVmHostResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateDedicatedVmHost(context.Background(), request)
@@ -288,6 +288,7 @@ func (s *CoreDhcpOptionsResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DhcpOptions
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -345,6 +346,8 @@ func (s *CoreDhcpOptionsResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateDhcpOptions(context.Background(), request)
@@ -362,6 +365,8 @@ func (s *CoreDhcpOptionsResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DhcpId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteDhcpOptions(context.Background(), request)
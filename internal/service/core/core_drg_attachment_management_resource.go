@@ -308,6 +308,8 @@ func (s *CoreDrgAttachmentManagementResourceCrud) Update() error {
 		request.RouteTableId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateDrgAttachment(context.Background(), request)
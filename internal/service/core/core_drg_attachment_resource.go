@@ -331,6 +331,7 @@ func (s *CoreDrgAttachmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DrgAttachment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -385,6 +386,8 @@ func (s *CoreDrgAttachmentResourceCrud) Update() error {
 		request.RouteTableId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateDrgAttachment(context.Background(), request)
@@ -402,6 +405,8 @@ func (s *CoreDrgAttachmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrgAttachmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteDrgAttachment(context.Background(), request)
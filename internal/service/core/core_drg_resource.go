@@ -227,6 +227,7 @@ func (s *CoreDrgResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Drg
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 
 	statusRequest := oci_core.GetDrgRedundancyStatusRequest{}
 	statusRequest.DrgId = &tmp
@@ -281,6 +282,8 @@ func (s *CoreDrgResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateDrg(context.Background(), request)
@@ -298,6 +301,8 @@ func (s *CoreDrgResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrgId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteDrg(context.Background(), request)
@@ -196,6 +196,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DrgRouteDistribution
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -222,6 +223,8 @@ func (s *CoreDrgRouteDistributionResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateDrgRouteDistribution(context.Background(), request)
@@ -239,6 +242,8 @@ func (s *CoreDrgRouteDistributionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrgRouteDistributionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteDrgRouteDistribution(context.Background(), request)
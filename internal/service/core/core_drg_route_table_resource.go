@@ -229,6 +229,7 @@ func (s *CoreDrgRouteTableResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DrgRouteTable
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -267,6 +268,8 @@ func (s *CoreDrgRouteTableResourceCrud) Update() error {
 		request.IsEcmpEnabled = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateDrgRouteTable(context.Background(), request)
@@ -307,6 +310,8 @@ func (s *CoreDrgRouteTableResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrgRouteTableId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.DeleteDrgRouteTable(context.Background(), request)
@@ -392,6 +392,7 @@ func (s *CoreImageResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Image
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -427,6 +428,8 @@ func (s *CoreImageResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ImageId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateImage(context.Background(), request)
@@ -444,6 +447,8 @@ func (s *CoreImageResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ImageId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteImage(context.Background(), request)
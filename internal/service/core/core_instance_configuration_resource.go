@@ -2428,6 +2428,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.InstanceConfiguration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -2463,6 +2464,8 @@ func (s *CoreInstanceConfigurationResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.InstanceConfigurationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateInstanceConfiguration(context.Background(), request)
@@ -2480,6 +2483,8 @@ func (s *CoreInstanceConfigurationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InstanceConfigurationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteInstanceConfiguration(context.Background(), request)
@@ -220,6 +220,8 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleConnectionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateInstanceConsoleConnection(context.Background(), request)
@@ -237,6 +239,8 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.InstanceConsoleConnectionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteInstanceConsoleConnection(context.Background(), request)
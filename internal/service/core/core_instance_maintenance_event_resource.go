@@ -297,6 +297,7 @@ func (s *CoreInstanceMaintenanceEventResourceCrud) Get() error {
 	}
 
 	s.Res = &response.InstanceMaintenanceEvent
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -340,6 +341,8 @@ func (s *CoreInstanceMaintenanceEventResourceCrud) Update() error {
 		request.TimeWindowStart = &oci_common.SDKTime{Time: tmp}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateInstanceMaintenanceEvent(context.Background(), request)
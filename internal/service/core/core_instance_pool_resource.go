@@ -494,6 +494,7 @@ func (s *CoreInstancePoolResourceCrud) Get() error {
 	}
 
 	s.Res = &response.InstancePool
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -575,6 +576,8 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 		request.Size = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateInstancePool(context.Background(), request)
@@ -945,6 +945,7 @@ func CoreInstanceResource() *schema.Resource {
 				oldConfig, newConfig := d.GetChange("platform_config.0.type")
 				return isPlatformConfigBm(oldConfig) || isPlatformConfigBm(newConfig)
 			}),
+			tfresource.FlexShapeMemoryDiff("shape", "shape_config.0.ocpus", "shape_config.0.memory_in_gbs"),
 		),
 	}
 }
@@ -1363,6 +1364,7 @@ func (s *CoreInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Instance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1479,6 +1481,8 @@ func (s *CoreInstanceResourceCrud) Update() error {
 		request.Metadata = tfresource.ObjectMapToStringMap(metadata.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateInstance(context.Background(), request)
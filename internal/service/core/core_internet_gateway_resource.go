@@ -212,6 +212,7 @@ func (s *CoreInternetGatewayResourceCrud) Get() error {
 	}
 
 	s.Res = &response.InternetGateway
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -257,6 +258,8 @@ func (s *CoreInternetGatewayResourceCrud) Update() error {
 		request.RouteTableId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateInternetGateway(context.Background(), request)
@@ -274,6 +277,8 @@ func (s *CoreInternetGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IgId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteInternetGateway(context.Background(), request)
@@ -516,6 +516,7 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Get() error {
 	}
 
 	s.Res = &response.IpSecConnectionTunnel
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 
 	secretRequest := oci_core.GetIPSecConnectionTunnelSharedSecretRequest{}
 
@@ -734,6 +735,8 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Update() error {
 		request.EncryptionDomainConfig = EncryptionDomainDetails
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 	response, err := s.Client.UpdateIPSecConnectionTunnel(context.Background(), request)
 	if err != nil {
@@ -256,6 +256,7 @@ func (s *CoreIpSecConnectionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.IpSecConnection
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -312,6 +313,8 @@ func (s *CoreIpSecConnectionResourceCrud) Update() error {
 		request.StaticRoutes = tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateIPSecConnection(context.Background(), request)
@@ -329,6 +332,8 @@ func (s *CoreIpSecConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IpscId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteIPSecConnection(context.Background(), request)
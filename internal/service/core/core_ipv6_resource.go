@@ -212,6 +212,7 @@ func (s *CoreIpv6ResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Ipv6
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -243,6 +244,8 @@ func (s *CoreIpv6ResourceCrud) Update() error {
 		request.VnicId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateIpv6(context.Background(), request)
@@ -260,6 +263,8 @@ func (s *CoreIpv6ResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.Ipv6Id = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteIpv6(context.Background(), request)
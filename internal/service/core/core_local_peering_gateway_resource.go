@@ -289,6 +289,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Get() error {
 	}
 
 	s.Res = &response.LocalPeeringGateway
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -329,6 +330,8 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Update() error {
 		request.RouteTableId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateLocalPeeringGateway(context.Background(), request)
@@ -346,6 +349,8 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LocalPeeringGatewayId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteLocalPeeringGateway(context.Background(), request)
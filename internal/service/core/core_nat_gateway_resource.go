@@ -227,6 +227,7 @@ func (s *CoreNatGatewayResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NatGateway
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -272,6 +273,8 @@ func (s *CoreNatGatewayResourceCrud) Update() error {
 		request.RouteTableId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateNatGateway(context.Background(), request)
@@ -289,6 +292,8 @@ func (s *CoreNatGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NatGatewayId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteNatGateway(context.Background(), request)
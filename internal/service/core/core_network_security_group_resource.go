@@ -192,6 +192,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NetworkSecurityGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -227,6 +228,8 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.NetworkSecurityGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateNetworkSecurityGroup(context.Background(), request)
@@ -244,6 +247,8 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NetworkSecurityGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteNetworkSecurityGroup(context.Background(), request)
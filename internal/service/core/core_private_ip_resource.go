@@ -208,6 +208,7 @@ func (s *CorePrivateIpResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PrivateIp
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -244,6 +245,8 @@ func (s *CorePrivateIpResourceCrud) Update() error {
 		request.VnicId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdatePrivateIp(context.Background(), request)
@@ -261,6 +264,8 @@ func (s *CorePrivateIpResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PrivateIpId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeletePrivateIp(context.Background(), request)
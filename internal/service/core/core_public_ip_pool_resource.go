@@ -187,6 +187,7 @@ func (s *CorePublicIpPoolResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PublicIpPool
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -222,6 +223,8 @@ func (s *CorePublicIpPoolResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.PublicIpPoolId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdatePublicIpPool(context.Background(), request)
@@ -239,6 +242,8 @@ func (s *CorePublicIpPoolResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PublicIpPoolId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeletePublicIpPool(context.Background(), request)
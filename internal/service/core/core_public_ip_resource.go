@@ -250,6 +250,7 @@ func (s *CorePublicIpResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PublicIp
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -295,6 +296,8 @@ func (s *CorePublicIpResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.PublicIpId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdatePublicIp(context.Background(), request)
@@ -312,6 +315,8 @@ func (s *CorePublicIpResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PublicIpId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeletePublicIp(context.Background(), request)
@@ -285,6 +285,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.RemotePeeringConnection
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -320,6 +321,8 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RemotePeeringConnectionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateRemotePeeringConnection(context.Background(), request)
@@ -337,6 +340,8 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RemotePeeringConnectionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteRemotePeeringConnection(context.Background(), request)
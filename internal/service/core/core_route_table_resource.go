@@ -259,6 +259,7 @@ func (s *CoreRouteTableResourceCrud) Get() error {
 	}
 
 	s.Res = &response.RouteTable
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -311,6 +312,8 @@ func (s *CoreRouteTableResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RtId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateRouteTable(context.Background(), request)
@@ -328,6 +331,8 @@ func (s *CoreRouteTableResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RtId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteRouteTable(context.Background(), request)
@@ -554,6 +554,7 @@ func (s *CoreSecurityListResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SecurityList
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -623,6 +624,8 @@ func (s *CoreSecurityListResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SecurityListId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateSecurityList(context.Background(), request)
@@ -640,6 +643,8 @@ func (s *CoreSecurityListResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SecurityListId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteSecurityList(context.Background(), request)
@@ -249,6 +249,7 @@ func (s *CoreServiceGatewayResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ServiceGateway
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -312,6 +313,8 @@ func (s *CoreServiceGatewayResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateServiceGateway(context.Background(), request)
@@ -329,6 +332,8 @@ func (s *CoreServiceGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ServiceGatewayId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteServiceGateway(context.Background(), request)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -152,6 +153,11 @@ func CoreSubnetResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+		// The CIDR block of the subnet must be within the CIDR blocks of its VCN, and not overlap the other subnets
+		CustomizeDiff: customdiff.All(
+			tfresource.CidrBlockWithinDiff("cidr_block", "VCN", vcnCidrBlocks),
+			tfresource.CidrBlockDoesNotOverlapDiff("cidr_block", "other subnets of the VCN", siblingSubnetCidrBlocks),
+		),
 	}
 }
 
@@ -350,6 +356,7 @@ func (s *CoreSubnetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Subnet
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -432,6 +439,8 @@ func (s *CoreSubnetResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SubnetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateSubnet(context.Background(), request)
@@ -449,6 +458,8 @@ func (s *CoreSubnetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SubnetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, globalvar.CoreService, globalvar.SubnetService, globalvar.DeleteResource)
 
 	_, err := s.Client.DeleteSubnet(context.Background(), request)
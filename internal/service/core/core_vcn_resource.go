@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,11 +22,11 @@ func CoreVcnResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVcn,
-		Read:     readCoreVcn,
-		Update:   updateCoreVcn,
-		Delete:   deleteCoreVcn,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVcn,
+		ReadContext:   readCoreVcn,
+		UpdateContext: updateCoreVcn,
+		DeleteContext: deleteCoreVcn,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -158,40 +160,45 @@ func CoreVcnResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+		// The deprecated `cidr_block` and `cidr_blocks` can not both be set, and the CIDR blocks must not overlap
+		CustomizeDiff: customdiff.All(
+			tfresource.MutuallyExclusiveGroupsDiff([]string{"cidr_block"}, []string{"cidr_blocks"}),
+			tfresource.CidrBlocksDoNotOverlapDiff("cidr_blocks"),
+		),
 	}
 }
 
-func createCoreVcn(d *schema.ResourceData, m interface{}) error {
+func createCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.CreateResourceWithContext(ctx, d, sync)
 }
 
-func readCoreVcn(d *schema.ResourceData, m interface{}) error {
+func readCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ReadResourceWithContext(ctx, sync)
 }
 
-func updateCoreVcn(d *schema.ResourceData, m interface{}) error {
+func updateCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.UpdateResourceWithContext(ctx, d, sync)
 }
 
-func deleteCoreVcn(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.DeleteResourceWithContext(ctx, d, sync)
 }
 
 type CoreVcnResourceCrud struct {
@@ -205,6 +212,19 @@ func (s *CoreVcnResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
+// Warnings reports the deprecated `cidr_block` when it is set in the config of the VCN
+func (s *CoreVcnResourceCrud) Warnings() []tfresource.Warning {
+	config := s.D.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute("cidr_block") || config.GetAttr("cidr_block").IsNull() {
+		return nil
+	}
+	return []tfresource.Warning{{
+		Summary:    tfresource.FieldDeprecatedForAnother("cidr_block", "cidr_blocks"),
+		Attribute:  "cidr_block",
+		Suggestion: "Replace 'cidr_block' with 'cidr_blocks' set to a list of its CIDR block. Once 'cidr_blocks' is used, the VCN can not go back to 'cidr_block'.",
+	}}
+}
+
 func (s *CoreVcnResourceCrud) CreatedPending() []string {
 	return []string{
 		string(oci_core.VcnLifecycleStateProvisioning),
@@ -229,7 +249,7 @@ func (s *CoreVcnResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVcnResourceCrud) Create() error {
+func (s *CoreVcnResourceCrud) CreateWithContext(ctx context.Context) error {
 	request := oci_core.CreateVcnRequest{}
 
 	if byoipv6CidrDetails, ok := s.D.GetOkExists("byoipv6cidr_details"); ok {
@@ -319,7 +339,7 @@ func (s *CoreVcnResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVcn(context.Background(), request)
+	response, err := s.Client.CreateVcn(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -329,6 +349,10 @@ func (s *CoreVcnResourceCrud) Create() error {
 }
 
 func (s *CoreVcnResourceCrud) Get() error {
+	return s.GetWithContext(context.Background())
+}
+
+func (s *CoreVcnResourceCrud) GetWithContext(ctx context.Context) error {
 	request := oci_core.GetVcnRequest{}
 
 	tmp := s.D.Id()
@@ -336,20 +360,21 @@ func (s *CoreVcnResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVcn(context.Background(), request)
+	response, err := s.Client.GetVcn(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.Vcn
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) Update() error {
+func (s *CoreVcnResourceCrud) UpdateWithContext(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -362,14 +387,14 @@ func (s *CoreVcnResourceCrud) Update() error {
 		enableIPv6Request.VcnId = &tmp
 		enableIPv6Request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.AddIpv6VcnCidr(context.Background(), enableIPv6Request)
+		_, err := s.Client.AddIpv6VcnCidr(ctx, enableIPv6Request)
 		if err != nil {
 			return err
 		}
 	}
 
 	if byoipv6CidrDetails, ok := s.D.GetOkExists("byoipv6Cidr_details"); ok && s.D.HasChange("byoipv6Cidr_details") {
-		err := s.addByoIpv6CidrBlocks(byoipv6CidrDetails)
+		err := s.addByoIpv6CidrBlocks(ctx, byoipv6CidrDetails)
 		if err != nil {
 			return err
 		}
@@ -378,7 +403,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 	if _, ok := s.D.GetOkExists("ipv6private_cidr_blocks"); ok && s.D.HasChange("ipv6private_cidr_blocks") {
 		oldRaw, newRaw := s.D.GetChange("ipv6private_cidr_blocks")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateIpv6CidrBlocks(oldRaw, newRaw)
+			err := s.updateIpv6CidrBlocks(ctx, oldRaw, newRaw)
 			if err != nil {
 				return err
 			}
@@ -388,7 +413,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 	if _, ok := s.D.GetOkExists("byoipv6cidr_blocks"); ok && s.D.HasChange("byoipv6cidr_blocks") {
 		oldRaw, newRaw := s.D.GetChange("byoipv6cidr_blocks")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateIpv6CidrBlocks(oldRaw, newRaw)
+			err := s.updateIpv6CidrBlocks(ctx, oldRaw, newRaw)
 			if err != nil {
 				return err
 			}
@@ -404,7 +429,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 		isOracleGuaAllocationEnabled := enableOracleGuaAllocation.(bool)
 		addVcnIpv6CidrDetails.IsOracleGuaAllocationEnabled = &isOracleGuaAllocationEnabled
 		enableIPv6Request.AddVcnIpv6CidrDetails = addVcnIpv6CidrDetails
-		_, err := s.Client.AddIpv6VcnCidr(context.Background(), enableIPv6Request)
+		_, err := s.Client.AddIpv6VcnCidr(ctx, enableIPv6Request)
 		if err != nil {
 			return err
 		}
@@ -415,7 +440,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 	if _, ok := s.D.GetOkExists("cidr_blocks"); ok && s.D.HasChange("cidr_blocks") {
 		oldRaw, newRaw := s.D.GetChange("cidr_blocks")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCidrBlocks(oldRaw, newRaw)
+			err := s.updateCidrBlocks(ctx, oldRaw, newRaw)
 			if err != nil {
 				return err
 			}
@@ -442,9 +467,11 @@ func (s *CoreVcnResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VcnId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVcn(context.Background(), request)
+	response, err := s.Client.UpdateVcn(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -453,7 +480,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) addByoIpv6CidrBlocks(byoipv6CidrDetails interface{}) error {
+func (s *CoreVcnResourceCrud) addByoIpv6CidrBlocks(ctx context.Context, byoipv6CidrDetails interface{}) error {
 	request := oci_core.AddIpv6VcnCidrRequest{}
 	addVcnIpv6CidrDetails := oci_core.AddVcnIpv6CidrDetails{}
 	fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "byoipv6cidr_details", byoipv6CidrDetails)
@@ -467,14 +494,14 @@ func (s *CoreVcnResourceCrud) addByoIpv6CidrBlocks(byoipv6CidrDetails interface{
 	request.VcnId = &idTmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 	request.AddVcnIpv6CidrDetails = addVcnIpv6CidrDetails
-	_, err = s.Client.AddIpv6VcnCidr(context.Background(), request)
+	_, err = s.Client.AddIpv6VcnCidr(ctx, request)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) updateIpv6CidrBlocks(oldRaw interface{}, newRaw interface{}) error {
+func (s *CoreVcnResourceCrud) updateIpv6CidrBlocks(ctx context.Context, oldRaw interface{}, newRaw interface{}) error {
 	interfaces := oldRaw.([]interface{})
 	oldBlocks := make([]string, len(interfaces))
 	for i := range interfaces {
@@ -503,7 +530,7 @@ func (s *CoreVcnResourceCrud) updateIpv6CidrBlocks(oldRaw interface{}, newRaw in
 		addIpv6VcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		addVcnIpv6CidrDetails.Ipv6PrivateCidrBlock = &newCidr
 		addIpv6VcnCidrRequest.AddVcnIpv6CidrDetails = addVcnIpv6CidrDetails
-		_, err := s.Client.AddIpv6VcnCidr(context.Background(), addIpv6VcnCidrRequest)
+		_, err := s.Client.AddIpv6VcnCidr(ctx, addIpv6VcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -516,7 +543,7 @@ func (s *CoreVcnResourceCrud) updateIpv6CidrBlocks(oldRaw interface{}, newRaw in
 		removeIpv6VcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		removeVcnIpv6CidrDetails.Ipv6CidrBlock = &oldCidr
 		removeIpv6VcnCidrRequest.RemoveVcnIpv6CidrDetails = removeVcnIpv6CidrDetails
-		_, err := s.Client.RemoveIpv6VcnCidr(context.Background(), removeIpv6VcnCidrRequest)
+		_, err := s.Client.RemoveIpv6VcnCidr(ctx, removeIpv6VcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -524,15 +551,17 @@ func (s *CoreVcnResourceCrud) updateIpv6CidrBlocks(oldRaw interface{}, newRaw in
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) Delete() error {
+func (s *CoreVcnResourceCrud) DeleteWithContext(ctx context.Context) error {
 	request := oci_core.DeleteVcnRequest{}
 
 	tmp := s.D.Id()
 	request.VcnId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVcn(context.Background(), request)
+	_, err := s.Client.DeleteVcn(ctx, request)
 	return err
 }
 
@@ -628,7 +657,7 @@ func Byoipv6CidrDetailsToMap(obj oci_core.Byoipv6CidrDetails) map[string]interfa
 	return result
 }
 
-func (s *CoreVcnResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVcnResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVcnCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -639,19 +668,19 @@ func (s *CoreVcnResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVcnCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVcnCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedStateWithContext(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interface{}) error {
+func (s *CoreVcnResourceCrud) updateCidrBlocks(ctx context.Context, oldRaw interface{}, newRaw interface{}) error {
 	interfaces := oldRaw.([]interface{})
 	oldBlocks := make([]string, len(interfaces))
 	for i := range interfaces {
@@ -676,7 +705,7 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		addVcnCidrRequest.VcnId = &idTmp
 		addVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		addVcnCidrRequest.CidrBlock = &newCidr
-		_, err := s.Client.AddVcnCidr(context.Background(), addVcnCidrRequest)
+		_, err := s.Client.AddVcnCidr(ctx, addVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -687,7 +716,7 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		removeVcnCidrRequest.VcnId = &idTmp
 		removeVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		removeVcnCidrRequest.CidrBlock = &oldCidr
-		_, err := s.Client.RemoveVcnCidr(context.Background(), removeVcnCidrRequest)
+		_, err := s.Client.RemoveVcnCidr(ctx, removeVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -699,7 +728,7 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		modifyVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		modifyVcnCidrRequest.OriginalCidrBlock = &oldCidr
 		modifyVcnCidrRequest.NewCidrBlock = &newCidr
-		_, err := s.Client.ModifyVcnCidr(context.Background(), modifyVcnCidrRequest)
+		_, err := s.Client.ModifyVcnCidr(ctx, modifyVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -491,6 +491,7 @@ func (s *CoreVirtualCircuitResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VirtualCircuit
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 
 	ppRequest := oci_core.ListVirtualCircuitPublicPrefixesRequest{}
 	ppRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
@@ -642,6 +643,8 @@ func (s *CoreVirtualCircuitResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VirtualCircuitId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVirtualCircuit(context.Background(), request)
@@ -727,6 +730,8 @@ func (s *CoreVirtualCircuitResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VirtualCircuitId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVirtualCircuit(context.Background(), request)
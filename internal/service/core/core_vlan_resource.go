@@ -257,6 +257,7 @@ func (s *CoreVlanResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Vlan
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -316,6 +317,8 @@ func (s *CoreVlanResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VlanId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVlan(context.Background(), request)
@@ -333,6 +336,8 @@ func (s *CoreVlanResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VlanId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVlan(context.Background(), request)
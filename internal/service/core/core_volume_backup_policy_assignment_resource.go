@@ -121,6 +121,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VolumeBackupPolicyAssignment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -130,6 +131,8 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PolicyAssignmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVolumeBackupPolicyAssignment(context.Background(), request)
@@ -243,6 +243,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VolumeBackupPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -292,6 +293,8 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVolumeBackupPolicy(context.Background(), request)
@@ -309,6 +312,8 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVolumeBackupPolicy(context.Background(), request)
@@ -397,6 +397,7 @@ func (s *CoreVolumeBackupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VolumeBackup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -437,6 +438,8 @@ func (s *CoreVolumeBackupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VolumeBackupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVolumeBackup(context.Background(), request)
@@ -454,6 +457,8 @@ func (s *CoreVolumeBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeBackupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVolumeBackup(context.Background(), request)
@@ -380,6 +380,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VolumeGroupBackup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -425,6 +426,8 @@ func (s *CoreVolumeGroupBackupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VolumeGroupBackupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVolumeGroupBackup(context.Background(), request)
@@ -442,6 +445,8 @@ func (s *CoreVolumeGroupBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeGroupBackupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVolumeGroupBackup(context.Background(), request)
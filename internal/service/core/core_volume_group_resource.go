@@ -368,6 +368,7 @@ func (s *CoreVolumeGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VolumeGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -451,6 +452,8 @@ func (s *CoreVolumeGroupResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVolumeGroup(context.Background(), request)
@@ -468,6 +471,8 @@ func (s *CoreVolumeGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVolumeGroup(context.Background(), request)
@@ -476,6 +476,7 @@ func (s *CoreVolumeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Volume
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -592,6 +593,8 @@ func (s *CoreVolumeResourceCrud) Update() error {
 		request.VpusPerGB = &tmpInt64
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	response, err := s.Client.UpdateVolume(context.Background(), request)
@@ -609,6 +612,8 @@ func (s *CoreVolumeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VolumeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVolume(context.Background(), request)
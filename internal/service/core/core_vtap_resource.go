@@ -333,6 +333,7 @@ func (s *CoreVtapResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Vtap
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -433,6 +434,8 @@ func (s *CoreVtapResourceCrud) Update() error {
 		request.VxlanNetworkIdentifier = &tmpInt64
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.UpdateVtap(context.Background(), request)
@@ -449,6 +452,8 @@ func (s *CoreVtapResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VtapId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
 	_, err := s.Client.DeleteVtap(context.Background(), request)
@@ -182,3 +182,86 @@ func Abs(x int) int {
 	}
 	return x
 }
+
+// virtualNetworkClientForDiff returns the virtual network client the planned resource is managed with, or an error if it
+// could not be created, e.g. because of the configuration of the provider, for the checks of the plan to be skipped
+func virtualNetworkClientForDiff(d *schema.ResourceDiff, m interface{}) (*oci_core.VirtualNetworkClient, error) {
+	clients, err := tfresource.ClientsForResourceDiff(d, m)
+	if err != nil {
+		return nil, err
+	}
+	oracleClients, ok := clients.(*tf_client.OracleClients)
+	if !ok {
+		return nil, fmt.Errorf("unexpected clients %T", clients)
+	}
+	client, err := oracleClients.GetClient("oci_core.VirtualNetworkClient")
+	if err != nil {
+		return nil, err
+	}
+	virtualNetworkClient, ok := client.(*oci_core.VirtualNetworkClient)
+	if !ok {
+		return nil, fmt.Errorf("unexpected virtual network client %T", client)
+	}
+	return virtualNetworkClient, nil
+}
+
+// vcnCidrBlocks returns the CIDR blocks of the VCN of a planned subnet, or nil if its VCN is not created yet
+func vcnCidrBlocks(ctx context.Context, d *schema.ResourceDiff, m interface{}) ([]string, error) {
+	vcnId, ok := d.GetOk("vcn_id")
+	if !ok || !d.NewValueKnown("vcn_id") {
+		return nil, nil
+	}
+	client, err := virtualNetworkClientForDiff(d, m)
+	if err != nil {
+		return nil, err
+	}
+
+	request := oci_core.GetVcnRequest{}
+	tmp := vcnId.(string)
+	request.VcnId = &tmp
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
+	response, err := client.GetVcn(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return response.CidrBlocks, nil
+}
+
+// siblingSubnetCidrBlocks returns the CIDR blocks of the other subnets of the VCN of a planned subnet, in its compartment
+func siblingSubnetCidrBlocks(ctx context.Context, d *schema.ResourceDiff, m interface{}) ([]string, error) {
+	vcnId, ok := d.GetOk("vcn_id")
+	if !ok || !d.NewValueKnown("vcn_id") || !d.NewValueKnown("compartment_id") {
+		return nil, nil
+	}
+	client, err := virtualNetworkClientForDiff(d, m)
+	if err != nil {
+		return nil, err
+	}
+
+	request := oci_core.ListSubnetsRequest{}
+	compartmentId := d.Get("compartment_id").(string)
+	request.CompartmentId = &compartmentId
+	tmp := vcnId.(string)
+	request.VcnId = &tmp
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
+
+	var cidrBlocks []string
+	for {
+		response, err := client.ListSubnets(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, subnet := range response.Items {
+			if subnet.Id != nil && *subnet.Id == d.Id() || subnet.CidrBlock == nil ||
+				subnet.LifecycleState == oci_core.SubnetLifecycleStateTerminated || subnet.LifecycleState == oci_core.SubnetLifecycleStateTerminating {
+				continue
+			}
+			cidrBlocks = append(cidrBlocks, *subnet.CidrBlock)
+		}
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return cidrBlocks, nil
+}
@@ -13,8 +13,8 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_core_capture_filter", CoreCaptureFilterResource())
 	tfresource.RegisterResource("oci_core_cluster_network", CoreClusterNetworkResource())
 	tfresource.RegisterResource("oci_core_compute_capacity_report", CoreComputeCapacityReportResource())
-	tfresource.RegisterResource("oci_core_compute_capacity_reservation", CoreComputeCapacityReservationResource())
-	tfresource.RegisterResource("oci_core_compute_capacity_topology", CoreComputeCapacityTopologyResource())
+	tfresource.RegisterWorkRequestResource("oci_core_compute_capacity_reservation", CoreComputeCapacityReservationResource())
+	tfresource.RegisterWorkRequestResource("oci_core_compute_capacity_topology", CoreComputeCapacityTopologyResource())
 	tfresource.RegisterResource("oci_core_compute_cluster", CoreComputeClusterResource())
 	tfresource.RegisterResource("oci_core_compute_image_capability_schema", CoreComputeImageCapabilitySchemaResource())
 	tfresource.RegisterResource("oci_core_console_history", CoreConsoleHistoryResource())
@@ -24,7 +24,7 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_core_default_route_table", DefaultCoreRouteTableResource())
 	tfresource.RegisterResource("oci_core_default_security_list", CoreDefaultSecurityListResource())
 	tfresource.RegisterResource("oci_core_cross_connect_group", CoreCrossConnectGroupResource())
-	tfresource.RegisterResource("oci_core_dedicated_vm_host", CoreDedicatedVmHostResource())
+	tfresource.RegisterWorkRequestResource("oci_core_dedicated_vm_host", CoreDedicatedVmHostResource())
 	tfresource.RegisterResource("oci_core_dhcp_options", CoreDhcpOptionsResource())
 	tfresource.RegisterResource("oci_core_drg", CoreDrgResource())
 	tfresource.RegisterResource("oci_core_drg_attachment", CoreDrgAttachmentResource())
@@ -34,13 +34,13 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_core_drg_route_distribution_statement", CoreDrgRouteDistributionStatementResource())
 	tfresource.RegisterResource("oci_core_drg_route_table", CoreDrgRouteTableResource())
 	tfresource.RegisterResource("oci_core_drg_route_table_route_rule", CoreDrgRouteTableRouteRuleResource())
-	tfresource.RegisterResource("oci_core_image", CoreImageResource())
-	tfresource.RegisterResource("oci_core_instance", CoreInstanceResource())
+	tfresource.RegisterWorkRequestResource("oci_core_image", CoreImageResource())
+	tfresource.RegisterWorkRequestResource("oci_core_instance", CoreInstanceResource())
 	tfresource.RegisterResource("oci_core_instance_configuration", CoreInstanceConfigurationResource())
 	tfresource.RegisterResource("oci_core_instance_console_connection", CoreInstanceConsoleConnectionResource())
-	tfresource.RegisterResource("oci_core_instance_maintenance_event", CoreInstanceMaintenanceEventResource())
+	tfresource.RegisterWorkRequestResource("oci_core_instance_maintenance_event", CoreInstanceMaintenanceEventResource())
 	tfresource.RegisterResource("oci_core_instance_pool", CoreInstancePoolResource())
-	tfresource.RegisterResource("oci_core_instance_pool_instance", CoreInstancePoolInstanceResource())
+	tfresource.RegisterWorkRequestResource("oci_core_instance_pool_instance", CoreInstancePoolInstanceResource())
 	tfresource.RegisterResource("oci_core_internet_gateway", CoreInternetGatewayResource())
 	tfresource.RegisterResource("oci_core_ipsec", CoreIpSecConnectionResource())
 	tfresource.RegisterResource("oci_core_ipsec_connection_tunnel_management", CoreIpSecConnectionTunnelManagementResource())
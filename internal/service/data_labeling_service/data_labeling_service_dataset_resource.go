@@ -668,6 +668,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Dataset
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -738,6 +739,8 @@ func (s *DataLabelingServiceDatasetResourceCrud) Update() error {
 		request.LabelingInstructions = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
 	response, err := s.Client.UpdateDataset(context.Background(), request)
@@ -755,6 +758,8 @@ func (s *DataLabelingServiceDatasetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DatasetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
 	response, err := s.Client.DeleteDataset(context.Background(), request)
@@ -413,6 +413,7 @@ func (s *DataSafeAlertPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AlertPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -457,6 +458,8 @@ func (s *DataSafeAlertPolicyResourceCrud) Update() error {
 		request.Severity = oci_data_safe.AlertSeverityEnum(severity.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateAlertPolicy(context.Background(), request)
@@ -474,6 +477,8 @@ func (s *DataSafeAlertPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AlertPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteAlertPolicy(context.Background(), request)
@@ -330,6 +330,7 @@ func (s *DataSafeAlertPolicyRuleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AlertPolicyRule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -361,6 +362,8 @@ func (s *DataSafeAlertPolicyRuleResourceCrud) Update() error {
 		request.RuleKey = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateAlertPolicyRule(context.Background(), request)
@@ -385,6 +388,8 @@ func (s *DataSafeAlertPolicyRuleResourceCrud) Delete() error {
 		request.RuleKey = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteAlertPolicyRule(context.Background(), request)
@@ -282,6 +282,7 @@ func (s *DataSafeAlertResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Alert
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -336,6 +337,8 @@ func (s *DataSafeAlertResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateAlert(context.Background(), request)
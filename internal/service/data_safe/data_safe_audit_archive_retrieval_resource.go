@@ -382,6 +382,7 @@ func (s *DataSafeAuditArchiveRetrievalResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AuditArchiveRetrieval
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -422,6 +423,8 @@ func (s *DataSafeAuditArchiveRetrievalResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateAuditArchiveRetrieval(context.Background(), request)
@@ -439,6 +442,8 @@ func (s *DataSafeAuditArchiveRetrievalResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AuditArchiveRetrievalId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteAuditArchiveRetrieval(context.Background(), request)
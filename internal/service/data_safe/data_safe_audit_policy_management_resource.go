@@ -368,6 +368,7 @@ func (s *DataSafeAuditPolicyManagementResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AuditPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -408,6 +409,8 @@ func (s *DataSafeAuditPolicyManagementResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateAuditPolicy(context.Background(), request)
@@ -557,6 +557,7 @@ func (s *DataSafeAuditPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AuditPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -597,6 +598,8 @@ func (s *DataSafeAuditPolicyResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateAuditPolicy(context.Background(), request)
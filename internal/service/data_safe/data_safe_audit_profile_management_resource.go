@@ -278,6 +278,7 @@ func (s *DataSafeAuditProfileManagementResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AuditProfile
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -323,6 +324,8 @@ func (s *DataSafeAuditProfileManagementResourceCrud) Update() error {
 		request.IsPaidUsageEnabled = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateAuditProfile(context.Background(), request)
@@ -542,6 +542,7 @@ func (s *DataSafeAuditProfileResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AuditProfile
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -587,6 +588,8 @@ func (s *DataSafeAuditProfileResourceCrud) Update() error {
 		request.IsPaidUsageEnabled = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateAuditProfile(context.Background(), request)
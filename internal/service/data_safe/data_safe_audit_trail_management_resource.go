@@ -371,6 +371,7 @@ func (s *DataSafeAuditTrailManagementResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AuditTrail
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -407,6 +408,8 @@ func (s *DataSafeAuditTrailManagementResourceCrud) Update() error {
 		request.IsAutoPurgeEnabled = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateAuditTrail(context.Background(), request)
@@ -424,6 +427,8 @@ func (s *DataSafeAuditTrailManagementResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AuditTrailId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteAuditTrail(context.Background(), request)
@@ -495,6 +495,7 @@ func (s *DataSafeAuditTrailResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AuditTrail
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -531,6 +532,8 @@ func (s *DataSafeAuditTrailResourceCrud) Update() error {
 		request.IsAutoPurgeEnabled = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateAuditTrail(context.Background(), request)
@@ -548,6 +551,8 @@ func (s *DataSafeAuditTrailResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AuditTrailId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteAuditTrail(context.Background(), request)
@@ -383,6 +383,7 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DataSafePrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -437,6 +438,8 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateDataSafePrivateEndpoint(context.Background(), request)
@@ -454,6 +457,8 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DataSafePrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteDataSafePrivateEndpoint(context.Background(), request)
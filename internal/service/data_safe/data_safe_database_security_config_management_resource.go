@@ -292,6 +292,7 @@ func (s *DataSafeDatabaseSecurityConfigManagementResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DatabaseSecurityConfig
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -343,6 +344,8 @@ func (s *DataSafeDatabaseSecurityConfigManagementResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateDatabaseSecurityConfig(context.Background(), request)
@@ -464,6 +464,7 @@ func (s *DataSafeDatabaseSecurityConfigResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DatabaseSecurityConfig
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -515,6 +516,8 @@ func (s *DataSafeDatabaseSecurityConfigResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateDatabaseSecurityConfig(context.Background(), request)
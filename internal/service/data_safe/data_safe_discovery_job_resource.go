@@ -515,6 +515,7 @@ func (s *DataSafeDiscoveryJobResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DiscoveryJob
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -538,6 +539,8 @@ func (s *DataSafeDiscoveryJobResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DiscoveryJobId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteDiscoveryJob(context.Background(), request)
@@ -246,6 +246,7 @@ func (s *DataSafeDiscoveryJobsResultResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DiscoveryJobResult
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -262,6 +263,8 @@ func (s *DataSafeDiscoveryJobsResultResourceCrud) Delete() error {
 		request.ResultKey = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	_, err := s.Client.DeleteDiscoveryJobResult(context.Background(), request)
@@ -546,6 +546,7 @@ func (s *DataSafeLibraryMaskingFormatResourceCrud) Get() error {
 	}
 
 	s.Res = &response.LibraryMaskingFormat
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -616,6 +617,8 @@ func (s *DataSafeLibraryMaskingFormatResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateLibraryMaskingFormat(context.Background(), request)
@@ -633,6 +636,8 @@ func (s *DataSafeLibraryMaskingFormatResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LibraryMaskingFormatId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	_, err := s.Client.DeleteLibraryMaskingFormat(context.Background(), request)
@@ -630,6 +630,7 @@ func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MaskingColumn
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -682,6 +683,8 @@ func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Update() error {
 		request.SensitiveTypeId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateMaskingColumn(context.Background(), request)
@@ -706,6 +709,8 @@ func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Delete() error {
 		request.MaskingPolicyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	_, err := s.Client.DeleteMaskingColumn(context.Background(), request)
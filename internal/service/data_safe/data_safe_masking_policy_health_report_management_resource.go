@@ -139,6 +139,8 @@ func (s *DataSafeMaskingPolicyHealthReportManagementResourceCrud) Delete() error
 	tmp := s.D.Id()
 	request.MaskingPolicyHealthReportId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	_, err := s.Client.DeleteMaskingPolicyHealthReport(context.Background(), request)
@@ -355,6 +357,7 @@ func (s *DataSafeMaskingPolicyHealthReportManagementResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MaskingPolicyHealthReport
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
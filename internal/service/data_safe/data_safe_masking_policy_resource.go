@@ -510,6 +510,7 @@ func (s *DataSafeMaskingPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MaskingPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -595,6 +596,8 @@ func (s *DataSafeMaskingPolicyResourceCrud) Update() error {
 		request.Recompile = oci_data_safe.MaskingPolicyRecompileEnum(recompile.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateMaskingPolicy(context.Background(), request)
@@ -612,6 +615,8 @@ func (s *DataSafeMaskingPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MaskingPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteMaskingPolicy(context.Background(), request)
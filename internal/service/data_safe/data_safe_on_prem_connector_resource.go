@@ -335,6 +335,7 @@ func (s *DataSafeOnPremConnectorResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OnPremConnector
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -375,6 +376,8 @@ func (s *DataSafeOnPremConnectorResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.OnPremConnectorId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateOnPremConnector(context.Background(), request)
@@ -392,6 +395,8 @@ func (s *DataSafeOnPremConnectorResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OnPremConnectorId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteOnPremConnector(context.Background(), request)
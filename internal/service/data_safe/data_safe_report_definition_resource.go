@@ -591,6 +591,7 @@ func (s *DataSafeReportDefinitionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ReportDefinition
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -695,6 +696,8 @@ func (s *DataSafeReportDefinitionResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateReportDefinition(context.Background(), request)
@@ -712,6 +715,8 @@ func (s *DataSafeReportDefinitionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ReportDefinitionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteReportDefinition(context.Background(), request)
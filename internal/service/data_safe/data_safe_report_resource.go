@@ -370,6 +370,7 @@ func (s *DataSafeReportResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Report
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -400,6 +401,8 @@ func (s *DataSafeReportResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ReportId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateReport(context.Background(), request)
@@ -353,6 +353,7 @@ func (s *DataSafeSdmMaskingPolicyDifferenceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SdmMaskingPolicyDifference
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -388,6 +389,8 @@ func (s *DataSafeSdmMaskingPolicyDifferenceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SdmMaskingPolicyDifferenceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSdmMaskingPolicyDifference(context.Background(), request)
@@ -405,6 +408,8 @@ func (s *DataSafeSdmMaskingPolicyDifferenceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SdmMaskingPolicyDifferenceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteSdmMaskingPolicyDifference(context.Background(), request)
@@ -751,6 +751,7 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SecurityAssessment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -801,6 +802,8 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SecurityAssessmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSecurityAssessment(context.Background(), request)
@@ -818,6 +821,8 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SecurityAssessmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteSecurityAssessment(context.Background(), request)
@@ -246,6 +246,7 @@ func (s *DataSafeSecurityPolicyDeploymentManagementResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SecurityPolicyDeployment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -286,6 +287,8 @@ func (s *DataSafeSecurityPolicyDeploymentManagementResourceCrud) Update() error
 	tmp := s.D.Id()
 	request.SecurityPolicyDeploymentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSecurityPolicyDeployment(context.Background(), request)
@@ -385,6 +385,7 @@ func (s *DataSafeSecurityPolicyDeploymentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SecurityPolicyDeployment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -425,6 +426,8 @@ func (s *DataSafeSecurityPolicyDeploymentResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SecurityPolicyDeploymentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSecurityPolicyDeployment(context.Background(), request)
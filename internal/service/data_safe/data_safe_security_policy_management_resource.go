@@ -242,6 +242,7 @@ func (s *DataSafeSecurityPolicyManagementResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SecurityPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -282,6 +283,8 @@ func (s *DataSafeSecurityPolicyManagementResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SecurityPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSecurityPolicy(context.Background(), request)
@@ -378,6 +378,7 @@ func (s *DataSafeSecurityPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SecurityPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -418,6 +419,8 @@ func (s *DataSafeSecurityPolicyResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SecurityPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSecurityPolicy(context.Background(), request)
@@ -484,6 +484,7 @@ func (s *DataSafeSensitiveDataModelResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SensitiveDataModel
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -587,6 +588,8 @@ func (s *DataSafeSensitiveDataModelResourceCrud) Update() error {
 		request.TargetId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSensitiveDataModel(context.Background(), request)
@@ -604,6 +607,8 @@ func (s *DataSafeSensitiveDataModelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SensitiveDataModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteSensitiveDataModel(context.Background(), request)
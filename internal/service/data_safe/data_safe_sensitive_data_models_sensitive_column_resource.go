@@ -501,6 +501,7 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SensitiveColumn
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -574,6 +575,8 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Update() error
 		request.Status = oci_data_safe.UpdateSensitiveColumnDetailsStatusEnum(status.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSensitiveColumn(context.Background(), request)
@@ -598,6 +601,8 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Delete() error
 		request.SensitiveDataModelId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	_, err := s.Client.DeleteSensitiveColumn(context.Background(), request)
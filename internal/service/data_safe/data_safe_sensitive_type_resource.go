@@ -370,6 +370,7 @@ func (s *DataSafeSensitiveTypeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SensitiveType
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -389,6 +390,8 @@ func (s *DataSafeSensitiveTypeResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSensitiveType(context.Background(), request)
@@ -406,6 +409,8 @@ func (s *DataSafeSensitiveTypeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SensitiveTypeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	_, err := s.Client.DeleteSensitiveType(context.Background(), request)
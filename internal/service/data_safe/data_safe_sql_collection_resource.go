@@ -505,6 +505,7 @@ func (s *DataSafeSqlCollectionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SqlCollection
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -545,6 +546,8 @@ func (s *DataSafeSqlCollectionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SqlCollectionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSqlCollection(context.Background(), request)
@@ -562,6 +565,8 @@ func (s *DataSafeSqlCollectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SqlCollectionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteSqlCollection(context.Background(), request)
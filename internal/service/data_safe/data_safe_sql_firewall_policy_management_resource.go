@@ -368,6 +368,7 @@ func (s *DataSafeSqlFirewallPolicyManagementResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SqlFirewallPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -463,6 +464,8 @@ func (s *DataSafeSqlFirewallPolicyManagementResourceCrud) Update() error {
 		request.ViolationAudit = oci_data_safe.UpdateSqlFirewallPolicyDetailsViolationAuditEnum(violationAudit.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSqlFirewallPolicy(context.Background(), request)
@@ -580,6 +583,8 @@ func (s *DataSafeSqlFirewallPolicyManagementResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SqlFirewallPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteSqlFirewallPolicy(context.Background(), request)
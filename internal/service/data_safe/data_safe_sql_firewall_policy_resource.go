@@ -495,6 +495,7 @@ func (s *DataSafeSqlFirewallPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SqlFirewallPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -590,6 +591,8 @@ func (s *DataSafeSqlFirewallPolicyResourceCrud) Update() error {
 		request.ViolationAudit = oci_data_safe.UpdateSqlFirewallPolicyDetailsViolationAuditEnum(violationAudit.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateSqlFirewallPolicy(context.Background(), request)
@@ -607,6 +610,8 @@ func (s *DataSafeSqlFirewallPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SqlFirewallPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteSqlFirewallPolicy(context.Background(), request)
@@ -359,6 +359,7 @@ func (s *DataSafeTargetAlertPolicyAssociationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.TargetAlertPolicyAssociation
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -405,6 +406,8 @@ func (s *DataSafeTargetAlertPolicyAssociationResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.TargetAlertPolicyAssociationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateTargetAlertPolicyAssociation(context.Background(), request)
@@ -422,6 +425,8 @@ func (s *DataSafeTargetAlertPolicyAssociationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TargetAlertPolicyAssociationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteTargetAlertPolicyAssociation(context.Background(), request)
@@ -470,6 +470,7 @@ func (s *DataSafeTargetDatabasePeerTargetDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PeerTargetDatabase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -518,6 +519,8 @@ func (s *DataSafeTargetDatabasePeerTargetDatabaseResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdatePeerTargetDatabase(context.Background(), request)
@@ -542,6 +545,8 @@ func (s *DataSafeTargetDatabasePeerTargetDatabaseResourceCrud) Delete() error {
 		request.TargetDatabaseId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeletePeerTargetDatabase(context.Background(), request)
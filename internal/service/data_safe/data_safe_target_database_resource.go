@@ -857,6 +857,7 @@ func (s *DataSafeTargetDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.TargetDatabase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -941,6 +942,8 @@ func (s *DataSafeTargetDatabaseResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateTargetDatabase(context.Background(), request)
@@ -958,6 +961,8 @@ func (s *DataSafeTargetDatabaseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TargetDatabaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteTargetDatabase(context.Background(), request)
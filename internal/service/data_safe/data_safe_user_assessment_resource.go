@@ -429,6 +429,7 @@ func (s *DataSafeUserAssessmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.UserAssessment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -479,6 +480,8 @@ func (s *DataSafeUserAssessmentResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.UserAssessmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.UpdateUserAssessment(context.Background(), request)
@@ -496,6 +499,8 @@ func (s *DataSafeUserAssessmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.UserAssessmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
 	response, err := s.Client.DeleteUserAssessment(context.Background(), request)
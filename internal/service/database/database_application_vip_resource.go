@@ -221,6 +221,7 @@ func (s *DatabaseApplicationVipResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ApplicationVip
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -230,6 +231,8 @@ func (s *DatabaseApplicationVipResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ApplicationVipId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.DeleteApplicationVip(context.Background(), request)
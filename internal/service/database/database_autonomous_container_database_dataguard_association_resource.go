@@ -406,6 +406,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Ge
 	}
 
 	s.Res = &response.AutonomousContainerDatabaseDataguardAssociation
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -439,6 +440,8 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Up
 		request.ProtectionMode = oci_database.UpdateAutonomousContainerDatabaseDataGuardAssociationDetailsProtectionModeEnum(protectionMode.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateAutonomousContainerDatabaseDataguardAssociation(context.Background(), request)
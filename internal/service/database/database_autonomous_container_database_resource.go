@@ -1027,6 +1027,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AutonomousContainerDatabase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1102,6 +1103,8 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Update() error {
 		request.VersionPreference = oci_database.UpdateAutonomousContainerDatabaseDetailsVersionPreferenceEnum(versionPreference.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateAutonomousContainerDatabase(context.Background(), request)
@@ -315,6 +315,7 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AutonomousDatabaseBackup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -329,6 +330,8 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Update() error {
 		request.RetentionPeriodInDays = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateAutonomousDatabaseBackup(context.Background(), request)
@@ -353,6 +356,8 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AutonomousDatabaseBackupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteAutonomousDatabaseBackup(context.Background(), request)
@@ -1529,6 +1529,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AutonomousDatabase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1955,6 +1956,8 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 		request.BackupRetentionPeriodInDays = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), request)
@@ -1980,6 +1983,8 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AutonomousDatabaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteAutonomousDatabase(context.Background(), request)
@@ -242,6 +242,7 @@ func (s *DatabaseAutonomousDatabaseSoftwareImageResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AutonomousDatabaseSoftwareImage
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -272,6 +273,8 @@ func (s *DatabaseAutonomousDatabaseSoftwareImageResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateAutonomousDatabaseSoftwareImage(context.Background(), request)
@@ -289,6 +292,8 @@ func (s *DatabaseAutonomousDatabaseSoftwareImageResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AutonomousDatabaseSoftwareImageId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.DeleteAutonomousDatabaseSoftwareImage(context.Background(), request)
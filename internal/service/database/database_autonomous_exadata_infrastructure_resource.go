@@ -548,6 +548,7 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AutonomousExadataInfrastructure
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -608,6 +609,8 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateAutonomousExadataInfrastructure(context.Background(), request)
@@ -676,6 +676,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AutonomousVmCluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -736,6 +737,8 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Update() error {
 		request.TotalContainerDatabases = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateAutonomousVmCluster(context.Background(), request)
@@ -753,6 +756,8 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AutonomousVmClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteAutonomousVmCluster(context.Background(), request)
@@ -281,6 +281,7 @@ func (s *DatabaseBackupDestinationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BackupDestination
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -339,6 +340,8 @@ func (s *DatabaseBackupDestinationResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateBackupDestination(context.Background(), request)
@@ -356,6 +359,8 @@ func (s *DatabaseBackupDestinationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BackupDestinationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteBackupDestination(context.Background(), request)
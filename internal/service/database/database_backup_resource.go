@@ -223,6 +223,7 @@ func (s *DatabaseBackupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Backup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -232,6 +233,8 @@ func (s *DatabaseBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BackupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteBackup(context.Background(), request)
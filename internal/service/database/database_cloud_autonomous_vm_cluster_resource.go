@@ -768,6 +768,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CloudAutonomousVmCluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -852,6 +853,8 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Update() error {
 		request.TotalContainerDatabases = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateCloudAutonomousVmCluster(context.Background(), request)
@@ -877,6 +880,8 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CloudAutonomousVmClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.DeleteCloudAutonomousVmCluster(context.Background(), request)
@@ -548,6 +548,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CloudExadataInfrastructure
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -621,6 +622,8 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Update() error {
 		request.StorageCount = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateCloudExadataInfrastructure(context.Background(), request)
@@ -668,6 +671,8 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Delete() error {
 		request.IsDeleteVmClusters = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteCloudExadataInfrastructure(context.Background(), request)
@@ -198,6 +198,7 @@ func (s *DatabaseCloudVmClusterIormConfigResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExadataIormConfig
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -231,6 +232,8 @@ func (s *DatabaseCloudVmClusterIormConfigResourceCrud) Update() error {
 		request.CloudVmClusterIormConfigUpdateDetails.Objective = oci_database.ExadataIormConfigUpdateDetailsObjectiveEnum(objective.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateCloudVmClusterIormConfig(context.Background(), request)
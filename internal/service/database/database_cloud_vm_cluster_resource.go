@@ -753,6 +753,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CloudVmCluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -920,6 +921,8 @@ func (s *DatabaseCloudVmClusterResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateCloudVmCluster(context.Background(), request)
@@ -945,6 +948,8 @@ func (s *DatabaseCloudVmClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CloudVmClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteCloudVmCluster(context.Background(), request)
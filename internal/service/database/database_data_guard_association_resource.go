@@ -420,6 +420,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DataGuardAssociation
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -452,6 +453,8 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Update() error {
 		request.TransportType = oci_database.UpdateDataGuardAssociationDetailsTransportTypeEnum(transportType.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateDataGuardAssociation(context.Background(), request)
@@ -650,6 +650,7 @@ func (s *DatabaseDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Database
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -665,6 +666,8 @@ func (s *DatabaseDatabaseResourceCrud) Delete() error {
 	}
 
 	deleteDatabaseRetryDurationFn := getdatabaseRetryDurationFunction(s.D.Timeout(schema.TimeoutDelete))
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", deleteDatabaseRetryDurationFn)
 
 	_, err := s.Client.DeleteDatabase(context.Background(), request)
@@ -1241,6 +1244,8 @@ func (s *DatabaseDatabaseResourceCrud) Update() error {
 	}
 
 	updateDatabaseRetryDurationFn := getdatabaseRetryDurationFunction(s.D.Timeout(schema.TimeoutUpdate))
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", updateDatabaseRetryDurationFn)
 
 	response, err := s.Client.UpdateDatabase(context.Background(), request)
@@ -297,6 +297,7 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DatabaseSoftwareImage
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -332,6 +333,8 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateDatabaseSoftwareImage(context.Background(), request)
@@ -354,6 +357,8 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DatabaseSoftwareImageId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteDatabaseSoftwareImage(context.Background(), request)
@@ -584,6 +584,7 @@ func (s *DatabaseDbHomeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DbHome
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 
 	return nil
 }
@@ -840,6 +841,8 @@ func (s *DatabaseDbHomeResourceCrud) Delete() error {
 	// Special override to ensure that DeleteDbHome retries for the duration of the Terraform configured Create timeout
 	// The underlying db system or vm cluster may be in an updating state. So keep retrying it.
 	deleteDbHomeRetryDurationFn := tfresource.GetDbHomeRetryDurationFunction(s.D.Timeout(schema.TimeoutDelete))
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", deleteDbHomeRetryDurationFn)
 
 	_, err := s.Client.DeleteDbHome(context.Background(), request)
@@ -227,6 +227,7 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ConsoleConnection
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -254,6 +255,8 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateConsoleConnection(context.Background(), request)
@@ -290,6 +293,8 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Delete() error {
 		log.Printf("[WARN] Delete() unable to parse current ID: %s", s.D.Id())
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteConsoleConnection(context.Background(), request)
@@ -220,6 +220,7 @@ func (s *DatabaseDbNodeConsoleHistoryResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ConsoleHistory
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 
 	return nil
 }
@@ -252,6 +253,8 @@ func (s *DatabaseDbNodeConsoleHistoryResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateConsoleHistory(context.Background(), request)
@@ -282,6 +285,8 @@ func (s *DatabaseDbNodeConsoleHistoryResourceCrud) Delete() error {
 		request.DbNodeId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteConsoleHistory(context.Background(), request)
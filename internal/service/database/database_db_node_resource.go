@@ -273,6 +273,7 @@ func (s *DatabaseDbNodeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DbNode
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -294,6 +295,8 @@ func (s *DatabaseDbNodeResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateDbNode(context.Background(), request)
@@ -1119,6 +1119,7 @@ func (s *DatabaseDbSystemResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DbSystem
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 
 	err = s.getDbHomeInfo()
 	if err != nil {
@@ -1245,6 +1246,8 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateDbSystem(context.Background(), request)
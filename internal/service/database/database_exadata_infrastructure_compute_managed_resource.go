@@ -415,6 +415,8 @@ func (s *DatabaseExadataInfrastructureComputeManagedResourceCrud) Update() error
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	if s.D.Get("state").(string) == string(oci_database.ExadataInfrastructureLifecycleStateRequiresActivation) ||
@@ -608,6 +610,7 @@ func (s *DatabaseExadataInfrastructureComputeManagedResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExadataInfrastructure
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -755,6 +755,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExadataInfrastructure
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -921,6 +922,8 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Update() error {
 		request.TimeZone = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	if s.D.Get("state").(string) == string(oci_database.ExadataInfrastructureLifecycleStateRequiresActivation) ||
@@ -957,6 +960,8 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExadataInfrastructureId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteExadataInfrastructure(context.Background(), request)
@@ -660,6 +660,7 @@ func (s *DatabaseExadbVmClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExadbVmCluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -674,6 +675,7 @@ func (s *DatabaseExadbVmClusterResourceCrud) Update() error {
 		}
 	}
 	request := oci_database.UpdateExadbVmClusterRequest{}
+	request.IfMatch = tfresource.IfMatchETag(s.D)
 
 	updateRequired := false
 	oldNodeCount := 1
@@ -868,6 +870,8 @@ func (s *DatabaseExadbVmClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExadbVmClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.DeleteExadbVmCluster(context.Background(), request)
@@ -310,6 +310,7 @@ func (s *DatabaseExascaleDbStorageVaultResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExascaleDbStorageVault
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -366,6 +367,8 @@ func (s *DatabaseExascaleDbStorageVaultResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateExascaleDbStorageVault(context.Background(), request)
@@ -389,6 +392,8 @@ func (s *DatabaseExascaleDbStorageVaultResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExascaleDbStorageVaultId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.DeleteExascaleDbStorageVault(context.Background(), request)
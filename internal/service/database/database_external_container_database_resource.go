@@ -288,6 +288,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalContainerDatabase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -323,6 +324,8 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateExternalContainerDatabase(context.Background(), request)
@@ -347,6 +350,8 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExternalContainerDatabaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.DeleteExternalContainerDatabase(context.Background(), request)
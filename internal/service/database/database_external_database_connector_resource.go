@@ -302,6 +302,7 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalDatabaseConnector
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -312,6 +313,8 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateExternalDatabaseConnector(context.Background(), request)
@@ -336,6 +339,8 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExternalDatabaseConnectorId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.DeleteExternalDatabaseConnector(context.Background(), request)
@@ -311,6 +311,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalNonContainerDatabase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -346,6 +347,8 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateExternalNonContainerDatabase(context.Background(), request)
@@ -370,6 +373,8 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExternalNonContainerDatabaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.DeleteExternalNonContainerDatabase(context.Background(), request)
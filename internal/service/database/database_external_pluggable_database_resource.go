@@ -330,6 +330,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalPluggableDatabase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -365,6 +366,8 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateExternalPluggableDatabase(context.Background(), request)
@@ -389,6 +392,8 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExternalPluggableDatabaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.DeleteExternalPluggableDatabase(context.Background(), request)
@@ -265,6 +265,7 @@ func (s *DatabaseKeyStoreResourceCrud) Get() error {
 	}
 
 	s.Res = &response.KeyStore
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -327,6 +328,8 @@ func (s *DatabaseKeyStoreResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateKeyStore(context.Background(), request)
@@ -344,6 +347,8 @@ func (s *DatabaseKeyStoreResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.KeyStoreId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteKeyStore(context.Background(), request)
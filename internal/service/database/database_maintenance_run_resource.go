@@ -331,6 +331,7 @@ func (s *DatabaseMaintenanceRunResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MaintenanceRun
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -398,6 +399,8 @@ func (s *DatabaseMaintenanceRunResourceCrud) Update() error {
 		request.TimeScheduled = &oci_common.SDKTime{Time: tmp}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.UpdateMaintenanceRun(context.Background(), request)
@@ -299,6 +299,7 @@ func (s *DatabaseOneoffPatchResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OneoffPatch
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -336,6 +337,8 @@ func (s *DatabaseOneoffPatchResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.OneoffPatchId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateOneoffPatch(context.Background(), request)
@@ -353,6 +356,8 @@ func (s *DatabaseOneoffPatchResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OneoffPatchId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.DeleteOneoffPatch(context.Background(), request)
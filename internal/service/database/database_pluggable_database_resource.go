@@ -545,6 +545,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PluggableDatabase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -566,6 +567,8 @@ func (s *DatabasePluggableDatabaseResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.PluggableDatabaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdatePluggableDatabase(context.Background(), request)
@@ -583,6 +586,8 @@ func (s *DatabasePluggableDatabaseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PluggableDatabaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeletePluggableDatabase(context.Background(), request)
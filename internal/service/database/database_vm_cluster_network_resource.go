@@ -518,6 +518,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VmClusterNetwork
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -675,6 +676,8 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateVmClusterNetwork(context.Background(), request)
@@ -712,6 +715,8 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VmClusterNetworkId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteVmClusterNetwork(context.Background(), request)
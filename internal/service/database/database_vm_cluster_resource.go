@@ -498,6 +498,7 @@ func (s *DatabaseVmClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VmCluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -603,6 +604,8 @@ func (s *DatabaseVmClusterResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VmClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	response, err := s.Client.UpdateVmCluster(context.Background(), request)
@@ -620,6 +623,8 @@ func (s *DatabaseVmClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VmClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.DeleteVmCluster(context.Background(), request)
@@ -399,6 +399,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) Get() error
 	}
 
 	s.Res = &response.DbManagementPrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -453,6 +454,8 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) Update() err
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateDbManagementPrivateEndpoint(context.Background(), request)
@@ -470,6 +473,8 @@ func (s *DatabaseManagementDbManagementPrivateEndpointResourceCrud) Delete() err
 	tmp := s.D.Id()
 	request.DbManagementPrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.DeleteDbManagementPrivateEndpoint(context.Background(), request)
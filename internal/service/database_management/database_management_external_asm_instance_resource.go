@@ -213,6 +213,7 @@ func (s *DatabaseManagementExternalAsmInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalAsmInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -234,6 +235,8 @@ func (s *DatabaseManagementExternalAsmInstanceResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalAsmInstance(context.Background(), request)
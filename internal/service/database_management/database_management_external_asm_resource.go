@@ -412,6 +412,7 @@ func (s *DatabaseManagementExternalAsmResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalAsm
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -438,6 +439,8 @@ func (s *DatabaseManagementExternalAsmResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalAsm(context.Background(), request)
@@ -367,6 +367,7 @@ func (s *DatabaseManagementExternalClusterInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalClusterInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -393,6 +394,8 @@ func (s *DatabaseManagementExternalClusterInstanceResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalClusterInstance(context.Background(), request)
@@ -443,6 +443,7 @@ func (s *DatabaseManagementExternalClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalCluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -469,6 +470,8 @@ func (s *DatabaseManagementExternalClusterResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalCluster(context.Background(), request)
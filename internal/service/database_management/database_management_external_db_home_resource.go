@@ -206,6 +206,7 @@ func (s *DatabaseManagementExternalDbHomeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalDbHome
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -227,6 +228,8 @@ func (s *DatabaseManagementExternalDbHomeResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalDbHome(context.Background(), request)
@@ -364,6 +364,7 @@ func (s *DatabaseManagementExternalDbNodeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalDbNode
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -390,6 +391,8 @@ func (s *DatabaseManagementExternalDbNodeResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalDbNode(context.Background(), request)
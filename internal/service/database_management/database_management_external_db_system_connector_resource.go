@@ -477,6 +477,7 @@ func (s *DatabaseManagementExternalDbSystemConnectorResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalDbSystemConnector
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -490,6 +491,8 @@ func (s *DatabaseManagementExternalDbSystemConnectorResourceCrud) Update() error
 	tmp := s.D.Id()
 	request.ExternalDbSystemConnectorId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalDbSystemConnector(context.Background(), request)
@@ -507,6 +510,8 @@ func (s *DatabaseManagementExternalDbSystemConnectorResourceCrud) Delete() error
 	tmp := s.D.Id()
 	request.ExternalDbSystemConnectorId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	_, err := s.Client.DeleteExternalDbSystemConnector(context.Background(), request)
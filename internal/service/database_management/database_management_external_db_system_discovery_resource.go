@@ -1456,6 +1456,7 @@ func (s *DatabaseManagementExternalDbSystemDiscoveryResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalDbSystemDiscovery
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1482,6 +1483,8 @@ func (s *DatabaseManagementExternalDbSystemDiscoveryResourceCrud) Update() error
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalDbSystemDiscovery(context.Background(), request)
@@ -1505,6 +1508,8 @@ func (s *DatabaseManagementExternalDbSystemDiscoveryResourceCrud) Delete() error
 	tmp := s.D.Id()
 	request.ExternalDbSystemDiscoveryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	_, err := s.Client.DeleteExternalDbSystemDiscovery(context.Background(), request)
@@ -414,6 +414,7 @@ func (s *DatabaseManagementExternalDbSystemResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalDbSystem
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -449,6 +450,8 @@ func (s *DatabaseManagementExternalDbSystemResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalDbSystem(context.Background(), request)
@@ -466,6 +469,8 @@ func (s *DatabaseManagementExternalDbSystemResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExternalDbSystemId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.DeleteExternalDbSystem(context.Background(), request)
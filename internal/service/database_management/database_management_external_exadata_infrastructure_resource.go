@@ -522,6 +522,7 @@ func (s *DatabaseManagementExternalExadataInfrastructureResourceCrud) Get() erro
 	}
 
 	s.Res = &response.ExternalExadataInfrastructure
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -597,6 +598,8 @@ func (s *DatabaseManagementExternalExadataInfrastructureResourceCrud) Update() e
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalExadataInfrastructure(context.Background(), request)
@@ -614,6 +617,8 @@ func (s *DatabaseManagementExternalExadataInfrastructureResourceCrud) Delete() e
 	tmp := s.D.Id()
 	request.ExternalExadataInfrastructureId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.DeleteExternalExadataInfrastructure(context.Background(), request)
@@ -291,6 +291,7 @@ func (s *DatabaseManagementExternalExadataStorageConnectorResourceCrud) Get() er
 	}
 
 	s.Res = &response.ExternalExadataStorageConnector
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -333,6 +334,8 @@ func (s *DatabaseManagementExternalExadataStorageConnectorResourceCrud) Update()
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalExadataStorageConnector(context.Background(), request)
@@ -350,6 +353,8 @@ func (s *DatabaseManagementExternalExadataStorageConnectorResourceCrud) Delete()
 	tmp := s.D.Id()
 	request.ExternalExadataStorageConnectorId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	_, err := s.Client.DeleteExternalExadataStorageConnector(context.Background(), request)
@@ -323,6 +323,7 @@ func (s *DatabaseManagementExternalExadataStorageGridResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalExadataStorageGrid
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -344,6 +345,8 @@ func (s *DatabaseManagementExternalExadataStorageGridResourceCrud) Update() erro
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalExadataStorageGrid(context.Background(), request)
@@ -331,6 +331,7 @@ func (s *DatabaseManagementExternalExadataStorageServerResourceCrud) Get() error
 	}
 
 	s.Res = &response.ExternalExadataStorageServer
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -352,6 +353,8 @@ func (s *DatabaseManagementExternalExadataStorageServerResourceCrud) Update() er
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalExadataStorageServer(context.Background(), request)
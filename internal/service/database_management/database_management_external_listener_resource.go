@@ -495,6 +495,7 @@ func (s *DatabaseManagementExternalListenerResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExternalListener
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -521,6 +522,8 @@ func (s *DatabaseManagementExternalListenerResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateExternalListener(context.Background(), request)
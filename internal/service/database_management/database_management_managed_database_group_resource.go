@@ -359,6 +359,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ManagedDatabaseGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -406,6 +407,8 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ManagedDatabaseGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateManagedDatabaseGroup(context.Background(), request)
@@ -479,6 +482,8 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagedDatabaseGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	mderr := s.removeAllManagedDatabases(request.ManagedDatabaseGroupId)
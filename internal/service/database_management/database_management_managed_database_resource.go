@@ -369,6 +369,7 @@ func (s *DatabaseManagementManagedDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ManagedDatabase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -390,6 +391,8 @@ func (s *DatabaseManagementManagedDatabaseResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ManagedDatabaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateManagedDatabase(context.Background(), request)
@@ -287,6 +287,7 @@ func (s *DatabaseManagementNamedCredentialResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NamedCredential
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -342,6 +343,8 @@ func (s *DatabaseManagementNamedCredentialResourceCrud) Update() error {
 		request.Scope = oci_database_management.NamedCredentialScopeEnum(scope.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	response, err := s.Client.UpdateNamedCredential(context.Background(), request)
@@ -359,6 +362,8 @@ func (s *DatabaseManagementNamedCredentialResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NamedCredentialId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
 	_, err := s.Client.DeleteNamedCredential(context.Background(), request)
@@ -6,29 +6,29 @@ package database_management
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_database_management_autonomous_database_autonomous_database_dbm_features_management", DatabaseManagementAutonomousDatabaseAutonomousDatabaseDbmFeaturesManagementResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_autonomous_database_autonomous_database_dbm_features_management", DatabaseManagementAutonomousDatabaseAutonomousDatabaseDbmFeaturesManagementResource())
 	tfresource.RegisterResource("oci_database_management_database_dbm_features_management", DatabaseManagementDatabaseDbmFeaturesManagementResource())
-	tfresource.RegisterResource("oci_database_management_db_management_private_endpoint", DatabaseManagementDbManagementPrivateEndpointResource())
-	tfresource.RegisterResource("oci_database_management_external_asm", DatabaseManagementExternalAsmResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_db_management_private_endpoint", DatabaseManagementDbManagementPrivateEndpointResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_external_asm", DatabaseManagementExternalAsmResource())
 	tfresource.RegisterResource("oci_database_management_external_asm_instance", DatabaseManagementExternalAsmInstanceResource())
-	tfresource.RegisterResource("oci_database_management_external_cluster", DatabaseManagementExternalClusterResource())
-	tfresource.RegisterResource("oci_database_management_external_cluster_instance", DatabaseManagementExternalClusterInstanceResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_external_cluster", DatabaseManagementExternalClusterResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_external_cluster_instance", DatabaseManagementExternalClusterInstanceResource())
 	tfresource.RegisterResource("oci_database_management_external_db_home", DatabaseManagementExternalDbHomeResource())
-	tfresource.RegisterResource("oci_database_management_external_db_node", DatabaseManagementExternalDbNodeResource())
-	tfresource.RegisterResource("oci_database_management_external_db_system", DatabaseManagementExternalDbSystemResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_external_db_node", DatabaseManagementExternalDbNodeResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_external_db_system", DatabaseManagementExternalDbSystemResource())
 	tfresource.RegisterResource("oci_database_management_external_db_system_connector", DatabaseManagementExternalDbSystemConnectorResource())
-	tfresource.RegisterResource("oci_database_management_external_db_system_database_managements_management", DatabaseManagementExternalDbSystemDatabaseManagementsManagementResource())
-	tfresource.RegisterResource("oci_database_management_external_db_system_discovery", DatabaseManagementExternalDbSystemDiscoveryResource())
-	tfresource.RegisterResource("oci_database_management_external_db_system_stack_monitorings_management", DatabaseManagementExternalDbSystemStackMonitoringsManagementResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_external_db_system_database_managements_management", DatabaseManagementExternalDbSystemDatabaseManagementsManagementResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_external_db_system_discovery", DatabaseManagementExternalDbSystemDiscoveryResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_external_db_system_stack_monitorings_management", DatabaseManagementExternalDbSystemStackMonitoringsManagementResource())
 	tfresource.RegisterResource("oci_database_management_external_exadata_infrastructure", DatabaseManagementExternalExadataInfrastructureResource())
-	tfresource.RegisterResource("oci_database_management_external_exadata_infrastructure_exadata_management", DatabaseManagementExternalExadataInfrastructureExadataManagementResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_external_exadata_infrastructure_exadata_management", DatabaseManagementExternalExadataInfrastructureExadataManagementResource())
 	tfresource.RegisterResource("oci_database_management_external_exadata_storage_connector", DatabaseManagementExternalExadataStorageConnectorResource())
 	tfresource.RegisterResource("oci_database_management_external_exadata_storage_grid", DatabaseManagementExternalExadataStorageGridResource())
 	tfresource.RegisterResource("oci_database_management_external_exadata_storage_server", DatabaseManagementExternalExadataStorageServerResource())
-	tfresource.RegisterResource("oci_database_management_external_listener", DatabaseManagementExternalListenerResource())
-	tfresource.RegisterResource("oci_database_management_externalcontainerdatabase_external_container_dbm_features_management", DatabaseManagementExternalcontainerdatabaseExternalContainerDbmFeaturesManagementResource())
-	tfresource.RegisterResource("oci_database_management_externalnoncontainerdatabase_external_non_container_dbm_features_management", DatabaseManagementExternalnoncontainerdatabaseExternalNonContainerDbmFeaturesManagementResource())
-	tfresource.RegisterResource("oci_database_management_externalpluggabledatabase_external_pluggable_dbm_features_management", DatabaseManagementExternalpluggabledatabaseExternalPluggableDbmFeaturesManagementResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_external_listener", DatabaseManagementExternalListenerResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_externalcontainerdatabase_external_container_dbm_features_management", DatabaseManagementExternalcontainerdatabaseExternalContainerDbmFeaturesManagementResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_externalnoncontainerdatabase_external_non_container_dbm_features_management", DatabaseManagementExternalnoncontainerdatabaseExternalNonContainerDbmFeaturesManagementResource())
+	tfresource.RegisterWorkRequestResource("oci_database_management_externalpluggabledatabase_external_pluggable_dbm_features_management", DatabaseManagementExternalpluggabledatabaseExternalPluggableDbmFeaturesManagementResource())
 	tfresource.RegisterResource("oci_database_management_managed_database", DatabaseManagementManagedDatabaseResource())
 	tfresource.RegisterResource("oci_database_management_managed_database_group", DatabaseManagementManagedDatabaseGroupResource())
 	tfresource.RegisterResource("oci_database_management_managed_databases_change_database_parameter", DatabaseManagementManagedDatabasesChangeDatabaseParameterResource())
@@ -498,6 +498,7 @@ func (s *DatabaseMigrationConnectionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Connection
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -517,6 +518,8 @@ func (s *DatabaseMigrationConnectionResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
 	response, err := s.Client.UpdateConnection(context.Background(), request)
@@ -534,6 +537,8 @@ func (s *DatabaseMigrationConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ConnectionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
 	response, err := s.Client.DeleteConnection(context.Background(), request)
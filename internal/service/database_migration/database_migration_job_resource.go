@@ -432,6 +432,7 @@ func (s *DatabaseMigrationJobResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Job
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -458,6 +459,8 @@ func (s *DatabaseMigrationJobResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.JobId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
 	response, err := s.Client.UpdateJob(context.Background(), request)
@@ -475,6 +478,8 @@ func (s *DatabaseMigrationJobResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.JobId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
 	_, err := s.Client.DeleteJob(context.Background(), request)
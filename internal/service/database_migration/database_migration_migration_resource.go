@@ -1101,6 +1101,7 @@ func (s *DatabaseMigrationMigrationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Migration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1120,6 +1121,8 @@ func (s *DatabaseMigrationMigrationResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
 	response, err := s.Client.UpdateMigration(context.Background(), request)
@@ -1137,6 +1140,8 @@ func (s *DatabaseMigrationMigrationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MigrationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
 	response, err := s.Client.DeleteMigration(context.Background(), request)
@@ -582,6 +582,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DatabaseToolsConnection
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 
 	return nil
 }
@@ -602,6 +603,8 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
 	response, err := s.Client.UpdateDatabaseToolsConnection(context.Background(), request)
@@ -624,6 +627,8 @@ func (s *DatabaseToolsDatabaseToolsConnectionResourceCrud) Delete() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
 	response, err := s.Client.DeleteDatabaseToolsConnection(context.Background(), request)
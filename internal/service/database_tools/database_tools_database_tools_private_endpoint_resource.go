@@ -488,6 +488,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DatabaseToolsPrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -547,6 +548,8 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
 	response, err := s.Client.UpdateDatabaseToolsPrivateEndpoint(context.Background(), request)
@@ -569,6 +572,8 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointResourceCrud) Delete() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
 
 	response, err := s.Client.DeleteDatabaseToolsPrivateEndpoint(context.Background(), request)
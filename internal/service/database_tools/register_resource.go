@@ -6,6 +6,6 @@ package database_tools
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_database_tools_database_tools_connection", DatabaseToolsDatabaseToolsConnectionResource())
-	tfresource.RegisterResource("oci_database_tools_database_tools_private_endpoint", DatabaseToolsDatabaseToolsPrivateEndpointResource())
+	tfresource.RegisterWorkRequestResource("oci_database_tools_database_tools_connection", DatabaseToolsDatabaseToolsConnectionResource())
+	tfresource.RegisterWorkRequestResource("oci_database_tools_database_tools_private_endpoint", DatabaseToolsDatabaseToolsPrivateEndpointResource())
 }
@@ -398,6 +398,7 @@ func (s *DatacatalogCatalogPrivateEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CatalogPrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -451,6 +452,8 @@ func (s *DatacatalogCatalogPrivateEndpointResourceCrud) Update() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
 	response, err := s.Client.UpdateCatalogPrivateEndpoint(context.Background(), request)
@@ -495,6 +498,8 @@ func (s *DatacatalogCatalogPrivateEndpointResourceCrud) Delete() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
 	response, err := s.Client.DeleteCatalogPrivateEndpoint(context.Background(), request)
@@ -409,6 +409,7 @@ func (s *DatacatalogCatalogResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Catalog
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -491,6 +492,8 @@ func (s *DatacatalogCatalogResourceCrud) Update() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
 	response, err := s.Client.UpdateCatalog(context.Background(), request)
@@ -513,6 +516,8 @@ func (s *DatacatalogCatalogResourceCrud) Delete() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
 	// Need to detach all Private Endpoints before delete Catalog
@@ -291,6 +291,7 @@ func (s *DatacatalogConnectionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Connection
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -341,6 +342,8 @@ func (s *DatacatalogConnectionResourceCrud) Update() error {
 		request.Properties = convertedProperties
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
 	response, err := s.Client.UpdateConnection(context.Background(), request)
@@ -373,6 +376,8 @@ func (s *DatacatalogConnectionResourceCrud) Delete() error {
 		request.DataAssetKey = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
 	_, err := s.Client.DeleteConnection(context.Background(), request)
@@ -254,6 +254,7 @@ func (s *DatacatalogDataAssetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DataAsset
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -286,6 +287,8 @@ func (s *DatacatalogDataAssetResourceCrud) Update() error {
 		request.Properties = convertedProperties
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
 	response, err := s.Client.UpdateDataAsset(context.Background(), request)
@@ -310,6 +313,8 @@ func (s *DatacatalogDataAssetResourceCrud) Delete() error {
 		request.DataAssetKey = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
 	_, err := s.Client.DeleteDataAsset(context.Background(), request)
@@ -385,6 +385,7 @@ func (s *DatacatalogMetastoreResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Metastore
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -425,6 +426,8 @@ func (s *DatacatalogMetastoreResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.MetastoreId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
 	response, err := s.Client.UpdateMetastore(context.Background(), request)
@@ -447,6 +450,8 @@ func (s *DatacatalogMetastoreResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MetastoreId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
 	_, err := s.Client.DeleteMetastore(context.Background(), request)
@@ -554,6 +554,7 @@ func (s *DataflowApplicationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Application
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -748,6 +749,8 @@ func (s *DataflowApplicationResourceCrud) Update() error {
 		request.WarehouseBucketUri = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
 	response, err := s.Client.UpdateApplication(context.Background(), request)
@@ -765,6 +768,8 @@ func (s *DataflowApplicationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ApplicationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
 	_, err := s.Client.DeleteApplication(context.Background(), request)
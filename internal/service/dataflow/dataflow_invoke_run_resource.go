@@ -663,6 +663,7 @@ func (s *DataflowInvokeRunResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Run
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -699,6 +700,8 @@ func (s *DataflowInvokeRunResourceCrud) Update() error {
 		request.RunId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
 	response, err := s.Client.UpdateRun(context.Background(), request)
@@ -731,6 +734,8 @@ func (s *DataflowInvokeRunResourceCrud) Delete() error {
 	tmp = s.D.Id()
 	request.RunId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
 	_, err = s.Client.DeleteRun(context.Background(), request)
@@ -587,6 +587,7 @@ func (s *DataflowPoolResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Pool
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -666,6 +667,8 @@ func (s *DataflowPoolResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
 	response, err := s.Client.UpdatePool(context.Background(), request)
@@ -693,6 +696,8 @@ func (s *DataflowPoolResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PoolId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
 	_, err := s.Client.DeletePool(context.Background(), request)
@@ -440,6 +440,7 @@ func (s *DataflowPrivateEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -529,6 +530,8 @@ func (s *DataflowPrivateEndpointResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
 	response, err := s.Client.UpdatePrivateEndpoint(context.Background(), request)
@@ -546,6 +549,8 @@ func (s *DataflowPrivateEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
 	response, err := s.Client.DeletePrivateEndpoint(context.Background(), request)
@@ -232,6 +232,7 @@ func (s *DataflowRunStatementResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Statement
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -248,6 +249,8 @@ func (s *DataflowRunStatementResourceCrud) Delete() error {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
 	_, error := s.Client.DeleteStatement(context.Background(), request)
@@ -564,6 +564,7 @@ func (s *DataflowSqlEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SqlEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -594,6 +595,8 @@ func (s *DataflowSqlEndpointResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SqlEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
 	_, err := s.Client.UpdateSqlEndpoint(context.Background(), request)
@@ -610,6 +613,8 @@ func (s *DataflowSqlEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SqlEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
 	_, err := s.Client.DeleteSqlEndpoint(context.Background(), request)
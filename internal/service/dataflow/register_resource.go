@@ -8,8 +8,8 @@ import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 func RegisterResource() {
 	tfresource.RegisterResource("oci_dataflow_application", DataflowApplicationResource())
 	tfresource.RegisterResource("oci_dataflow_invoke_run", DataflowInvokeRunResource())
-	tfresource.RegisterResource("oci_dataflow_pool", DataflowPoolResource())
-	tfresource.RegisterResource("oci_dataflow_private_endpoint", DataflowPrivateEndpointResource())
+	tfresource.RegisterWorkRequestResource("oci_dataflow_pool", DataflowPoolResource())
+	tfresource.RegisterWorkRequestResource("oci_dataflow_private_endpoint", DataflowPrivateEndpointResource())
 	tfresource.RegisterResource("oci_dataflow_run_statement", DataflowRunStatementResource())
 	tfresource.RegisterResource("oci_dataflow_sql_endpoint", DataflowSqlEndpointResource())
 }
@@ -577,6 +577,7 @@ func (s *DataintegrationWorkspaceApplicationPatchResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Patch
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -598,6 +599,8 @@ func (s *DataintegrationWorkspaceApplicationPatchResourceCrud) Delete() error {
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	_, err := s.Client.DeletePatch(context.Background(), request)
@@ -650,6 +650,7 @@ func (s *DataintegrationWorkspaceApplicationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Application
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -754,6 +755,8 @@ func (s *DataintegrationWorkspaceApplicationResourceCrud) Update() error {
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	response, err := s.Client.UpdateApplication(context.Background(), request)
@@ -778,6 +781,8 @@ func (s *DataintegrationWorkspaceApplicationResourceCrud) Delete() error {
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	_, err := s.Client.DeleteApplication(context.Background(), request)
@@ -558,6 +558,7 @@ func (s *DataintegrationWorkspaceApplicationScheduleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Schedule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -662,6 +663,8 @@ func (s *DataintegrationWorkspaceApplicationScheduleResourceCrud) Update() error
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	response, err := s.Client.UpdateSchedule(context.Background(), request)
@@ -691,6 +694,8 @@ func (s *DataintegrationWorkspaceApplicationScheduleResourceCrud) Delete() error
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	_, err := s.Client.DeleteSchedule(context.Background(), request)
@@ -1026,6 +1026,7 @@ func (s *DataintegrationWorkspaceApplicationTaskScheduleResourceCrud) Get() erro
 	}
 
 	s.Res = &response.TaskSchedule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1190,6 +1191,8 @@ func (s *DataintegrationWorkspaceApplicationTaskScheduleResourceCrud) Update() e
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	response, err := s.Client.UpdateTaskSchedule(context.Background(), request)
@@ -1219,6 +1222,8 @@ func (s *DataintegrationWorkspaceApplicationTaskScheduleResourceCrud) Delete() e
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	_, err := s.Client.DeleteTaskSchedule(context.Background(), request)
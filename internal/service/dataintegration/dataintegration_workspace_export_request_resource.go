@@ -339,6 +339,7 @@ func (s *DataintegrationWorkspaceExportRequestResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExportRequest
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -355,6 +356,8 @@ func (s *DataintegrationWorkspaceExportRequestResourceCrud) Delete() error {
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	_, err := s.Client.DeleteExportRequest(context.Background(), request)
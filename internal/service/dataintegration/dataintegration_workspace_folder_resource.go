@@ -428,6 +428,7 @@ func (s *DataintegrationWorkspaceFolderResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Folder
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -511,6 +512,8 @@ func (s *DataintegrationWorkspaceFolderResourceCrud) Update() error {
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	response, err := s.Client.UpdateFolder(context.Background(), request)
@@ -535,6 +538,8 @@ func (s *DataintegrationWorkspaceFolderResourceCrud) Delete() error {
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	_, err := s.Client.DeleteFolder(context.Background(), request)
@@ -343,6 +343,7 @@ func (s *DataintegrationWorkspaceImportRequestResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ImportRequest
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -359,6 +360,8 @@ func (s *DataintegrationWorkspaceImportRequestResourceCrud) Delete() error {
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	_, err := s.Client.DeleteImportRequest(context.Background(), request)
@@ -421,6 +421,7 @@ func (s *DataintegrationWorkspaceProjectResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Project
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -494,6 +495,8 @@ func (s *DataintegrationWorkspaceProjectResourceCrud) Update() error {
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	response, err := s.Client.UpdateProject(context.Background(), request)
@@ -518,6 +521,8 @@ func (s *DataintegrationWorkspaceProjectResourceCrud) Delete() error {
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	_, err := s.Client.DeleteProject(context.Background(), request)
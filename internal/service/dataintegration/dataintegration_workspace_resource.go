@@ -476,6 +476,7 @@ func (s *DataintegrationWorkspaceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Workspace
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -516,6 +517,8 @@ func (s *DataintegrationWorkspaceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.WorkspaceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	response, err := s.Client.UpdateWorkspace(context.Background(), request)
@@ -547,6 +550,8 @@ func (s *DataintegrationWorkspaceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.WorkspaceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	response, err := s.Client.DeleteWorkspace(context.Background(), request)
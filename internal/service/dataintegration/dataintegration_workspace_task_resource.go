@@ -4850,6 +4850,7 @@ func (s *DataintegrationWorkspaceTaskResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Task
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	log.Printf(" Get() Task Response %v", response.Task)
 	return nil
 }
@@ -4861,6 +4862,8 @@ func (s *DataintegrationWorkspaceTaskResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	response, err := s.Client.UpdateTask(context.Background(), request)
@@ -4885,6 +4888,8 @@ func (s *DataintegrationWorkspaceTaskResourceCrud) Delete() error {
 		request.WorkspaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataintegration")
 
 	_, err := s.Client.DeleteTask(context.Background(), request)
@@ -6,7 +6,7 @@ package dataintegration
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_dataintegration_workspace", DataintegrationWorkspaceResource())
+	tfresource.RegisterWorkRequestResource("oci_dataintegration_workspace", DataintegrationWorkspaceResource())
 	tfresource.RegisterResource("oci_dataintegration_workspace_application", DataintegrationWorkspaceApplicationResource())
 	tfresource.RegisterResource("oci_dataintegration_workspace_application_patch", DataintegrationWorkspaceApplicationPatchResource())
 	tfresource.RegisterResource("oci_dataintegration_workspace_application_schedule", DataintegrationWorkspaceApplicationScheduleResource())
@@ -400,6 +400,7 @@ func (s *DatascienceDataSciencePrivateEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DataSciencePrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -454,6 +455,8 @@ func (s *DatascienceDataSciencePrivateEndpointResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.UpdateDataSciencePrivateEndpoint(context.Background(), request)
@@ -471,6 +474,8 @@ func (s *DatascienceDataSciencePrivateEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DataSciencePrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	_, err := s.Client.DeleteDataSciencePrivateEndpoint(context.Background(), request)
@@ -599,6 +599,7 @@ func (s *DatascienceJobResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Job
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	if emptyArtifact, ok := s.D.GetOkExists("empty_artifact"); ok {
 		tmp := emptyArtifact.(bool)
 		if !tmp {
@@ -676,6 +677,8 @@ func (s *DatascienceJobResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.UpdateJob(context.Background(), request)
@@ -698,6 +701,8 @@ func (s *DatascienceJobResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.JobId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	_, err := s.Client.DeleteJob(context.Background(), request)
@@ -557,6 +557,7 @@ func (s *DatascienceJobRunResourceCrud) Get() error {
 	}
 
 	s.Res = &response.JobRun
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -592,6 +593,8 @@ func (s *DatascienceJobRunResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.JobRunId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.UpdateJobRun(context.Background(), request)
@@ -609,6 +612,8 @@ func (s *DatascienceJobRunResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.JobRunId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	_, err := s.Client.DeleteJobRun(context.Background(), request)
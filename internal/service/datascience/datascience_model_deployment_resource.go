@@ -898,6 +898,7 @@ func (s *DatascienceModelDeploymentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ModelDeployment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -960,6 +961,8 @@ func (s *DatascienceModelDeploymentResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ModelDeploymentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.UpdateModelDeployment(context.Background(), request)
@@ -977,6 +980,8 @@ func (s *DatascienceModelDeploymentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ModelDeploymentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.DeleteModelDeployment(context.Background(), request)
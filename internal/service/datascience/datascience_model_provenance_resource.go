@@ -185,6 +185,7 @@ func (s *DatascienceModelProvenanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ModelProvenance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -226,6 +227,8 @@ func (s *DatascienceModelProvenanceResourceCrud) Update() error {
 		request.TrainingScript = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.UpdateModelProvenance(context.Background(), request)
@@ -434,6 +434,7 @@ func (s *DatascienceModelResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Model
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	if emptyModel, ok := s.D.GetOkExists("empty_model"); ok {
 		tmp := emptyModel.(bool)
 		if !tmp {
@@ -518,6 +519,8 @@ func (s *DatascienceModelResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.UpdateModel(context.Background(), request)
@@ -535,6 +538,8 @@ func (s *DatascienceModelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	_, err := s.Client.DeleteModel(context.Background(), request)
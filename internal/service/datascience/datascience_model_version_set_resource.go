@@ -346,6 +346,7 @@ func (s *DatascienceModelVersionSetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ModelVersionSet
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -381,6 +382,8 @@ func (s *DatascienceModelVersionSetResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ModelVersionSetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.UpdateModelVersionSet(context.Background(), request)
@@ -403,6 +406,8 @@ func (s *DatascienceModelVersionSetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ModelVersionSetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.DeleteModelVersionSet(context.Background(), request)
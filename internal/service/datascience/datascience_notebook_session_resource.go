@@ -556,6 +556,7 @@ func (s *DatascienceNotebookSessionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NotebookSession
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -630,6 +631,8 @@ func (s *DatascienceNotebookSessionResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.UpdateNotebookSession(context.Background(), request)
@@ -647,6 +650,8 @@ func (s *DatascienceNotebookSessionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NotebookSessionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	_, err := s.Client.DeleteNotebookSession(context.Background(), request)
@@ -862,6 +862,7 @@ func (s *DatasciencePipelineResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Pipeline
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	log.Printf("[DEBUG] the response from the pipeline get call is %v", s.Res)
 	// after we get the pipeline response, GET the head artifact for each of the custom_script steps
 	for _, item := range s.Res.StepDetails {
@@ -960,6 +961,8 @@ func (s *DatasciencePipelineResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.UpdatePipeline(context.Background(), request)
@@ -987,6 +990,8 @@ func (s *DatasciencePipelineResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PipelineId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.DeletePipeline(context.Background(), request)
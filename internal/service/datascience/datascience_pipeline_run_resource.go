@@ -604,6 +604,7 @@ func (s *DatasciencePipelineRunResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PipelineRun
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -639,6 +640,8 @@ func (s *DatasciencePipelineRunResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.PipelineRunId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	response, err := s.Client.UpdatePipelineRun(context.Background(), request)
@@ -661,6 +664,8 @@ func (s *DatasciencePipelineRunResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PipelineRunId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
 	_, err := s.Client.DeletePipelineRun(context.Background(), request)
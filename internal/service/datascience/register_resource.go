@@ -10,7 +10,7 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_datascience_job", DatascienceJobResource())
 	tfresource.RegisterResource("oci_datascience_job_run", DatascienceJobRunResource())
 	tfresource.RegisterResource("oci_datascience_model", DatascienceModelResource())
-	tfresource.RegisterResource("oci_datascience_model_deployment", DatascienceModelDeploymentResource())
+	tfresource.RegisterWorkRequestResource("oci_datascience_model_deployment", DatascienceModelDeploymentResource())
 	tfresource.RegisterResource("oci_datascience_model_provenance", DatascienceModelProvenanceResource())
 	tfresource.RegisterResource("oci_datascience_model_version_set", DatascienceModelVersionSetResource())
 	tfresource.RegisterResource("oci_datascience_model_artifact_export", DatascienceModelArtifactExportResource())
@@ -467,6 +467,7 @@ func (s *DelegateAccessControlDelegationControlResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DelegationControl
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -565,6 +566,8 @@ func (s *DelegateAccessControlDelegationControlResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "delegate_access_control")
 
 	response, err := s.Client.UpdateDelegationControl(context.Background(), request)
@@ -587,6 +590,8 @@ func (s *DelegateAccessControlDelegationControlResourceCrud) Delete() error {
 		request.Description = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "delegate_access_control")
 
 	response, err := s.Client.DeleteDelegationControl(context.Background(), request)
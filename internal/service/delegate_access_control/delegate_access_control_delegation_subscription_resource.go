@@ -347,6 +347,7 @@ func (s *DelegateAccessControlDelegationSubscriptionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DelegationSubscription
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -382,6 +383,8 @@ func (s *DelegateAccessControlDelegationSubscriptionResourceCrud) Update() error
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "delegate_access_control")
 
 	response, err := s.Client.UpdateDelegationSubscription(context.Background(), request)
@@ -399,6 +402,8 @@ func (s *DelegateAccessControlDelegationSubscriptionResourceCrud) Delete() error
 	tmp := s.D.Id()
 	request.DelegationSubscriptionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "delegate_access_control")
 
 	response, err := s.Client.DeleteDelegationSubscription(context.Background(), request)
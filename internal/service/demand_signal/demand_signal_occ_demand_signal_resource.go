@@ -339,6 +339,7 @@ func (s *DemandSignalOccDemandSignalResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OccDemandSignal
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -370,6 +371,8 @@ func (s *DemandSignalOccDemandSignalResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.OccDemandSignalId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "demand_signal")
 
 	response, err := s.Client.UpdateOccDemandSignal(context.Background(), request)
@@ -387,6 +390,8 @@ func (s *DemandSignalOccDemandSignalResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OccDemandSignalId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "demand_signal")
 
 	_, err := s.Client.DeleteOccDemandSignal(context.Background(), request)
@@ -670,6 +670,7 @@ func (s *DesktopsDesktopPoolResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DesktopPool
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -763,6 +764,8 @@ func (s *DesktopsDesktopPoolResourceCrud) Update() error {
 		request.TimeStopScheduled = &oci_common.SDKTime{Time: tmp}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "desktops")
 
 	response, err := s.Client.UpdateDesktopPool(context.Background(), request)
@@ -785,6 +788,8 @@ func (s *DesktopsDesktopPoolResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DesktopPoolId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "desktops")
 
 	response, err := s.Client.DeleteDesktopPool(context.Background(), request)
@@ -388,6 +388,7 @@ func (s *DevopsBuildPipelineResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BuildPipeline
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -430,6 +431,8 @@ func (s *DevopsBuildPipelineResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateBuildPipeline(context.Background(), request)
@@ -447,6 +450,8 @@ func (s *DevopsBuildPipelineResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BuildPipelineId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.DeleteBuildPipeline(context.Background(), request)
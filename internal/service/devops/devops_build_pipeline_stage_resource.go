@@ -602,6 +602,7 @@ func (s *DevopsBuildPipelineStageResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BuildPipelineStage
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -612,6 +613,8 @@ func (s *DevopsBuildPipelineStageResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateBuildPipelineStage(context.Background(), request)
@@ -629,6 +632,8 @@ func (s *DevopsBuildPipelineStageResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BuildPipelineStageId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.DeleteBuildPipelineStage(context.Background(), request)
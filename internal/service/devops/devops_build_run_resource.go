@@ -672,6 +672,7 @@ func (s *DevopsBuildRunResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BuildRun
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -698,6 +699,8 @@ func (s *DevopsBuildRunResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateBuildRun(context.Background(), request)
@@ -392,6 +392,7 @@ func (s *DevopsConnectionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Connection
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -402,6 +403,8 @@ func (s *DevopsConnectionResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateConnection(context.Background(), request)
@@ -419,6 +422,8 @@ func (s *DevopsConnectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ConnectionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.DeleteConnection(context.Background(), request)
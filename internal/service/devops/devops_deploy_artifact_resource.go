@@ -469,6 +469,7 @@ func (s *DevopsDeployArtifactResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DeployArtifact
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -519,6 +520,8 @@ func (s *DevopsDeployArtifactResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateDeployArtifact(context.Background(), request)
@@ -536,6 +539,8 @@ func (s *DevopsDeployArtifactResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DeployArtifactId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.DeleteDeployArtifact(context.Background(), request)
@@ -430,6 +430,7 @@ func (s *DevopsDeployEnvironmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DeployEnvironment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -440,6 +441,8 @@ func (s *DevopsDeployEnvironmentResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateDeployEnvironment(context.Background(), request)
@@ -457,6 +460,8 @@ func (s *DevopsDeployEnvironmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DeployEnvironmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.DeleteDeployEnvironment(context.Background(), request)
@@ -525,6 +525,7 @@ func (s *DevopsDeployPipelineResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DeployPipeline
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -567,6 +568,8 @@ func (s *DevopsDeployPipelineResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateDeployPipeline(context.Background(), request)
@@ -584,6 +587,8 @@ func (s *DevopsDeployPipelineResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DeployPipelineId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.DeleteDeployPipeline(context.Background(), request)
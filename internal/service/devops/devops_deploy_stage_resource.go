@@ -1181,6 +1181,7 @@ func (s *DevopsDeployStageResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DeployStage
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1191,6 +1192,8 @@ func (s *DevopsDeployStageResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateDeployStage(context.Background(), request)
@@ -1208,6 +1211,8 @@ func (s *DevopsDeployStageResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DeployStageId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.DeleteDeployStage(context.Background(), request)
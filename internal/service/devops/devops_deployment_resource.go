@@ -519,6 +519,7 @@ func (s *DevopsDeploymentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Deployment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -529,6 +530,8 @@ func (s *DevopsDeploymentResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateDeployment(context.Background(), request)
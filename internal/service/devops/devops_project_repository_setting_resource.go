@@ -251,6 +251,7 @@ func (s *DevopsProjectRepositorySettingResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ProjectRepositorySettings
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -284,6 +285,8 @@ func (s *DevopsProjectRepositorySettingResourceCrud) Update() error {
 		request.ProjectId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateProjectRepositorySettings(context.Background(), request)
@@ -303,6 +306,8 @@ func (s *DevopsProjectRepositorySettingResourceCrud) Delete() error {
 		request.ProjectId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	_, err := s.Client.DeleteProjectRepositorySettings(context.Background(), request)
@@ -156,6 +156,8 @@ func (s *DevopsRepositoryProtectedBranchManagementResourceCrud) Delete() error {
 		request.RepositoryId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	_, err := s.Client.DeleteProtectedBranch(context.Background(), request)
@@ -185,6 +185,7 @@ func (s *DevopsRepositoryRefResourceCrud) Get() error {
 	}
 
 	s.Res = &response.RepositoryRef
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -219,6 +220,8 @@ func (s *DevopsRepositoryRefResourceCrud) Delete() error {
 		request.RepositoryId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	_, err := s.Client.DeleteRef(context.Background(), request)
@@ -472,6 +472,7 @@ func (s *DevopsRepositoryResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Repository
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -523,6 +524,8 @@ func (s *DevopsRepositoryResourceCrud) Update() error {
 		request.RepositoryType = oci_devops.RepositoryRepositoryTypeEnum(repositoryType.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateRepository(context.Background(), request)
@@ -540,6 +543,8 @@ func (s *DevopsRepositoryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RepositoryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.DeleteRepository(context.Background(), request)
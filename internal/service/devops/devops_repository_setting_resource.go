@@ -282,6 +282,7 @@ func (s *DevopsRepositorySettingResourceCrud) Get() error {
 	}
 
 	s.Res = &response.RepositorySettings
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -326,6 +327,8 @@ func (s *DevopsRepositorySettingResourceCrud) Update() error {
 		request.RepositoryId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateRepositorySettings(context.Background(), request)
@@ -345,6 +348,8 @@ func (s *DevopsRepositorySettingResourceCrud) Delete() error {
 		request.RepositoryId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	_, err := s.Client.DeleteRepositorySettings(context.Background(), request)
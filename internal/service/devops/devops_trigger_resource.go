@@ -495,6 +495,7 @@ func (s *DevopsTriggerResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Trigger
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -505,6 +506,8 @@ func (s *DevopsTriggerResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.UpdateTrigger(context.Background(), request)
@@ -522,6 +525,8 @@ func (s *DevopsTriggerResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TriggerId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
 	response, err := s.Client.DeleteTrigger(context.Background(), request)
@@ -6,21 +6,21 @@ package devops
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_devops_build_pipeline", DevopsBuildPipelineResource())
-	tfresource.RegisterResource("oci_devops_build_pipeline_stage", DevopsBuildPipelineStageResource())
+	tfresource.RegisterWorkRequestResource("oci_devops_build_pipeline", DevopsBuildPipelineResource())
+	tfresource.RegisterWorkRequestResource("oci_devops_build_pipeline_stage", DevopsBuildPipelineStageResource())
 	tfresource.RegisterResource("oci_devops_build_run", DevopsBuildRunResource())
-	tfresource.RegisterResource("oci_devops_connection", DevopsConnectionResource())
-	tfresource.RegisterResource("oci_devops_deploy_artifact", DevopsDeployArtifactResource())
-	tfresource.RegisterResource("oci_devops_deploy_environment", DevopsDeployEnvironmentResource())
-	tfresource.RegisterResource("oci_devops_deploy_pipeline", DevopsDeployPipelineResource())
-	tfresource.RegisterResource("oci_devops_deploy_stage", DevopsDeployStageResource())
+	tfresource.RegisterWorkRequestResource("oci_devops_connection", DevopsConnectionResource())
+	tfresource.RegisterWorkRequestResource("oci_devops_deploy_artifact", DevopsDeployArtifactResource())
+	tfresource.RegisterWorkRequestResource("oci_devops_deploy_environment", DevopsDeployEnvironmentResource())
+	tfresource.RegisterWorkRequestResource("oci_devops_deploy_pipeline", DevopsDeployPipelineResource())
+	tfresource.RegisterWorkRequestResource("oci_devops_deploy_stage", DevopsDeployStageResource())
 	tfresource.RegisterResource("oci_devops_deployment", DevopsDeploymentResource())
-	tfresource.RegisterResource("oci_devops_project", DevopsProjectResource())
+	tfresource.RegisterWorkRequestResource("oci_devops_project", DevopsProjectResource())
 	tfresource.RegisterResource("oci_devops_project_repository_setting", DevopsProjectRepositorySettingResource())
-	tfresource.RegisterResource("oci_devops_repository", DevopsRepositoryResource())
-	tfresource.RegisterResource("oci_devops_repository_mirror", DevopsRepositoryMirrorResource())
+	tfresource.RegisterWorkRequestResource("oci_devops_repository", DevopsRepositoryResource())
+	tfresource.RegisterWorkRequestResource("oci_devops_repository_mirror", DevopsRepositoryMirrorResource())
 	tfresource.RegisterResource("oci_devops_repository_protected_branch_management", DevopsRepositoryProtectedBranchManagementResource())
 	tfresource.RegisterResource("oci_devops_repository_ref", DevopsRepositoryRefResource())
 	tfresource.RegisterResource("oci_devops_repository_setting", DevopsRepositorySettingResource())
-	tfresource.RegisterResource("oci_devops_trigger", DevopsTriggerResource())
+	tfresource.RegisterWorkRequestResource("oci_devops_trigger", DevopsTriggerResource())
 }
@@ -566,6 +566,7 @@ func (s *DisasterRecoveryDrPlanExecutionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DrPlanExecution
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -592,6 +593,8 @@ func (s *DisasterRecoveryDrPlanExecutionResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "disaster_recovery")
 
 	response, err := s.Client.UpdateDrPlanExecution(context.Background(), request)
@@ -609,6 +612,8 @@ func (s *DisasterRecoveryDrPlanExecutionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrPlanExecutionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "disaster_recovery")
 
 	response, err := s.Client.DeleteDrPlanExecution(context.Background(), request)
@@ -499,6 +499,7 @@ func (s *DisasterRecoveryDrPlanResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DrPlan
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -542,6 +543,8 @@ func (s *DisasterRecoveryDrPlanResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "disaster_recovery")
 
 	response, err := s.Client.UpdateDrPlan(context.Background(), request)
@@ -559,6 +562,8 @@ func (s *DisasterRecoveryDrPlanResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrPlanId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "disaster_recovery")
 
 	_, err := s.Client.DeleteDrPlan(context.Background(), request)
@@ -851,6 +851,7 @@ func (s *DisasterRecoveryDrProtectionGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DrProtectionGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -915,6 +916,8 @@ func (s *DisasterRecoveryDrProtectionGroupResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "disaster_recovery")
 
 	response, err := s.Client.UpdateDrProtectionGroup(context.Background(), request)
@@ -932,6 +935,8 @@ func (s *DisasterRecoveryDrProtectionGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DrProtectionGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "disaster_recovery")
 
 	response, err := s.Client.DeleteDrProtectionGroup(context.Background(), request)
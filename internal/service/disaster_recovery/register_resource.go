@@ -6,7 +6,7 @@ package disaster_recovery
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_disaster_recovery_dr_plan", DisasterRecoveryDrPlanResource())
-	tfresource.RegisterResource("oci_disaster_recovery_dr_plan_execution", DisasterRecoveryDrPlanExecutionResource())
-	tfresource.RegisterResource("oci_disaster_recovery_dr_protection_group", DisasterRecoveryDrProtectionGroupResource())
+	tfresource.RegisterWorkRequestResource("oci_disaster_recovery_dr_plan", DisasterRecoveryDrPlanResource())
+	tfresource.RegisterWorkRequestResource("oci_disaster_recovery_dr_plan_execution", DisasterRecoveryDrPlanExecutionResource())
+	tfresource.RegisterWorkRequestResource("oci_disaster_recovery_dr_protection_group", DisasterRecoveryDrProtectionGroupResource())
 }
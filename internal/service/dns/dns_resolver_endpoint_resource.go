@@ -254,6 +254,7 @@ func (s *DnsResolverEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ResolverEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -264,6 +265,8 @@ func (s *DnsResolverEndpointResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	response, err := s.Client.UpdateResolverEndpoint(context.Background(), request)
@@ -292,6 +295,8 @@ func (s *DnsResolverEndpointResourceCrud) Delete() error {
 		request.Scope = oci_dns.DeleteResolverEndpointScopeEnum(scope.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	_, err := s.Client.DeleteResolverEndpoint(context.Background(), request)
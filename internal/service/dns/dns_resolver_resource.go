@@ -439,6 +439,7 @@ func (s *DnsResolverResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Resolver
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -512,6 +513,8 @@ func (s *DnsResolverResourceCrud) Update() error {
 		request.Scope = oci_dns.UpdateResolverScopeEnum(scope.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	response, err := s.Client.UpdateResolver(context.Background(), request)
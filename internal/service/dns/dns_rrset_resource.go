@@ -338,6 +338,8 @@ func (s *DnsRrsetResourceCrud) Update() error {
 		request.ZoneNameOrId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	response, err := s.Client.UpdateRRSet(context.Background(), request)
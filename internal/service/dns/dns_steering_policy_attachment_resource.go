@@ -206,6 +206,8 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SteeringPolicyAttachmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	response, err := s.Client.UpdateSteeringPolicyAttachment(context.Background(), request)
@@ -223,6 +225,8 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SteeringPolicyAttachmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	_, err := s.Client.DeleteSteeringPolicyAttachment(context.Background(), request)
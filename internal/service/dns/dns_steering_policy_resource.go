@@ -480,6 +480,8 @@ func (s *DnsSteeringPolicyResourceCrud) Update() error {
 		request.Ttl = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	response, err := s.Client.UpdateSteeringPolicy(context.Background(), request)
@@ -503,6 +505,8 @@ func (s *DnsSteeringPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SteeringPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	_, err := s.Client.DeleteSteeringPolicy(context.Background(), request)
@@ -253,6 +253,8 @@ func (s *DnsTsigKeyResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.TsigKeyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	response, err := s.Client.UpdateTsigKey(context.Background(), request)
@@ -270,6 +272,8 @@ func (s *DnsTsigKeyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TsigKeyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	_, err := s.Client.DeleteTsigKey(context.Background(), request)
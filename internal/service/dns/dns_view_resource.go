@@ -241,6 +241,7 @@ func (s *DnsViewResourceCrud) Get() error {
 	}
 
 	s.Res = &response.View
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -280,6 +281,8 @@ func (s *DnsViewResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ViewId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	response, err := s.Client.UpdateView(context.Background(), request)
@@ -301,6 +304,8 @@ func (s *DnsViewResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ViewId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	_, err := s.Client.DeleteView(context.Background(), request)
@@ -490,6 +490,8 @@ func (s *DnsZoneResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ZoneNameOrId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	response, err := s.Client.UpdateZone(context.Background(), request)
@@ -521,6 +523,8 @@ func (s *DnsZoneResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ZoneNameOrId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
 	_, err := s.Client.DeleteZone(context.Background(), request)
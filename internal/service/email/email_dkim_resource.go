@@ -352,6 +352,7 @@ func (s *EmailDkimResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Dkim
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -378,6 +379,8 @@ func (s *EmailDkimResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	response, err := s.Client.UpdateDkim(context.Background(), request)
@@ -395,6 +398,8 @@ func (s *EmailDkimResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DkimId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	response, err := s.Client.DeleteDkim(context.Background(), request)
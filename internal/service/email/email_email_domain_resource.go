@@ -347,6 +347,7 @@ func (s *EmailEmailDomainResourceCrud) Get() error {
 	}
 
 	s.Res = &response.EmailDomain
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -387,6 +388,8 @@ func (s *EmailEmailDomainResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	response, err := s.Client.UpdateEmailDomain(context.Background(), request)
@@ -404,6 +407,8 @@ func (s *EmailEmailDomainResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.EmailDomainId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	response, err := s.Client.DeleteEmailDomain(context.Background(), request)
@@ -345,6 +345,7 @@ func (s *EmailEmailReturnPathResourceCrud) Get() error {
 	}
 
 	s.Res = &response.EmailReturnPath
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -371,6 +372,8 @@ func (s *EmailEmailReturnPathResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	response, err := s.Client.UpdateEmailReturnPath(context.Background(), request)
@@ -388,6 +391,8 @@ func (s *EmailEmailReturnPathResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.EmailReturnPathId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	response, err := s.Client.DeleteEmailReturnPath(context.Background(), request)
@@ -196,6 +196,7 @@ func (s *EmailSenderResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Sender
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -226,6 +227,8 @@ func (s *EmailSenderResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SenderId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	response, err := s.Client.UpdateSender(context.Background(), request)
@@ -243,6 +246,8 @@ func (s *EmailSenderResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SenderId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
 	_, err := s.Client.DeleteSender(context.Background(), request)
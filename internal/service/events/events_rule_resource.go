@@ -306,6 +306,7 @@ func (s *EventsRuleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Rule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -367,6 +368,8 @@ func (s *EventsRuleResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.RuleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
 	response, err := s.Client.UpdateRule(context.Background(), request)
@@ -384,6 +387,8 @@ func (s *EventsRuleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RuleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
 	_, err := s.Client.DeleteRule(context.Background(), request)
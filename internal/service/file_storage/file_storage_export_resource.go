@@ -261,6 +261,7 @@ func (s *FileStorageExportResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Export
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -292,6 +293,8 @@ func (s *FileStorageExportResourceCrud) Update() error {
 		request.IsIdmapGroupsForSysAuth = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateExport(context.Background(), request)
@@ -309,6 +312,8 @@ func (s *FileStorageExportResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExportId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	_, err := s.Client.DeleteExport(context.Background(), request)
@@ -162,6 +162,7 @@ func (s *FileStorageExportSetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExportSet
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -194,6 +195,8 @@ func (s *FileStorageExportSetResourceCrud) Update() error {
 		request.MaxFsStatFiles = &tmpInt64
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateExportSet(context.Background(), request)
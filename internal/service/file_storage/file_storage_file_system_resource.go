@@ -325,6 +325,7 @@ func (s *FileStorageFileSystemResourceCrud) Get() error {
 	}
 
 	s.Res = &response.FileSystem
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -370,6 +371,8 @@ func (s *FileStorageFileSystemResourceCrud) Update() error {
 		request.KmsKeyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateFileSystem(context.Background(), request)
@@ -392,6 +395,8 @@ func (s *FileStorageFileSystemResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FileSystemId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	_, err := s.Client.DeleteFileSystem(context.Background(), request)
@@ -337,6 +337,7 @@ func (s *FileStorageFilesystemSnapshotPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.FilesystemSnapshotPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -394,6 +395,8 @@ func (s *FileStorageFilesystemSnapshotPolicyResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateFilesystemSnapshotPolicy(context.Background(), request)
@@ -411,6 +414,8 @@ func (s *FileStorageFilesystemSnapshotPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FilesystemSnapshotPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	_, err := s.Client.DeleteFilesystemSnapshotPolicy(context.Background(), request)
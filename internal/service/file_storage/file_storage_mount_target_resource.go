@@ -429,6 +429,7 @@ func (s *FileStorageMountTargetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MountTarget
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -539,6 +540,8 @@ func (s *FileStorageMountTargetResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateMountTarget(context.Background(), request)
@@ -556,6 +559,8 @@ func (s *FileStorageMountTargetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MountTargetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	_, err := s.Client.DeleteMountTarget(context.Background(), request)
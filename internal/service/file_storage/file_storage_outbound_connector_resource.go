@@ -228,6 +228,7 @@ func (s *FileStorageOutboundConnectorResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OutboundConnector
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -263,6 +264,8 @@ func (s *FileStorageOutboundConnectorResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.OutboundConnectorId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateOutboundConnector(context.Background(), request)
@@ -280,6 +283,8 @@ func (s *FileStorageOutboundConnectorResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OutboundConnectorId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	_, err := s.Client.DeleteOutboundConnector(context.Background(), request)
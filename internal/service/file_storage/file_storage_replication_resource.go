@@ -248,6 +248,7 @@ func (s *FileStorageReplicationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Replication
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -292,6 +293,8 @@ func (s *FileStorageReplicationResourceCrud) Update() error {
 		request.ReplicationInterval = &tmpInt64
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateReplication(context.Background(), request)
@@ -313,6 +316,8 @@ func (s *FileStorageReplicationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ReplicationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	_, err := s.Client.DeleteReplication(context.Background(), request)
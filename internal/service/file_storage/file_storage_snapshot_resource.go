@@ -227,6 +227,7 @@ func (s *FileStorageSnapshotResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Snapshot
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -256,6 +257,8 @@ func (s *FileStorageSnapshotResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SnapshotId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	response, err := s.Client.UpdateSnapshot(context.Background(), request)
@@ -273,6 +276,8 @@ func (s *FileStorageSnapshotResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SnapshotId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
 	_, err := s.Client.DeleteSnapshot(context.Background(), request)
@@ -513,6 +513,7 @@ func (s *FleetSoftwareUpdateFsuCollectionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.FsuCollection
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -548,6 +549,8 @@ func (s *FleetSoftwareUpdateFsuCollectionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.FsuCollectionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fleet_software_update")
 
 	response, err := s.Client.UpdateFsuCollection(context.Background(), request)
@@ -565,6 +568,8 @@ func (s *FleetSoftwareUpdateFsuCollectionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FsuCollectionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fleet_software_update")
 
 	response, err := s.Client.DeleteFsuCollection(context.Background(), request)
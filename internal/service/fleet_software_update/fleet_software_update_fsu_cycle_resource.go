@@ -553,6 +553,7 @@ func (s *FleetSoftwareUpdateFsuCycleResourceCrud) Get() error {
 		return err
 	}
 	s.Res = &response.FsuCycle
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -573,6 +574,8 @@ func (s *FleetSoftwareUpdateFsuCycleResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fleet_software_update")
 
 	response, err := s.Client.UpdateFsuCycle(context.Background(), request)
@@ -590,6 +593,8 @@ func (s *FleetSoftwareUpdateFsuCycleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FsuCycleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fleet_software_update")
 	response, err := s.Client.DeleteFsuCycle(context.Background(), request)
 	if err != nil {
@@ -6,6 +6,6 @@ package fleet_software_update
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_fleet_software_update_fsu_collection", FleetSoftwareUpdateFsuCollectionResource())
-	tfresource.RegisterResource("oci_fleet_software_update_fsu_cycle", FleetSoftwareUpdateFsuCycleResource())
+	tfresource.RegisterWorkRequestResource("oci_fleet_software_update_fsu_collection", FleetSoftwareUpdateFsuCollectionResource())
+	tfresource.RegisterWorkRequestResource("oci_fleet_software_update_fsu_cycle", FleetSoftwareUpdateFsuCycleResource())
 }
@@ -351,6 +351,7 @@ func (s *FunctionsApplicationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Application
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -426,6 +427,8 @@ func (s *FunctionsApplicationResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
 	response, err := s.Client.UpdateApplication(context.Background(), request)
@@ -443,6 +446,8 @@ func (s *FunctionsApplicationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ApplicationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
 	_, err := s.Client.DeleteApplication(context.Background(), request)
@@ -450,6 +450,7 @@ func (s *FunctionsFunctionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Function
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -524,6 +525,8 @@ func (s *FunctionsFunctionResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
 	response, err := s.Client.UpdateFunction(context.Background(), request)
@@ -541,6 +544,8 @@ func (s *FunctionsFunctionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FunctionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
 	_, err := s.Client.DeleteFunction(context.Background(), request)
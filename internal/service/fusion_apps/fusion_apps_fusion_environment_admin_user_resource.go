@@ -336,6 +336,8 @@ func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) Delete() error {
 		request.FusionEnvironmentId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
 	response, err := s.Client.DeleteFusionEnvironmentAdminUser(context.Background(), request)
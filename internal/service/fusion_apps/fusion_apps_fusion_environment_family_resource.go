@@ -397,6 +397,7 @@ func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.FusionEnvironmentFamily
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -456,6 +457,8 @@ func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
 	response, err := s.Client.UpdateFusionEnvironmentFamily(context.Background(), request)
@@ -473,6 +476,8 @@ func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FusionEnvironmentFamilyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
 	response, err := s.Client.DeleteFusionEnvironmentFamily(context.Background(), request)
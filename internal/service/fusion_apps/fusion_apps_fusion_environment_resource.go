@@ -688,6 +688,7 @@ func (s *FusionAppsFusionEnvironmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.FusionEnvironment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -769,6 +770,8 @@ func (s *FusionAppsFusionEnvironmentResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
 	response, err := s.Client.UpdateFusionEnvironment(context.Background(), request)
@@ -786,6 +789,8 @@ func (s *FusionAppsFusionEnvironmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FusionEnvironmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
 	response, err := s.Client.DeleteFusionEnvironment(context.Background(), request)
@@ -364,6 +364,7 @@ func (s *FusionAppsFusionEnvironmentServiceAttachmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ServiceAttachment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -390,6 +391,8 @@ func (s *FusionAppsFusionEnvironmentServiceAttachmentResourceCrud) Delete() erro
 		log.Printf("[WARN] DELETE() unable to parse current ID: %s", s.D.Id())
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
 	response, err := s.Client.DeleteServiceAttachment(context.Background(), request)
@@ -388,6 +388,7 @@ func (s *GenerativeAiDedicatedAiClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DedicatedAiCluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -433,6 +434,8 @@ func (s *GenerativeAiDedicatedAiClusterResourceCrud) Update() error {
 		request.UnitCount = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generative_ai")
 
 	response, err := s.Client.UpdateDedicatedAiCluster(context.Background(), request)
@@ -450,6 +453,8 @@ func (s *GenerativeAiDedicatedAiClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DedicatedAiClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generative_ai")
 
 	response, err := s.Client.DeleteDedicatedAiCluster(context.Background(), request)
@@ -385,6 +385,7 @@ func (s *GenerativeAiEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Endpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -436,6 +437,8 @@ func (s *GenerativeAiEndpointResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generative_ai")
 
 	response, err := s.Client.UpdateEndpoint(context.Background(), request)
@@ -453,6 +456,8 @@ func (s *GenerativeAiEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.EndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generative_ai")
 
 	response, err := s.Client.DeleteEndpoint(context.Background(), request)
@@ -570,6 +570,7 @@ func (s *GenerativeAiModelResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Model
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -620,6 +621,8 @@ func (s *GenerativeAiModelResourceCrud) Update() error {
 		request.Version = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generative_ai")
 
 	response, err := s.Client.UpdateModel(context.Background(), request)
@@ -637,6 +640,8 @@ func (s *GenerativeAiModelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ModelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generative_ai")
 
 	response, err := s.Client.DeleteModel(context.Background(), request)
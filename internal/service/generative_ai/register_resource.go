@@ -6,7 +6,7 @@ package generative_ai
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_generative_ai_dedicated_ai_cluster", GenerativeAiDedicatedAiClusterResource())
-	tfresource.RegisterResource("oci_generative_ai_endpoint", GenerativeAiEndpointResource())
-	tfresource.RegisterResource("oci_generative_ai_model", GenerativeAiModelResource())
+	tfresource.RegisterWorkRequestResource("oci_generative_ai_dedicated_ai_cluster", GenerativeAiDedicatedAiClusterResource())
+	tfresource.RegisterWorkRequestResource("oci_generative_ai_endpoint", GenerativeAiEndpointResource())
+	tfresource.RegisterWorkRequestResource("oci_generative_ai_model", GenerativeAiModelResource())
 }
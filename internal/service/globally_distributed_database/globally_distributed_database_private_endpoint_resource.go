@@ -380,6 +380,7 @@ func (s *GloballyDistributedDatabasePrivateEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -434,6 +435,8 @@ func (s *GloballyDistributedDatabasePrivateEndpointResourceCrud) Update() error
 	tmp := s.D.Id()
 	request.PrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "globally_distributed_database")
 
 	response, err := s.Client.UpdatePrivateEndpoint(context.Background(), request)
@@ -451,6 +454,8 @@ func (s *GloballyDistributedDatabasePrivateEndpointResourceCrud) Delete() error
 	tmp := s.D.Id()
 	request.PrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "globally_distributed_database")
 
 	response, err := s.Client.DeletePrivateEndpoint(context.Background(), request)
@@ -1085,6 +1085,7 @@ func (s *GloballyDistributedDatabaseShardedDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ShardedDatabase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1120,6 +1121,8 @@ func (s *GloballyDistributedDatabaseShardedDatabaseResourceCrud) Update() error
 	tmp := s.D.Id()
 	request.ShardedDatabaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "globally_distributed_database")
 
 	response, err := s.Client.UpdateShardedDatabase(context.Background(), request)
@@ -1147,6 +1150,8 @@ func (s *GloballyDistributedDatabaseShardedDatabaseResourceCrud) Delete() error
 	tmp := s.D.Id()
 	request.ShardedDatabaseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "globally_distributed_database")
 
 	response, err := s.Client.DeleteShardedDatabase(context.Background(), request)
@@ -6,6 +6,6 @@ package globally_distributed_database
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_globally_distributed_database_private_endpoint", GloballyDistributedDatabasePrivateEndpointResource())
-	tfresource.RegisterResource("oci_globally_distributed_database_sharded_database", GloballyDistributedDatabaseShardedDatabaseResource())
+	tfresource.RegisterWorkRequestResource("oci_globally_distributed_database_private_endpoint", GloballyDistributedDatabasePrivateEndpointResource())
+	tfresource.RegisterWorkRequestResource("oci_globally_distributed_database_sharded_database", GloballyDistributedDatabaseShardedDatabaseResource())
 }
@@ -267,6 +267,7 @@ func (s *GoldenGateConnectionAssignmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ConnectionAssignment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -281,6 +282,8 @@ func (s *GoldenGateConnectionAssignmentResourceCrud) Delete() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
 	response, err := s.Client.DeleteConnectionAssignment(context.Background(), request)
@@ -795,6 +795,7 @@ func (s *GoldenGateConnectionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Connection
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -814,6 +815,8 @@ func (s *GoldenGateConnectionResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
 	response, err := s.Client.UpdateConnection(context.Background(), request)
@@ -836,6 +839,8 @@ func (s *GoldenGateConnectionResourceCrud) Delete() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
 	response, err := s.Client.DeleteConnection(context.Background(), request)
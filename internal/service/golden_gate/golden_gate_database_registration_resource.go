@@ -474,6 +474,7 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DatabaseRegistration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -548,6 +549,8 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Update() error {
 		request.Wallet = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
 	response, err := s.Client.UpdateDatabaseRegistration(context.Background(), request)
@@ -565,6 +568,8 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DatabaseRegistrationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
 	response, err := s.Client.DeleteDatabaseRegistration(context.Background(), request)
@@ -460,6 +460,7 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DeploymentBackup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -495,6 +496,8 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Update() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
 	response, err := s.Client.UpdateDeploymentBackup(context.Background(), request)
@@ -517,6 +520,8 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Delete() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
 	response, err := s.Client.DeleteDeploymentBackup(context.Background(), request)
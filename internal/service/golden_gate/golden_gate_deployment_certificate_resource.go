@@ -384,6 +384,7 @@ func (s *GoldenGateDeploymentCertificateResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Certificate
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -405,6 +406,8 @@ func (s *GoldenGateDeploymentCertificateResourceCrud) Delete() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
 	response, err := s.Client.DeleteCertificate(context.Background(), request)
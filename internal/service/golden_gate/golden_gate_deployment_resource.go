@@ -873,6 +873,7 @@ func (s *GoldenGateDeploymentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Deployment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -999,6 +1000,8 @@ func (s *GoldenGateDeploymentResourceCrud) Update() error {
 		request.SubnetId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
 	response, err := s.Client.UpdateDeployment(context.Background(), request)
@@ -1021,6 +1024,8 @@ func (s *GoldenGateDeploymentResourceCrud) Delete() error {
 		request.IsLockOverride = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
 	response, err := s.Client.DeleteDeployment(context.Background(), request)
@@ -278,6 +278,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Get() error {
 	}
 
 	s.Res = &response.HttpMonitor
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -376,6 +377,8 @@ func (s *HealthChecksHttpMonitorResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
 	response, err := s.Client.UpdateHttpMonitor(context.Background(), request)
@@ -393,6 +396,8 @@ func (s *HealthChecksHttpMonitorResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MonitorId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
 	_, err := s.Client.DeleteHttpMonitor(context.Background(), request)
@@ -249,6 +249,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PingMonitor
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -334,6 +335,8 @@ func (s *HealthChecksPingMonitorResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
 	response, err := s.Client.UpdatePingMonitor(context.Background(), request)
@@ -351,6 +354,8 @@ func (s *HealthChecksPingMonitorResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MonitorId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
 	_, err := s.Client.DeletePingMonitor(context.Background(), request)
@@ -208,6 +208,8 @@ func (s *IdentityApiKeyResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteApiKey(context.Background(), request)
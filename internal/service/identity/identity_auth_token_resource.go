@@ -216,6 +216,8 @@ func (s *IdentityAuthTokenResourceCrud) Update() error {
 		request.UserId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateAuthToken(context.Background(), request)
@@ -238,6 +240,8 @@ func (s *IdentityAuthTokenResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteAuthToken(context.Background(), request)
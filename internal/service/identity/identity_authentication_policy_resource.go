@@ -217,6 +217,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AuthenticationPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -250,6 +251,8 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateAuthenticationPolicy(context.Background(), request)
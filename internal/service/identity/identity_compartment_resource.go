@@ -277,6 +277,7 @@ func (s *IdentityCompartmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Compartment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -317,6 +318,8 @@ func (s *IdentityCompartmentResourceCrud) Update() error {
 		request.Name = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateCompartment(context.Background(), request)
@@ -334,6 +337,8 @@ func (s *IdentityCompartmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CompartmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteCompartment(context.Background(), request)
@@ -216,6 +216,8 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Update() error {
 		request.UserId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateCustomerSecretKey(context.Background(), request)
@@ -238,6 +240,8 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteCustomerSecretKey(context.Background(), request)
@@ -211,6 +211,8 @@ func (s *IdentityDbCredentialResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteDbCredential(context.Background(), request)
@@ -522,6 +522,7 @@ func (s *IdentityDomainResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Domain
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -578,6 +579,8 @@ func (s *IdentityDomainResourceCrud) Update() error {
 		request.IsHiddenOnLogin = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateDomain(context.Background(), request)
@@ -595,6 +598,8 @@ func (s *IdentityDomainResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DomainId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.DeleteDomain(context.Background(), request)
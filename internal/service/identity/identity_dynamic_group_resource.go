@@ -206,6 +206,7 @@ func (s *IdentityDynamicGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DynamicGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -237,6 +238,8 @@ func (s *IdentityDynamicGroupResourceCrud) Update() error {
 		request.MatchingRule = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateDynamicGroup(context.Background(), request)
@@ -254,6 +257,8 @@ func (s *IdentityDynamicGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DynamicGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteDynamicGroup(context.Background(), request)
@@ -222,6 +222,7 @@ func (s *IdentityGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Group
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -248,6 +249,8 @@ func (s *IdentityGroupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.GroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateGroup(context.Background(), request)
@@ -265,6 +268,8 @@ func (s *IdentityGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.GroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteGroup(context.Background(), request)
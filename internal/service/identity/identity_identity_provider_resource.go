@@ -214,6 +214,7 @@ func (s *IdentityIdentityProviderResourceCrud) Get() error {
 	}
 
 	s.Res = &response.IdentityProvider
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -224,6 +225,8 @@ func (s *IdentityIdentityProviderResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateIdentityProvider(context.Background(), request)
@@ -241,6 +244,8 @@ func (s *IdentityIdentityProviderResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IdentityProviderId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteIdentityProvider(context.Background(), request)
@@ -193,6 +193,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Get() error {
 	}
 
 	s.Res = &response.IdpGroupMapping
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -217,6 +218,8 @@ func (s *IdentityIdpGroupMappingResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.MappingId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateIdpGroupMapping(context.Background(), request)
@@ -239,6 +242,8 @@ func (s *IdentityIdpGroupMappingResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MappingId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteIdpGroupMapping(context.Background(), request)
@@ -279,6 +279,7 @@ func (s *IdentityNetworkSourceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NetworkSources
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -348,6 +349,8 @@ func (s *IdentityNetworkSourceResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateNetworkSource(context.Background(), request)
@@ -365,6 +368,8 @@ func (s *IdentityNetworkSourceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NetworkSourceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteNetworkSource(context.Background(), request)
@@ -267,6 +267,7 @@ func (s *IdentityPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Policy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 
 	// Update etag on a successful get
 	s.D.Set("ETag", response.Etag)
@@ -319,6 +320,8 @@ func (s *IdentityPolicyResourceCrud) Update() error {
 		request.VersionDate = tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdatePolicy(context.Background(), request)
@@ -343,6 +346,8 @@ func (s *IdentityPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeletePolicy(context.Background(), request)
@@ -231,6 +231,8 @@ func (s *IdentitySmtpCredentialResourceCrud) Update() error {
 		request.UserId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateSmtpCredential(context.Background(), request)
@@ -253,6 +255,8 @@ func (s *IdentitySmtpCredentialResourceCrud) Delete() error {
 		request.UserId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteSmtpCredential(context.Background(), request)
@@ -190,6 +190,7 @@ func (s *IdentityTagDefaultResourceCrud) Get() error {
 	}
 
 	s.Res = &response.TagDefault
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -209,6 +210,8 @@ func (s *IdentityTagDefaultResourceCrud) Update() error {
 		request.Value = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateTagDefault(context.Background(), request)
@@ -234,6 +237,8 @@ func (s *IdentityTagDefaultResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TagDefaultId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteTagDefault(context.Background(), request)
@@ -299,6 +299,8 @@ func (s *IdentityTagNamespaceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.TagNamespaceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteTagNamespace(context.Background(), request)
@@ -317,6 +317,7 @@ func (s *IdentityTagResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Tag
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	if parseTagCompositeIdErr == nil {
 		// Import sets the ID to composite ID and hence overwriting ID to OCID from response
 		id := response.Tag.Id
@@ -395,6 +396,8 @@ func (s *IdentityTagResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateTag(context.Background(), request)
@@ -430,6 +433,8 @@ func (s *IdentityTagResourceCrud) Delete() error {
 		request.TagNamespaceId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 	response, err := s.Client.DeleteTag(context.Background(), request)
 
@@ -299,6 +299,7 @@ func (s *IdentityUserResourceCrud) Get() error {
 	}
 
 	s.Res = &response.User
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -330,6 +331,8 @@ func (s *IdentityUserResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.UserId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	response, err := s.Client.UpdateUser(context.Background(), request)
@@ -347,6 +350,8 @@ func (s *IdentityUserResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.UserId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
 	_, err := s.Client.DeleteUser(context.Background(), request)
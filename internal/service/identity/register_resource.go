@@ -12,13 +12,13 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_identity_compartment", IdentityCompartmentResource())
 	tfresource.RegisterResource("oci_identity_customer_secret_key", IdentityCustomerSecretKeyResource())
 	tfresource.RegisterResource("oci_identity_db_credential", IdentityDbCredentialResource())
-	tfresource.RegisterResource("oci_identity_domain", IdentityDomainResource())
-	tfresource.RegisterResource("oci_identity_domain_replication_to_region", IdentityDomainReplicationToRegionResource())
+	tfresource.RegisterWorkRequestResource("oci_identity_domain", IdentityDomainResource())
+	tfresource.RegisterWorkRequestResource("oci_identity_domain_replication_to_region", IdentityDomainReplicationToRegionResource())
 	tfresource.RegisterResource("oci_identity_dynamic_group", IdentityDynamicGroupResource())
 	tfresource.RegisterResource("oci_identity_group", IdentityGroupResource())
 	tfresource.RegisterResource("oci_identity_identity_provider", IdentityIdentityProviderResource())
 	tfresource.RegisterResource("oci_identity_idp_group_mapping", IdentityIdpGroupMappingResource())
-	tfresource.RegisterResource("oci_identity_import_standard_tags_management", IdentityImportStandardTagsManagementResource())
+	tfresource.RegisterWorkRequestResource("oci_identity_import_standard_tags_management", IdentityImportStandardTagsManagementResource())
 	tfresource.RegisterResource("oci_identity_network_source", IdentityNetworkSourceResource())
 	tfresource.RegisterResource("oci_identity_policy", IdentityPolicyResource())
 	tfresource.RegisterResource("oci_identity_smtp_credential", IdentitySmtpCredentialResource())
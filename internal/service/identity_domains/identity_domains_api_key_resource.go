@@ -583,6 +583,8 @@ func (s *IdentityDomainsApiKeyResourceCrud) Delete() error {
 		request.ResourceTypeSchemaVersion = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteApiKey(context.Background(), request)
@@ -4422,6 +4422,8 @@ func (s *IdentityDomainsAppResourceCrud) Delete() error {
 		request.ResourceTypeSchemaVersion = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteApp(context.Background(), request)
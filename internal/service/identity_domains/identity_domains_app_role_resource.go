@@ -654,6 +654,8 @@ func (s *IdentityDomainsAppRoleResourceCrud) Delete() error {
 		request.ResourceTypeSchemaVersion = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteAppRole(context.Background(), request)
@@ -585,6 +585,8 @@ func (s *IdentityDomainsApprovalWorkflowAssignmentResourceCrud) Delete() error {
 		request.ResourceTypeSchemaVersion = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteApprovalWorkflowAssignment(context.Background(), request)
@@ -695,6 +695,8 @@ func (s *IdentityDomainsApprovalWorkflowResourceCrud) Delete() error {
 		request.ResourceTypeSchemaVersion = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteApprovalWorkflow(context.Background(), request)
@@ -570,6 +570,8 @@ func (s *IdentityDomainsMyOAuth2ClientCredentialResourceCrud) Delete() error {
 		request.ResourceTypeSchemaVersion = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteMyOAuth2ClientCredential(context.Background(), request)
@@ -807,6 +807,8 @@ func (s *IdentityDomainsRuleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RuleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteRule(context.Background(), request)
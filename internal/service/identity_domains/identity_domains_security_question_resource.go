@@ -551,6 +551,8 @@ func (s *IdentityDomainsSecurityQuestionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SecurityQuestionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteSecurityQuestion(context.Background(), request)
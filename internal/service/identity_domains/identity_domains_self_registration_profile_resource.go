@@ -1247,6 +1247,8 @@ func (s *IdentityDomainsSelfRegistrationProfileResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SelfRegistrationProfileId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteSelfRegistrationProfile(context.Background(), request)
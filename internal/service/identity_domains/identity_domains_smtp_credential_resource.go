@@ -595,6 +595,8 @@ func (s *IdentityDomainsSmtpCredentialResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SmtpCredentialId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteSmtpCredential(context.Background(), request)
@@ -992,6 +992,8 @@ func (s *IdentityDomainsSocialIdentityProviderResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SocialIdentityProviderId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteSocialIdentityProvider(context.Background(), request)
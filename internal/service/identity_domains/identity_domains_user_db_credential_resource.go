@@ -625,6 +625,8 @@ func (s *IdentityDomainsUserDbCredentialResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.UserDbCredentialId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteUserDbCredential(context.Background(), request)
@@ -3500,6 +3500,8 @@ func (s *IdentityDomainsUserResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.UserId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_domains")
 
 	_, err := s.Client.DeleteUser(context.Background(), request)
@@ -826,6 +826,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.IntegrationInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -914,6 +915,8 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Update() error {
 		request.MessagePacks = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
 	response, err := s.Client.UpdateIntegrationInstance(context.Background(), request)
@@ -931,6 +934,8 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IntegrationInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
 	response, err := s.Client.DeleteIntegrationInstance(context.Background(), request)
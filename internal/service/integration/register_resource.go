@@ -6,6 +6,6 @@ package integration
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_integration_integration_instance", IntegrationIntegrationInstanceResource())
+	tfresource.RegisterWorkRequestResource("oci_integration_integration_instance", IntegrationIntegrationInstanceResource())
 	tfresource.RegisterResource("oci_integration_private_endpoint_outbound_connection", IntegrationPrivateEndpointOutboundConnectionResource())
 }
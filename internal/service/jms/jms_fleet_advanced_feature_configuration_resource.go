@@ -553,6 +553,7 @@ func (s *JmsFleetAdvancedFeatureConfigurationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.FleetAdvancedFeatureConfiguration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -640,6 +641,8 @@ func (s *JmsFleetAdvancedFeatureConfigurationResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
 
 	response, err := s.Client.UpdateFleetAdvancedFeatureConfiguration(context.Background(), request)
@@ -425,6 +425,7 @@ func (s *JmsFleetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Fleet
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -492,6 +493,8 @@ func (s *JmsFleetResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
 
 	response, err := s.Client.UpdateFleet(context.Background(), request)
@@ -509,6 +512,8 @@ func (s *JmsFleetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.FleetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
 
 	_, err := s.Client.DeleteFleet(context.Background(), request)
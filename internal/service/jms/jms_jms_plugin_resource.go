@@ -227,6 +227,7 @@ func (s *JmsJmsPluginResourceCrud) Get() error {
 	}
 
 	s.Res = &response.JmsPlugin
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -253,6 +254,8 @@ func (s *JmsJmsPluginResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.JmsPluginId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
 
 	response, err := s.Client.UpdateJmsPlugin(context.Background(), request)
@@ -270,6 +273,8 @@ func (s *JmsJmsPluginResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.JmsPluginId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms")
 
 	_, err := s.Client.DeleteJmsPlugin(context.Background(), request)
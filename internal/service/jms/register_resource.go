@@ -6,7 +6,7 @@ package jms
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_jms_fleet", JmsFleetResource())
+	tfresource.RegisterWorkRequestResource("oci_jms_fleet", JmsFleetResource())
 	tfresource.RegisterResource("oci_jms_fleet_advanced_feature_configuration", JmsFleetAdvancedFeatureConfigurationResource())
 	tfresource.RegisterResource("oci_jms_jms_plugin", JmsJmsPluginResource())
 }
@@ -395,6 +395,7 @@ func (s *JmsJavaDownloadsJavaDownloadReportResourceCrud) Get() error {
 	}
 
 	s.Res = &response.JavaDownloadReport
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -404,6 +405,8 @@ func (s *JmsJavaDownloadsJavaDownloadReportResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.JavaDownloadReportId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms_java_downloads")
 
 	response, err := s.Client.DeleteJavaDownloadReport(context.Background(), request)
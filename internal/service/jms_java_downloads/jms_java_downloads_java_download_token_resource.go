@@ -453,6 +453,7 @@ func (s *JmsJavaDownloadsJavaDownloadTokenResourceCrud) Get() error {
 	}
 
 	s.Res = &response.JavaDownloadToken
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -513,6 +514,8 @@ func (s *JmsJavaDownloadsJavaDownloadTokenResourceCrud) Update() error {
 		request.TimeExpires = &oci_common.SDKTime{Time: tmp}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms_java_downloads")
 
 	response, err := s.Client.UpdateJavaDownloadToken(context.Background(), request)
@@ -530,6 +533,8 @@ func (s *JmsJavaDownloadsJavaDownloadTokenResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.JavaDownloadTokenId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms_java_downloads")
 
 	response, err := s.Client.DeleteJavaDownloadToken(context.Background(), request)
@@ -242,6 +242,7 @@ func (s *JmsJavaDownloadsJavaLicenseAcceptanceRecordResourceCrud) Get() error {
 	}
 
 	s.Res = &response.JavaLicenseAcceptanceRecord
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -263,6 +264,8 @@ func (s *JmsJavaDownloadsJavaLicenseAcceptanceRecordResourceCrud) Update() error
 		request.LicenseAcceptanceStatus = oci_jms_java_downloads.LicenseAcceptanceStatusEnum(licenseAcceptanceStatus.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms_java_downloads")
 
 	response, err := s.Client.UpdateJavaLicenseAcceptanceRecord(context.Background(), request)
@@ -280,6 +283,8 @@ func (s *JmsJavaDownloadsJavaLicenseAcceptanceRecordResourceCrud) Delete() error
 	tmp := s.D.Id()
 	request.JavaLicenseAcceptanceRecordId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "jms_java_downloads")
 
 	_, err := s.Client.DeleteJavaLicenseAcceptanceRecord(context.Background(), request)
@@ -6,7 +6,7 @@ package jms_java_downloads
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_jms_java_downloads_java_download_report", JmsJavaDownloadsJavaDownloadReportResource())
-	tfresource.RegisterResource("oci_jms_java_downloads_java_download_token", JmsJavaDownloadsJavaDownloadTokenResource())
+	tfresource.RegisterWorkRequestResource("oci_jms_java_downloads_java_download_report", JmsJavaDownloadsJavaDownloadReportResource())
+	tfresource.RegisterWorkRequestResource("oci_jms_java_downloads_java_download_token", JmsJavaDownloadsJavaDownloadTokenResource())
 	tfresource.RegisterResource("oci_jms_java_downloads_java_license_acceptance_record", JmsJavaDownloadsJavaLicenseAcceptanceRecordResource())
 }
@@ -235,6 +235,7 @@ func (s *KmsEkmsPrivateEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.EkmsPrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -261,6 +262,8 @@ func (s *KmsEkmsPrivateEndpointResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	response, err := s.Client.UpdateEkmsPrivateEndpoint(context.Background(), request)
@@ -278,6 +281,8 @@ func (s *KmsEkmsPrivateEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.EkmsPrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
 	_, err := s.Client.DeleteEkmsPrivateEndpoint(context.Background(), request)
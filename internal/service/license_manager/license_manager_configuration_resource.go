@@ -144,6 +144,7 @@ func (s *LicenseManagerConfigurationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Configuration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -168,6 +169,8 @@ func (s *LicenseManagerConfigurationResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
 	response, err := s.Client.UpdateConfiguration(context.Background(), request)
@@ -267,6 +267,7 @@ func (s *LicenseManagerLicenseRecordResourceCrud) Get() error {
 	}
 
 	s.Res = &response.LicenseRecord
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -329,6 +330,8 @@ func (s *LicenseManagerLicenseRecordResourceCrud) Update() error {
 		request.SupportEndDate = &oci_common.SDKTime{Time: tmp}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
 	response, err := s.Client.UpdateLicenseRecord(context.Background(), request)
@@ -346,6 +349,8 @@ func (s *LicenseManagerLicenseRecordResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LicenseRecordId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
 	_, err := s.Client.DeleteLicenseRecord(context.Background(), request)
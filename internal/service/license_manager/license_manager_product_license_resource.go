@@ -302,6 +302,7 @@ func (s *LicenseManagerProductLicenseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ProductLicense
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -340,6 +341,8 @@ func (s *LicenseManagerProductLicenseResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ProductLicenseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
 	response, err := s.Client.UpdateProductLicense(context.Background(), request)
@@ -357,6 +360,8 @@ func (s *LicenseManagerProductLicenseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ProductLicenseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
 	_, err := s.Client.DeleteProductLicense(context.Background(), request)
@@ -265,6 +265,7 @@ func (s *LimitsQuotaResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Quota
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -312,6 +313,8 @@ func (s *LimitsQuotaResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
 
 	response, err := s.Client.UpdateQuota(context.Background(), request)
@@ -337,6 +340,8 @@ func (s *LimitsQuotaResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.QuotaId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
 
 	_, err := s.Client.DeleteQuota(context.Background(), request)
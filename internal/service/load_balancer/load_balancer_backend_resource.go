@@ -349,6 +349,8 @@ func (s *LoadBalancerBackendResourceCrud) Update() error {
 		request.Weight = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdateBackend(context.Background(), request)
@@ -391,6 +393,8 @@ func (s *LoadBalancerBackendResourceCrud) Delete() error {
 		request.LoadBalancerId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteBackend(context.Background(), request)
@@ -646,6 +646,8 @@ func (s *LoadBalancerBackendSetResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdateBackendSet(context.Background(), request)
@@ -683,6 +685,8 @@ func (s *LoadBalancerBackendSetResourceCrud) Delete() error {
 		request.LoadBalancerId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteBackendSet(context.Background(), request)
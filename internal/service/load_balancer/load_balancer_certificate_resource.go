@@ -268,6 +268,8 @@ func (s *LoadBalancerCertificateResourceCrud) Delete() error {
 		request.LoadBalancerId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteCertificate(context.Background(), request)
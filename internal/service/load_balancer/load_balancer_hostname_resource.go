@@ -241,6 +241,8 @@ func (s *LoadBalancerHostnameResourceCrud) Update() error {
 		request.Name = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdateHostname(context.Background(), request)
@@ -278,6 +280,8 @@ func (s *LoadBalancerHostnameResourceCrud) Delete() error {
 		request.Name = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteHostname(context.Background(), request)
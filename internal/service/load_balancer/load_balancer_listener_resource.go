@@ -507,6 +507,8 @@ func (s *LoadBalancerListenerResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdateListener(context.Background(), request)
@@ -544,6 +546,8 @@ func (s *LoadBalancerListenerResourceCrud) Delete() error {
 		request.LoadBalancerId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteListener(context.Background(), request)
@@ -533,6 +533,8 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdateLoadBalancer(context.Background(), request)
@@ -563,6 +565,8 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LoadBalancerId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteLoadBalancer(context.Background(), request)
@@ -301,6 +301,8 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdatePathRouteSet(context.Background(), request)
@@ -338,6 +340,8 @@ func (s *LoadBalancerPathRouteSetResourceCrud) Delete() error {
 		request.PathRouteSetName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeletePathRouteSet(context.Background(), request)
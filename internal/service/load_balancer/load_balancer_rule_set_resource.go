@@ -460,6 +460,8 @@ func (s *LoadBalancerRuleSetResourceCrud) Update() error {
 		request.RuleSetName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.UpdateRuleSet(context.Background(), request)
@@ -497,6 +499,8 @@ func (s *LoadBalancerRuleSetResourceCrud) Delete() error {
 		request.RuleSetName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteRuleSet(context.Background(), request)
@@ -239,6 +239,8 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Delete() error {
 		request.Name = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
 	response, err := s.Client.DeleteSSLCipherSuite(context.Background(), request)
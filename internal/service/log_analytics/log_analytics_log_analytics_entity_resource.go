@@ -376,6 +376,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Get() error {
 	}
 
 	s.Res = &response.LogAnalyticsEntity
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -454,6 +455,8 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Update() error {
 		request.TimezoneRegion = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
 	response, err := s.Client.UpdateLogAnalyticsEntity(context.Background(), request)
@@ -476,6 +479,8 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
 	_, err := s.Client.DeleteLogAnalyticsEntity(context.Background(), request)
@@ -195,6 +195,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.LogAnalyticsLogGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -240,6 +241,8 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Update() error {
 		request.NamespaceName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
 	response, err := s.Client.UpdateLogAnalyticsLogGroup(context.Background(), request)
@@ -262,6 +265,8 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
 	_, err := s.Client.DeleteLogAnalyticsLogGroup(context.Background(), request)
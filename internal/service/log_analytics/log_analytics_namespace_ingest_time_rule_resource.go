@@ -371,6 +371,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.IngestTimeRule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -463,6 +464,8 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Update() error {
 		request.UpdateIngestTimeRuleDetails.LifecycleState = oci_log_analytics.ConfigLifecycleStateEnum(state.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
 	response, err := s.Client.UpdateIngestTimeRule(context.Background(), request)
@@ -487,6 +490,8 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
 	_, err := s.Client.DeleteIngestTimeRule(context.Background(), request)
@@ -391,6 +391,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ScheduledTask
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -459,6 +460,8 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Update() error {
 	}
 
 	request.UpdateScheduledTaskDetails = details
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
 	response, err := s.Client.UpdateScheduledTask(context.Background(), request)
@@ -481,6 +484,8 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Delete() error {
 		log.Printf("[WARN] Delete() unable to parse current ID: %s", s.D.Id())
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
 	_, err = s.Client.DeleteScheduledTask(context.Background(), request)
@@ -344,6 +344,7 @@ func (s *LoggingLogGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.LogGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -384,6 +385,8 @@ func (s *LoggingLogGroupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.LogGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
 	response, err := s.Client.UpdateLogGroup(context.Background(), request)
@@ -401,6 +404,8 @@ func (s *LoggingLogGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LogGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
 	_, err := s.Client.DeleteLogGroup(context.Background(), request)
@@ -476,6 +476,7 @@ func (s *LoggingLogResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Log
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -528,6 +529,8 @@ func (s *LoggingLogResourceCrud) Update() error {
 		request.RetentionDuration = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
 	response, err := s.Client.UpdateLog(context.Background(), request)
@@ -550,6 +553,8 @@ func (s *LoggingLogResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LogId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
 	response, err := s.Client.DeleteLog(context.Background(), request)
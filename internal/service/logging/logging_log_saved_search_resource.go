@@ -214,6 +214,7 @@ func (s *LoggingLogSavedSearchResourceCrud) Get() error {
 	}
 
 	s.Res = &response.LogSavedSearch
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -259,6 +260,8 @@ func (s *LoggingLogSavedSearchResourceCrud) Update() error {
 		request.Query = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
 	response, err := s.Client.UpdateLogSavedSearch(context.Background(), request)
@@ -276,6 +279,8 @@ func (s *LoggingLogSavedSearchResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LogSavedSearchId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
 	_, err := s.Client.DeleteLogSavedSearch(context.Background(), request)
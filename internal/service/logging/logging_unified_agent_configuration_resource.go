@@ -1882,6 +1882,7 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.UnifiedAgentConfiguration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1949,6 +1950,8 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.UnifiedAgentConfigurationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
 	response, err := s.Client.UpdateUnifiedAgentConfiguration(context.Background(), request)
@@ -1966,6 +1969,8 @@ func (s *LoggingUnifiedAgentConfigurationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.UnifiedAgentConfigurationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
 	response, err := s.Client.DeleteUnifiedAgentConfiguration(context.Background(), request)
@@ -411,6 +411,7 @@ func (s *ManagementAgentManagementAgentDataSourceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DataSource
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -421,6 +422,8 @@ func (s *ManagementAgentManagementAgentDataSourceResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
 	response, err := s.Client.UpdateDataSource(context.Background(), request)
@@ -443,6 +446,8 @@ func (s *ManagementAgentManagementAgentDataSourceResourceCrud) Delete() error {
 		request.ManagementAgentId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
 	response, err := s.Client.DeleteDataSource(context.Background(), request)
@@ -217,6 +217,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ManagementAgentInstallKey
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -236,6 +237,8 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ManagementAgentInstallKeyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
 	response, err := s.Client.UpdateManagementAgentInstallKey(context.Background(), request)
@@ -253,6 +256,8 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagementAgentInstallKeyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
 	_, err := s.Client.DeleteManagementAgentInstallKey(context.Background(), request)
@@ -606,6 +606,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ManagementAgent
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -637,6 +638,8 @@ func (s *ManagementAgentManagementAgentResourceCrud) Update() error {
 	agentId := s.D.Id()
 	request.ManagementAgentId = &agentId
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
 	response, err := s.Client.UpdateManagementAgent(context.Background(), request)
@@ -683,6 +686,8 @@ func (s *ManagementAgentManagementAgentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagementAgentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
 	_, err := s.Client.DeleteManagementAgent(context.Background(), request)
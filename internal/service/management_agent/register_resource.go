@@ -7,6 +7,6 @@ import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
 	tfresource.RegisterResource("oci_management_agent_management_agent", ManagementAgentManagementAgentResource())
-	tfresource.RegisterResource("oci_management_agent_management_agent_data_source", ManagementAgentManagementAgentDataSourceResource())
+	tfresource.RegisterWorkRequestResource("oci_management_agent_management_agent_data_source", ManagementAgentManagementAgentDataSourceResource())
 	tfresource.RegisterResource("oci_management_agent_management_agent_install_key", ManagementAgentManagementAgentInstallKeyResource())
 }
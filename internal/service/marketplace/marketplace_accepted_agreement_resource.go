@@ -195,6 +195,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AcceptedAgreement
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -221,6 +222,8 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
 	response, err := s.Client.UpdateAcceptedAgreement(context.Background(), request)
@@ -243,6 +246,8 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Delete() error {
 		request.Signature = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
 	_, err := s.Client.DeleteAcceptedAgreement(context.Background(), request)
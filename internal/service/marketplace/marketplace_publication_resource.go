@@ -435,6 +435,7 @@ func (s *MarketplacePublicationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Publication
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -498,6 +499,8 @@ func (s *MarketplacePublicationResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
 	response, err := s.Client.UpdatePublication(context.Background(), request)
@@ -515,6 +518,8 @@ func (s *MarketplacePublicationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PublicationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
 	_, err := s.Client.DeletePublication(context.Background(), request)
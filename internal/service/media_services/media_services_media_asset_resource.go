@@ -480,6 +480,7 @@ func (s *MediaServicesMediaAssetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MediaAsset
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -568,6 +569,8 @@ func (s *MediaServicesMediaAssetResourceCrud) Update() error {
 		request.Type = oci_media_services.AssetTypeEnum(type_.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	response, err := s.Client.UpdateMediaAsset(context.Background(), request)
@@ -594,6 +597,8 @@ func (s *MediaServicesMediaAssetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MediaAssetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	_, err := s.Client.DeleteMediaAsset(context.Background(), request)
@@ -271,6 +271,7 @@ func (s *MediaServicesMediaWorkflowConfigurationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MediaWorkflowConfiguration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -318,6 +319,8 @@ func (s *MediaServicesMediaWorkflowConfigurationResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	response, err := s.Client.UpdateMediaWorkflowConfiguration(context.Background(), request)
@@ -340,6 +343,8 @@ func (s *MediaServicesMediaWorkflowConfigurationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MediaWorkflowConfigurationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	_, err := s.Client.DeleteMediaWorkflowConfiguration(context.Background(), request)
@@ -343,6 +343,7 @@ func (s *MediaServicesMediaWorkflowJobResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MediaWorkflowJob
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -383,6 +384,8 @@ func (s *MediaServicesMediaWorkflowJobResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.MediaWorkflowJobId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	response, err := s.Client.UpdateMediaWorkflowJob(context.Background(), request)
@@ -405,6 +408,8 @@ func (s *MediaServicesMediaWorkflowJobResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MediaWorkflowJobId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 	request.RequestMetadata.RetryPolicy.MaximumNumberAttempts = 1
 
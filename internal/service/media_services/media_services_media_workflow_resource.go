@@ -369,6 +369,7 @@ func (s *MediaServicesMediaWorkflowResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MediaWorkflow
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -446,6 +447,8 @@ func (s *MediaServicesMediaWorkflowResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	response, err := s.Client.UpdateMediaWorkflow(context.Background(), request)
@@ -468,6 +471,8 @@ func (s *MediaServicesMediaWorkflowResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MediaWorkflowId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	_, err := s.Client.DeleteMediaWorkflow(context.Background(), request)
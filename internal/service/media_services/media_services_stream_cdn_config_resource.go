@@ -368,6 +368,7 @@ func (s *MediaServicesStreamCdnConfigResourceCrud) Get() error {
 	}
 
 	s.Res = &response.StreamCdnConfig
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -415,6 +416,8 @@ func (s *MediaServicesStreamCdnConfigResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.StreamCdnConfigId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	response, err := s.Client.UpdateStreamCdnConfig(context.Background(), request)
@@ -437,6 +440,8 @@ func (s *MediaServicesStreamCdnConfigResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.StreamCdnConfigId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	_, err := s.Client.DeleteStreamCdnConfig(context.Background(), request)
@@ -259,6 +259,7 @@ func (s *MediaServicesStreamDistributionChannelResourceCrud) Get() error {
 	}
 
 	s.Res = &response.StreamDistributionChannel
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -299,6 +300,8 @@ func (s *MediaServicesStreamDistributionChannelResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.StreamDistributionChannelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	response, err := s.Client.UpdateStreamDistributionChannel(context.Background(), request)
@@ -321,6 +324,8 @@ func (s *MediaServicesStreamDistributionChannelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.StreamDistributionChannelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	_, err := s.Client.DeleteStreamDistributionChannel(context.Background(), request)
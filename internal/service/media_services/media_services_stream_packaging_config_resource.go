@@ -330,6 +330,7 @@ func (s *MediaServicesStreamPackagingConfigResourceCrud) Get() error {
 	}
 
 	s.Res = &response.StreamPackagingConfig
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -361,6 +362,8 @@ func (s *MediaServicesStreamPackagingConfigResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.StreamPackagingConfigId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	response, err := s.Client.UpdateStreamPackagingConfig(context.Background(), request)
@@ -383,6 +386,8 @@ func (s *MediaServicesStreamPackagingConfigResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.StreamPackagingConfigId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "media_services")
 
 	_, err := s.Client.DeleteStreamPackagingConfig(context.Background(), request)
@@ -212,6 +212,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CustomTable
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -232,6 +233,8 @@ func (s *MeteringComputationCustomTableResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
 	response, err := s.Client.UpdateCustomTable(context.Background(), request)
@@ -249,6 +252,8 @@ func (s *MeteringComputationCustomTableResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CustomTableId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
 	_, err := s.Client.DeleteCustomTable(context.Background(), request)
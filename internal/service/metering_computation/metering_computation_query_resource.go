@@ -308,6 +308,7 @@ func (s *MeteringComputationQueryResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Query
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -328,6 +329,8 @@ func (s *MeteringComputationQueryResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.QueryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
 	response, err := s.Client.UpdateQuery(context.Background(), request)
@@ -345,6 +348,8 @@ func (s *MeteringComputationQueryResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.QueryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
 	_, err := s.Client.DeleteQuery(context.Background(), request)
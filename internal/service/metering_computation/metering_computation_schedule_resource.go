@@ -438,6 +438,7 @@ func (s *MeteringComputationScheduleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Schedule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -479,6 +480,8 @@ func (s *MeteringComputationScheduleResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ScheduleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
 	response, err := s.Client.UpdateSchedule(context.Background(), request)
@@ -496,6 +499,8 @@ func (s *MeteringComputationScheduleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ScheduleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
 	_, err := s.Client.DeleteSchedule(context.Background(), request)
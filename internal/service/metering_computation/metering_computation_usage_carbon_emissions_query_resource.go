@@ -267,6 +267,7 @@ func (s *MeteringComputationUsageCarbonEmissionsQueryResourceCrud) Get() error {
 	}
 
 	s.Res = &response.UsageCarbonEmissionsQuery
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -287,6 +288,8 @@ func (s *MeteringComputationUsageCarbonEmissionsQueryResourceCrud) Update() erro
 	tmp := s.D.Id()
 	request.UsageCarbonEmissionsQueryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
 	response, err := s.Client.UpdateUsageCarbonEmissionsQuery(context.Background(), request)
@@ -304,6 +307,8 @@ func (s *MeteringComputationUsageCarbonEmissionsQueryResourceCrud) Delete() erro
 	tmp := s.D.Id()
 	request.UsageCarbonEmissionsQueryId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
 	_, err := s.Client.DeleteUsageCarbonEmissionsQuery(context.Background(), request)
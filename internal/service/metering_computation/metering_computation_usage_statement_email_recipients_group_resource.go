@@ -210,6 +210,7 @@ func (s *MeteringComputationUsageStatementEmailRecipientsGroupResourceCrud) Get(
 	}
 
 	s.Res = &response.EmailRecipientsGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -248,6 +249,8 @@ func (s *MeteringComputationUsageStatementEmailRecipientsGroupResourceCrud) Upda
 		request.SubscriptionId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
 	response, err := s.Client.UpdateEmailRecipientsGroup(context.Background(), request)
@@ -277,6 +280,8 @@ func (s *MeteringComputationUsageStatementEmailRecipientsGroupResourceCrud) Dele
 		request.SubscriptionId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
 	_, err := s.Client.DeleteEmailRecipientsGroup(context.Background(), request)
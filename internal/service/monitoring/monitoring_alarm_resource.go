@@ -477,6 +477,7 @@ func (s *MonitoringAlarmResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Alarm
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -646,6 +647,8 @@ func (s *MonitoringAlarmResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
 	response, err := s.Client.UpdateAlarm(context.Background(), request)
@@ -663,6 +666,8 @@ func (s *MonitoringAlarmResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AlarmId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
 	_, err := s.Client.DeleteAlarm(context.Background(), request)
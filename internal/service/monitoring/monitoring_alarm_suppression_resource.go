@@ -265,6 +265,7 @@ func (s *MonitoringAlarmSuppressionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AlarmSuppression
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -274,6 +275,8 @@ func (s *MonitoringAlarmSuppressionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AlarmSuppressionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
 	_, err := s.Client.DeleteAlarmSuppression(context.Background(), request)
@@ -445,6 +445,7 @@ func (s *MysqlChannelResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Channel
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -503,6 +504,8 @@ func (s *MysqlChannelResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	_, err := s.Client.UpdateChannel(context.Background(), request)
@@ -519,6 +522,8 @@ func (s *MysqlChannelResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ChannelId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	_, err := s.Client.DeleteChannel(context.Background(), request)
@@ -301,6 +301,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.HeatWaveCluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -327,6 +328,8 @@ func (s *MysqlHeatWaveClusterResourceCrud) Update() error {
 		request.ShapeName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	_, err := s.Client.UpdateHeatWaveCluster(context.Background(), request)
@@ -345,6 +348,8 @@ func (s *MysqlHeatWaveClusterResourceCrud) Delete() error {
 		request.DbSystemId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	_, err := s.Client.DeleteHeatWaveCluster(context.Background(), request)
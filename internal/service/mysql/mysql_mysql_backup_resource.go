@@ -705,6 +705,7 @@ func (s *MysqlMysqlBackupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Backup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -750,6 +751,8 @@ func (s *MysqlMysqlBackupResourceCrud) Update() error {
 		request.RetentionInDays = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	response, err := s.Client.UpdateBackup(context.Background(), request)
@@ -772,6 +775,8 @@ func (s *MysqlMysqlBackupResourceCrud) Delete() error {
 		s.Client.SetRegion(destinationRegion)
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	_, err := s.Client.DeleteBackup(context.Background(), request)
@@ -820,6 +820,7 @@ func (s *MysqlMysqlConfigurationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Configuration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -851,6 +852,8 @@ func (s *MysqlMysqlConfigurationResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	response, err := s.Client.UpdateConfiguration(context.Background(), request)
@@ -868,6 +871,8 @@ func (s *MysqlMysqlConfigurationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ConfigurationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	_, err := s.Client.DeleteConfiguration(context.Background(), request)
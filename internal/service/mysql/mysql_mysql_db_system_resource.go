@@ -1121,6 +1121,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DbSystem
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1256,6 +1257,8 @@ func (s *MysqlMysqlDbSystemResourceCrud) Update() error {
 		request.ShapeName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	_, err := s.Client.UpdateDbSystem(context.Background(), request)
@@ -1272,6 +1275,8 @@ func (s *MysqlMysqlDbSystemResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DbSystemId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	_, err := s.Client.DeleteDbSystem(context.Background(), request)
@@ -329,6 +329,7 @@ func (s *MysqlReplicaResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Replica
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -376,6 +377,8 @@ func (s *MysqlReplicaResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	_, err := s.Client.UpdateReplica(context.Background(), request)
@@ -392,6 +395,8 @@ func (s *MysqlReplicaResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ReplicaId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
 	_, err := s.Client.DeleteReplica(context.Background(), request)
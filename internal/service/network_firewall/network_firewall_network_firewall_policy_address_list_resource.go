@@ -185,6 +185,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyAddressListResourceCrud) Get() erro
 	}
 
 	s.Res = &response.AddressList
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -205,6 +206,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyAddressListResourceCrud) Update() e
 		request.AddressListName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateAddressList(context.Background(), request)
@@ -229,6 +232,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyAddressListResourceCrud) Delete() e
 		request.NetworkFirewallPolicyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	_, err := s.Client.DeleteAddressList(context.Background(), request)
@@ -167,6 +167,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyApplicationGroupResourceCrud) Get()
 	}
 
 	s.Res = &response.ApplicationGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -195,6 +196,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyApplicationGroupResourceCrud) Updat
 		request.NetworkFirewallPolicyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateApplicationGroup(context.Background(), request)
@@ -219,6 +222,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyApplicationGroupResourceCrud) Delet
 		request.NetworkFirewallPolicyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	_, err := s.Client.DeleteApplicationGroup(context.Background(), request)
@@ -167,6 +167,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyApplicationResourceCrud) Get() erro
 	}
 
 	s.Res = &response.Application
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -187,6 +188,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyApplicationResourceCrud) Update() e
 		request.ApplicationName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateApplication(context.Background(), request)
@@ -211,6 +214,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyApplicationResourceCrud) Delete() e
 		request.NetworkFirewallPolicyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	_, err := s.Client.DeleteApplication(context.Background(), request)
@@ -203,6 +203,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyDecryptionProfileResourceCrud) Get(
 	}
 
 	s.Res = &response.DecryptionProfile
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -223,6 +224,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyDecryptionProfileResourceCrud) Upda
 		request.DecryptionProfileName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateDecryptionProfile(context.Background(), request)
@@ -247,6 +250,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyDecryptionProfileResourceCrud) Dele
 		request.NetworkFirewallPolicyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	_, err := s.Client.DeleteDecryptionProfile(context.Background(), request)
@@ -251,6 +251,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyDecryptionRuleResourceCrud) Get() e
 	}
 
 	s.Res = &response.DecryptionRule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -302,6 +303,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyDecryptionRuleResourceCrud) Update(
 		request.Secret = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateDecryptionRule(context.Background(), request)
@@ -326,6 +329,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyDecryptionRuleResourceCrud) Delete(
 		request.NetworkFirewallPolicyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	_, err := s.Client.DeleteDecryptionRule(context.Background(), request)
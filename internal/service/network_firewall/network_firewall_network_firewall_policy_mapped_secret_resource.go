@@ -172,6 +172,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyMappedSecretResourceCrud) Get() err
 	}
 
 	s.Res = &response.MappedSecret
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -192,6 +193,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyMappedSecretResourceCrud) Update()
 		request.NetworkFirewallPolicyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateMappedSecret(context.Background(), request)
@@ -216,6 +219,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyMappedSecretResourceCrud) Delete()
 		request.NetworkFirewallPolicyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	_, err := s.Client.DeleteMappedSecret(context.Background(), request)
@@ -363,6 +363,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NetworkFirewallPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -398,6 +399,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.NetworkFirewallPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateNetworkFirewallPolicy(context.Background(), request)
@@ -415,6 +418,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NetworkFirewallPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.DeleteNetworkFirewallPolicy(context.Background(), request)
@@ -259,6 +259,7 @@ func (s *NetworkFirewallNetworkFirewallPolicySecurityRuleResourceCrud) Get() err
 	}
 
 	s.Res = &response.SecurityRule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -304,6 +305,8 @@ func (s *NetworkFirewallNetworkFirewallPolicySecurityRuleResourceCrud) Update()
 		request.SecurityRuleName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateSecurityRule(context.Background(), request)
@@ -328,6 +331,8 @@ func (s *NetworkFirewallNetworkFirewallPolicySecurityRuleResourceCrud) Delete()
 		request.SecurityRuleName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	_, err := s.Client.DeleteSecurityRule(context.Background(), request)
@@ -169,6 +169,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyServiceListResourceCrud) Get() erro
 	}
 
 	s.Res = &response.ServiceList
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -196,6 +197,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyServiceListResourceCrud) Update() e
 		request.Services = tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateServiceList(context.Background(), request)
@@ -220,6 +223,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyServiceListResourceCrud) Delete() e
 		request.ServiceListName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	_, err := s.Client.DeleteServiceList(context.Background(), request)
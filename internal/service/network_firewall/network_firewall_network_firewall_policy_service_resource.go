@@ -179,6 +179,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyServiceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Service
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -199,6 +200,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyServiceResourceCrud) Update() error
 		request.ServiceName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateService(context.Background(), request)
@@ -223,6 +226,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyServiceResourceCrud) Delete() error
 		request.ServiceName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	_, err := s.Client.DeleteService(context.Background(), request)
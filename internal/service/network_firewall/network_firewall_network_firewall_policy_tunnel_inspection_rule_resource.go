@@ -234,6 +234,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyTunnelInspectionRuleResourceCrud) G
 	}
 
 	s.Res = &response.TunnelInspectionRule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -254,6 +255,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyTunnelInspectionRuleResourceCrud) U
 		request.TunnelInspectionRuleName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateTunnelInspectionRule(context.Background(), request)
@@ -278,6 +281,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyTunnelInspectionRuleResourceCrud) D
 		request.TunnelInspectionRuleName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	_, err := s.Client.DeleteTunnelInspectionRule(context.Background(), request)
@@ -191,6 +191,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyUrlListResourceCrud) Get() error {
 	}
 
 	s.Res = &response.UrlList
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -224,6 +225,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyUrlListResourceCrud) Update() error
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateUrlList(context.Background(), request)
@@ -248,6 +251,8 @@ func (s *NetworkFirewallNetworkFirewallPolicyUrlListResourceCrud) Delete() error
 		request.UrlListName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	_, err := s.Client.DeleteUrlList(context.Background(), request)
@@ -419,6 +419,7 @@ func (s *NetworkFirewallNetworkFirewallResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NetworkFirewall
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -473,6 +474,8 @@ func (s *NetworkFirewallNetworkFirewallResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.UpdateNetworkFirewall(context.Background(), request)
@@ -490,6 +493,8 @@ func (s *NetworkFirewallNetworkFirewallResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NetworkFirewallId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
 	response, err := s.Client.DeleteNetworkFirewall(context.Background(), request)
@@ -6,8 +6,8 @@ package network_firewall
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_network_firewall_network_firewall", NetworkFirewallNetworkFirewallResource())
-	tfresource.RegisterResource("oci_network_firewall_network_firewall_policy", NetworkFirewallNetworkFirewallPolicyResource())
+	tfresource.RegisterWorkRequestResource("oci_network_firewall_network_firewall", NetworkFirewallNetworkFirewallResource())
+	tfresource.RegisterWorkRequestResource("oci_network_firewall_network_firewall_policy", NetworkFirewallNetworkFirewallPolicyResource())
 	tfresource.RegisterResource("oci_network_firewall_network_firewall_policy_address_list", NetworkFirewallNetworkFirewallPolicyAddressListResource())
 	tfresource.RegisterResource("oci_network_firewall_network_firewall_policy_application", NetworkFirewallNetworkFirewallPolicyApplicationResource())
 	tfresource.RegisterResource("oci_network_firewall_network_firewall_policy_application_group", NetworkFirewallNetworkFirewallPolicyApplicationGroupResource())
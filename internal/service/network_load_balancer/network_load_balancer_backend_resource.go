@@ -375,6 +375,7 @@ func (s *NetworkLoadBalancerBackendResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Backend
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -416,6 +417,8 @@ func (s *NetworkLoadBalancerBackendResourceCrud) Update() error {
 		request.Weight = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
 	response, err := s.Client.UpdateBackend(context.Background(), request)
@@ -445,6 +448,8 @@ func (s *NetworkLoadBalancerBackendResourceCrud) Delete() error {
 		request.NetworkLoadBalancerId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
 	response, err := s.Client.DeleteBackend(context.Background(), request)
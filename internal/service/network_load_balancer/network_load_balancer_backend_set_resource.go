@@ -496,6 +496,7 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BackendSet
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -573,6 +574,8 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Update() error {
 		request.Policy = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
 	response, err := s.Client.UpdateBackendSet(context.Background(), request)
@@ -597,6 +600,8 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Delete() error {
 		request.NetworkLoadBalancerId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
 	response, err := s.Client.DeleteBackendSet(context.Background(), request)
@@ -332,6 +332,7 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Listener
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -380,6 +381,8 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Update() error {
 		request.UdpIdleTimeout = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
 	response, err := s.Client.UpdateListener(context.Background(), request)
@@ -404,6 +407,8 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Delete() error {
 		request.NetworkLoadBalancerId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
 	response, err := s.Client.DeleteListener(context.Background(), request)
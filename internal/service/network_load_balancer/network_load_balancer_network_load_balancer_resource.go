@@ -505,6 +505,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NetworkLoadBalancer
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -573,6 +574,8 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Update() error {
 		request.SubnetIpv6Cidr = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
 	response, err := s.Client.UpdateNetworkLoadBalancer(context.Background(), request)
@@ -590,6 +593,8 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NetworkLoadBalancerId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
 	response, err := s.Client.DeleteNetworkLoadBalancer(context.Background(), request)
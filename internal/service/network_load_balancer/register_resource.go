@@ -6,9 +6,9 @@ package network_load_balancer
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_network_load_balancer_backend", NetworkLoadBalancerBackendResource())
-	tfresource.RegisterResource("oci_network_load_balancer_backend_set", NetworkLoadBalancerBackendSetResource())
-	tfresource.RegisterResource("oci_network_load_balancer_listener", NetworkLoadBalancerListenerResource())
-	tfresource.RegisterResource("oci_network_load_balancer_network_load_balancer", NetworkLoadBalancerNetworkLoadBalancerResource())
-	tfresource.RegisterResource("oci_network_load_balancer_network_load_balancers_backend_sets_unified", NetworkLoadBalancerNetworkLoadBalancersBackendSetsUnifiedResource())
+	tfresource.RegisterWorkRequestResource("oci_network_load_balancer_backend", NetworkLoadBalancerBackendResource())
+	tfresource.RegisterWorkRequestResource("oci_network_load_balancer_backend_set", NetworkLoadBalancerBackendSetResource())
+	tfresource.RegisterWorkRequestResource("oci_network_load_balancer_listener", NetworkLoadBalancerListenerResource())
+	tfresource.RegisterWorkRequestResource("oci_network_load_balancer_network_load_balancer", NetworkLoadBalancerNetworkLoadBalancerResource())
+	tfresource.RegisterWorkRequestResource("oci_network_load_balancer_network_load_balancers_backend_sets_unified", NetworkLoadBalancerNetworkLoadBalancersBackendSetsUnifiedResource())
 }
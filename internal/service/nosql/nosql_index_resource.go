@@ -383,6 +383,7 @@ func (s *NosqlIndexResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Index
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -409,6 +410,8 @@ func (s *NosqlIndexResourceCrud) Delete() error {
 		request.TableNameOrId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
 	response, err := s.Client.DeleteIndex(context.Background(), request)
@@ -340,6 +340,8 @@ func (s *NosqlTableReplicaResourceCrud) Delete() error {
 		request.TableNameOrId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
 	response, err := s.Client.DeleteReplica(context.Background(), request)
@@ -576,6 +576,7 @@ func (s *NosqlTableResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Table
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -701,6 +702,8 @@ func (s *NosqlTableResourceCrud) Delete() error {
 		request.TableNameOrId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
 	response, err := s.Client.DeleteTable(context.Background(), request)
@@ -6,7 +6,7 @@ package nosql
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_nosql_index", NosqlIndexResource())
-	tfresource.RegisterResource("oci_nosql_table", NosqlTableResource())
-	tfresource.RegisterResource("oci_nosql_table_replica", NosqlTableReplicaResource())
+	tfresource.RegisterWorkRequestResource("oci_nosql_index", NosqlIndexResource())
+	tfresource.RegisterWorkRequestResource("oci_nosql_table", NosqlTableResource())
+	tfresource.RegisterWorkRequestResource("oci_nosql_table_replica", NosqlTableReplicaResource())
 }
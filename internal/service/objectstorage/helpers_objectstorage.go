@@ -20,6 +20,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
@@ -572,3 +573,34 @@ func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, buc
 
 	return nil
 }
+
+// bucketRegion returns the region of a planned bucket, which is the one of the object storage client it is managed
+// with, or "" if it is unknown
+func bucketRegion(d *schema.ResourceDiff, m interface{}) string {
+	if !d.NewValueKnown("region") {
+		return ""
+	}
+	clients, err := tfresource.ClientsForResourceDiff(d, m)
+	if err != nil {
+		return ""
+	}
+	oracleClients, ok := clients.(*tf_client.OracleClients)
+	if !ok {
+		return ""
+	}
+	// The client may not be created, e.g. because of the configuration of the provider, in which case the region is
+	// unknown and the check is skipped
+	client, err := oracleClients.GetClient("oci_object_storage.ObjectStorageClient")
+	if err != nil {
+		return ""
+	}
+	objectStorageClient, ok := client.(*oci_object_storage.ObjectStorageClient)
+	if !ok || objectStorageClient.ConfigurationProvider() == nil {
+		return ""
+	}
+	region, err := (*objectStorageClient.ConfigurationProvider()).Region()
+	if err != nil {
+		return ""
+	}
+	return region
+}
@@ -197,6 +197,8 @@ func ObjectStorageBucketResource() *schema.Resource {
 				Computed: true,
 			},
 		},
+		// The KMS key of the bucket must be in the region of the bucket
+		CustomizeDiff: tfresource.KmsKeyRegionDiff("kms_key_id", bucketRegion),
 	}
 }
 
@@ -471,6 +473,8 @@ func (s *ObjectStorageBucketResourceCrud) Update() error {
 		request.Versioning = oci_object_storage.UpdateBucketDetailsVersioningEnum(versioning.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.UpdateBucket(context.Background(), request)
@@ -501,6 +505,8 @@ func (s *ObjectStorageBucketResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	_, err := s.Client.DeleteBucket(context.Background(), request)
@@ -322,6 +322,8 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Delete() error {
 		request.NamespaceName = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	_, err := s.Client.DeleteObjectLifecyclePolicy(context.Background(), request)
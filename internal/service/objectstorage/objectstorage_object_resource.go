@@ -870,6 +870,7 @@ func (s *ObjectStorageObjectResourceCrud) Update() error {
 
 func (s *ObjectStorageObjectResourceCrud) Delete() error {
 	request := oci_object_storage.DeleteObjectRequest{}
+	request.IfMatch = tfresource.IfMatchETag(s.D)
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
 		tmp := bucket.(string)
@@ -499,6 +499,7 @@ func (s *OceOceInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OceInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -569,6 +570,8 @@ func (s *OceOceInstanceResourceCrud) Update() error {
 		request.WafPrimaryDomain = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
 	response, err := s.Client.UpdateOceInstance(context.Background(), request)
@@ -586,6 +589,8 @@ func (s *OceOceInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OceInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
 	response, err := s.Client.DeleteOceInstance(context.Background(), request)
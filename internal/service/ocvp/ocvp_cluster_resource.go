@@ -624,6 +624,7 @@ func (s *OcvpClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Cluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -671,6 +672,8 @@ func (s *OcvpClusterResourceCrud) Update() error {
 		request.VmwareSoftwareVersion = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
 	response, err := s.Client.UpdateCluster(context.Background(), request)
@@ -688,6 +691,8 @@ func (s *OcvpClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
 	response, err := s.Client.DeleteCluster(context.Background(), request)
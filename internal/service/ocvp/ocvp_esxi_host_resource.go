@@ -581,6 +581,7 @@ func (s *OcvpEsxiHostResourceCrud) Get() error {
 	}
 
 	s.Res = &response.EsxiHost
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -616,6 +617,8 @@ func (s *OcvpEsxiHostResourceCrud) Update() error {
 		request.NextCommitment = oci_ocvp.CommitmentEnum(nextSku.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
 	response, err := s.Client.UpdateEsxiHost(context.Background(), request)
@@ -633,6 +636,8 @@ func (s *OcvpEsxiHostResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.EsxiHostId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
 	response, err := s.Client.DeleteEsxiHost(context.Background(), request)
@@ -1304,6 +1304,7 @@ func (s *OcvpSddcResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Sddc
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1354,6 +1355,8 @@ func (s *OcvpSddcResourceCrud) Update() error {
 	sddcId := s.D.Id()
 	request.SddcId = &sddcId
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
 	response, err := s.Client.UpdateSddc(context.Background(), request)
@@ -1546,6 +1549,8 @@ func (s *OcvpSddcResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SddcId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
 	response, err := s.Client.DeleteSddc(context.Background(), request)
@@ -6,7 +6,7 @@ package ocvp
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_ocvp_cluster", OcvpClusterResource())
-	tfresource.RegisterResource("oci_ocvp_esxi_host", OcvpEsxiHostResource())
-	tfresource.RegisterResource("oci_ocvp_sddc", OcvpSddcResource())
+	tfresource.RegisterWorkRequestResource("oci_ocvp_cluster", OcvpClusterResource())
+	tfresource.RegisterWorkRequestResource("oci_ocvp_esxi_host", OcvpEsxiHostResource())
+	tfresource.RegisterWorkRequestResource("oci_ocvp_sddc", OcvpSddcResource())
 }
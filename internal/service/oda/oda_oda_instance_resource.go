@@ -510,6 +510,7 @@ func (s *OdaOdaInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OdaInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -550,6 +551,8 @@ func (s *OdaOdaInstanceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.OdaInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
 	response, err := s.Client.UpdateOdaInstance(context.Background(), request)
@@ -572,6 +575,8 @@ func (s *OdaOdaInstanceResourceCrud) Delete() error {
 		request.RetentionTime = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
 	response, err := s.Client.DeleteOdaInstance(context.Background(), request)
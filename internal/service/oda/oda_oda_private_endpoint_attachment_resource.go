@@ -286,6 +286,7 @@ func (s *OdaOdaPrivateEndpointAttachmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OdaPrivateEndpointAttachment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -295,6 +296,8 @@ func (s *OdaOdaPrivateEndpointAttachmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OdaPrivateEndpointAttachmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
 	response, err := s.Client.DeleteOdaPrivateEndpointAttachment(context.Background(), request)
@@ -360,6 +360,7 @@ func (s *OdaOdaPrivateEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OdaPrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -414,6 +415,8 @@ func (s *OdaOdaPrivateEndpointResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.OdaPrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
 	response, err := s.Client.UpdateOdaPrivateEndpoint(context.Background(), request)
@@ -431,6 +434,8 @@ func (s *OdaOdaPrivateEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OdaPrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
 	response, err := s.Client.DeleteOdaPrivateEndpoint(context.Background(), request)
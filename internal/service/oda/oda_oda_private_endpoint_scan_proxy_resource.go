@@ -377,6 +377,7 @@ func (s *OdaOdaPrivateEndpointScanProxyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OdaPrivateEndpointScanProxy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -391,6 +392,8 @@ func (s *OdaOdaPrivateEndpointScanProxyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OdaPrivateEndpointScanProxyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
 	response, err := s.Client.DeleteOdaPrivateEndpointScanProxy(context.Background(), request)
@@ -6,8 +6,8 @@ package oda
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_oda_oda_instance", OdaOdaInstanceResource())
-	tfresource.RegisterResource("oci_oda_oda_private_endpoint", OdaOdaPrivateEndpointResource())
-	tfresource.RegisterResource("oci_oda_oda_private_endpoint_attachment", OdaOdaPrivateEndpointAttachmentResource())
-	tfresource.RegisterResource("oci_oda_oda_private_endpoint_scan_proxy", OdaOdaPrivateEndpointScanProxyResource())
+	tfresource.RegisterWorkRequestResource("oci_oda_oda_instance", OdaOdaInstanceResource())
+	tfresource.RegisterWorkRequestResource("oci_oda_oda_private_endpoint", OdaOdaPrivateEndpointResource())
+	tfresource.RegisterWorkRequestResource("oci_oda_oda_private_endpoint_attachment", OdaOdaPrivateEndpointAttachmentResource())
+	tfresource.RegisterWorkRequestResource("oci_oda_oda_private_endpoint_scan_proxy", OdaOdaPrivateEndpointScanProxyResource())
 }
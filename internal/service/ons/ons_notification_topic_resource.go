@@ -224,6 +224,7 @@ func (s *OnsNotificationTopicResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NotificationTopic
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -261,6 +262,8 @@ func (s *OnsNotificationTopicResourceCrud) Update() error {
 		request.TopicId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
 	response, err := s.Client.UpdateTopic(context.Background(), request)
@@ -280,6 +283,8 @@ func (s *OnsNotificationTopicResourceCrud) Delete() error {
 		request.TopicId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
 	_, err := s.Client.DeleteTopic(context.Background(), request)
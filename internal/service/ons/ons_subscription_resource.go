@@ -212,6 +212,7 @@ func (s *OnsSubscriptionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Subscription
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -251,6 +252,8 @@ func (s *OnsSubscriptionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SubscriptionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
 	_, err := s.Client.UpdateSubscription(context.Background(), request)
@@ -267,6 +270,8 @@ func (s *OnsSubscriptionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SubscriptionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
 	_, err := s.Client.DeleteSubscription(context.Background(), request)
@@ -508,6 +508,7 @@ func (s *OpaOpaInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OpaInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -548,6 +549,8 @@ func (s *OpaOpaInstanceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.OpaInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opa")
 
 	response, err := s.Client.UpdateOpaInstance(context.Background(), request)
@@ -565,6 +568,8 @@ func (s *OpaOpaInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OpaInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opa")
 
 	response, err := s.Client.DeleteOpaInstance(context.Background(), request)
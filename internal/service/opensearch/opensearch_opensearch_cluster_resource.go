@@ -599,6 +599,7 @@ func (s *OpensearchOpensearchClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OpensearchCluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -690,6 +691,8 @@ func (s *OpensearchOpensearchClusterResourceCrud) Update() error {
 		request.SoftwareVersion = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opensearch")
 
 	response, err := s.Client.UpdateOpensearchCluster(context.Background(), request)
@@ -707,6 +710,8 @@ func (s *OpensearchOpensearchClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OpensearchClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opensearch")
 
 	response, err := s.Client.DeleteOpensearchCluster(context.Background(), request)
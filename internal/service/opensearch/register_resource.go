@@ -6,5 +6,5 @@ package opensearch
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_opensearch_opensearch_cluster", OpensearchOpensearchClusterResource())
+	tfresource.RegisterWorkRequestResource("oci_opensearch_opensearch_cluster", OpensearchOpensearchClusterResource())
 }
@@ -415,6 +415,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Get() error
 	}
 
 	s.Res = &response.OperatorControlAssignment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -501,6 +502,8 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Update() er
 		request.TimeAssignmentTo = &oci_common.SDKTime{Time: tmp}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
 	response, err := s.Client.UpdateOperatorControlAssignment(context.Background(), request)
@@ -523,6 +526,8 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Delete() er
 	tmp := s.D.Id()
 	request.OperatorControlAssignmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
 	_, err := s.Client.DeleteOperatorControlAssignment(context.Background(), request)
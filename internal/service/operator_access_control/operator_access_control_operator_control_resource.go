@@ -333,6 +333,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OperatorControl
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -440,6 +441,8 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Update() error {
 		request.SystemMessage = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
 	response, err := s.Client.UpdateOperatorControl(context.Background(), request)
@@ -462,6 +465,8 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OperatorControlId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
 	_, err := s.Client.DeleteOperatorControl(context.Background(), request)
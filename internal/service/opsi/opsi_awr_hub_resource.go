@@ -349,6 +349,7 @@ func (s *OpsiAwrHubResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AwrHub
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -375,6 +376,8 @@ func (s *OpsiAwrHubResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.UpdateAwrHub(context.Background(), request)
@@ -392,6 +395,8 @@ func (s *OpsiAwrHubResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AwrHubId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.DeleteAwrHub(context.Background(), request)
@@ -396,6 +396,7 @@ func (s *OpsiAwrHubSourceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AwrHubSource
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -430,6 +431,8 @@ func (s *OpsiAwrHubSourceResourceCrud) Update() error {
 		request.Type = oci_opsi.AwrHubSourceTypeEnum(type_.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.UpdateAwrHubSource(context.Background(), request)
@@ -447,6 +450,8 @@ func (s *OpsiAwrHubSourceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AwrHubSourceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.DeleteAwrHubSource(context.Background(), request)
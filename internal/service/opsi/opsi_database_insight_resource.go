@@ -609,6 +609,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DatabaseInsight
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -638,6 +639,8 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.UpdateDatabaseInsight(context.Background(), request)
@@ -742,6 +745,8 @@ func (s *OpsiDatabaseInsightResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DatabaseInsightId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.DeleteDatabaseInsight(context.Background(), request)
@@ -348,6 +348,7 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.EnterpriseManagerBridge
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -388,6 +389,8 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.UpdateEnterpriseManagerBridge(context.Background(), request)
@@ -405,6 +408,8 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.EnterpriseManagerBridgeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.DeleteEnterpriseManagerBridge(context.Background(), request)
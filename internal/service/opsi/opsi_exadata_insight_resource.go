@@ -646,6 +646,7 @@ func (s *OpsiExadataInsightResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ExadataInsight
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -665,6 +666,8 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.UpdateExadataInsight(context.Background(), request)
@@ -770,6 +773,8 @@ func (s *OpsiExadataInsightResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ExadataInsightId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.DeleteExadataInsight(context.Background(), request)
@@ -425,6 +425,7 @@ func (s *OpsiHostInsightResourceCrud) Get() error {
 	}
 
 	s.Res = &response.HostInsight
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -444,6 +445,8 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.UpdateHostInsight(context.Background(), request)
@@ -552,6 +555,8 @@ func (s *OpsiHostInsightResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.HostInsightId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.DeleteHostInsight(context.Background(), request)
@@ -457,6 +457,7 @@ func (s *OpsiNewsReportResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NewsReport
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -534,6 +535,8 @@ func (s *OpsiNewsReportResourceCrud) Update() error {
 		request.Status = oci_opsi.ResourceStatusEnum(status.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.UpdateNewsReport(context.Background(), request)
@@ -551,6 +554,8 @@ func (s *OpsiNewsReportResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NewsReportId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.DeleteNewsReport(context.Background(), request)
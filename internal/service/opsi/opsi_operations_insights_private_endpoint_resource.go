@@ -389,6 +389,7 @@ func (s *OpsiOperationsInsightsPrivateEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OperationsInsightsPrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -443,6 +444,8 @@ func (s *OpsiOperationsInsightsPrivateEndpointResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.OperationsInsightsPrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.UpdateOperationsInsightsPrivateEndpoint(context.Background(), request)
@@ -461,6 +464,8 @@ func (s *OpsiOperationsInsightsPrivateEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OperationsInsightsPrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.DeleteOperationsInsightsPrivateEndpoint(context.Background(), request)
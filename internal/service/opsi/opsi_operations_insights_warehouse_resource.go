@@ -358,6 +358,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OperationsInsightsWarehouse
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -403,6 +404,8 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Update() error {
 		request.StorageAllocatedInGBs = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.UpdateOperationsInsightsWarehouse(context.Background(), request)
@@ -420,6 +423,8 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OperationsInsightsWarehouseId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.DeleteOperationsInsightsWarehouse(context.Background(), request)
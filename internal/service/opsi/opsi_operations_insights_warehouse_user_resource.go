@@ -370,6 +370,7 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Get() error {
 	}
 
 	s.Res = &response.OperationsInsightsWarehouseUser
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -411,6 +412,8 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.OperationsInsightsWarehouseUserId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.UpdateOperationsInsightsWarehouseUser(context.Background(), request)
@@ -428,6 +431,8 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OperationsInsightsWarehouseUserId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.DeleteOperationsInsightsWarehouseUser(context.Background(), request)
@@ -532,6 +532,7 @@ func (s *OpsiOpsiConfigurationResourceCrud) Get() error {
 		return err
 	}
 	s.Res = &response.OpsiConfiguration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -551,6 +552,8 @@ func (s *OpsiOpsiConfigurationResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.UpdateOpsiConfiguration(context.Background(), request)
@@ -568,6 +571,8 @@ func (s *OpsiOpsiConfigurationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.OpsiConfigurationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
 	response, err := s.Client.DeleteOpsiConfiguration(context.Background(), request)
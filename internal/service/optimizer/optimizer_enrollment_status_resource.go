@@ -165,6 +165,7 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Get() error {
 	}
 
 	s.Res = &response.EnrollmentStatus
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -178,6 +179,8 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Update() error {
 		request.Status = oci_optimizer.OptimizerEnrollmentStatusEnum(status.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
 	response, err := s.Client.UpdateEnrollmentStatus(context.Background(), request)
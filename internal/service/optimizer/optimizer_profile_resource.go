@@ -357,6 +357,7 @@ func (s *OptimizerProfileResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Profile
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -426,6 +427,8 @@ func (s *OptimizerProfileResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
 	response, err := s.Client.UpdateProfile(context.Background(), request)
@@ -443,6 +446,8 @@ func (s *OptimizerProfileResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ProfileId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
 	_, err := s.Client.DeleteProfile(context.Background(), request)
@@ -257,6 +257,7 @@ func (s *OptimizerRecommendationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Recommendation
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -278,6 +279,8 @@ func (s *OptimizerRecommendationResourceCrud) Update() error {
 		request.TimeStatusEnd = &oci_common.SDKTime{Time: tmp}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
 	response, err := s.Client.UpdateRecommendation(context.Background(), request)
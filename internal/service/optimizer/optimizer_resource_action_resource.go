@@ -248,6 +248,7 @@ func (s *OptimizerResourceActionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ResourceAction
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -269,6 +270,8 @@ func (s *OptimizerResourceActionResourceCrud) Update() error {
 		request.TimeStatusEnd = &oci_common.SDKTime{Time: tmp}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
 	response, err := s.Client.UpdateResourceAction(context.Background(), request)
@@ -506,6 +506,7 @@ func (s *OsManagementHubEventResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Event
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -548,6 +549,8 @@ func (s *OsManagementHubEventResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	response, err := s.Client.UpdateEvent(context.Background(), request)
@@ -565,6 +568,8 @@ func (s *OsManagementHubEventResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.EventId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	response, err := s.Client.DeleteEvent(context.Background(), request)
@@ -411,6 +411,7 @@ func (s *OsManagementHubLifecycleEnvironmentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.LifecycleEnvironment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -475,6 +476,8 @@ func (s *OsManagementHubLifecycleEnvironmentResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	response, err := s.Client.UpdateLifecycleEnvironment(context.Background(), request)
@@ -492,6 +495,8 @@ func (s *OsManagementHubLifecycleEnvironmentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.LifecycleEnvironmentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	_, err := s.Client.DeleteLifecycleEnvironment(context.Background(), request)
@@ -371,6 +371,7 @@ func (s *OsManagementHubManagedInstanceGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ManagedInstanceGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -435,6 +436,8 @@ func (s *OsManagementHubManagedInstanceGroupResourceCrud) Update() error {
 		request.NotificationTopicId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	response, err := s.Client.UpdateManagedInstanceGroup(context.Background(), request)
@@ -452,6 +455,8 @@ func (s *OsManagementHubManagedInstanceGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagedInstanceGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	_, err := s.Client.DeleteManagedInstanceGroup(context.Background(), request)
@@ -525,6 +525,7 @@ func (s *OsManagementHubManagedInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ManagedInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -565,6 +566,8 @@ func (s *OsManagementHubManagedInstanceResourceCrud) Update() error {
 		request.SecondaryManagementStationId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	response, err := s.Client.UpdateManagedInstance(context.Background(), request)
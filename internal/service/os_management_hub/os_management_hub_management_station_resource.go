@@ -410,6 +410,7 @@ func (s *OsManagementHubManagementStationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ManagementStation
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -484,6 +485,8 @@ func (s *OsManagementHubManagementStationResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	response, err := s.Client.UpdateManagementStation(context.Background(), request)
@@ -501,6 +504,8 @@ func (s *OsManagementHubManagementStationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagementStationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	_, err := s.Client.DeleteManagementStation(context.Background(), request)
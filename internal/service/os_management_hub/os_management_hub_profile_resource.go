@@ -347,6 +347,7 @@ func (s *OsManagementHubProfileResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Profile
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -399,6 +400,8 @@ func (s *OsManagementHubProfileResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ProfileId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	response, err := s.Client.UpdateProfile(context.Background(), request)
@@ -416,6 +419,8 @@ func (s *OsManagementHubProfileResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ProfileId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	_, err := s.Client.DeleteProfile(context.Background(), request)
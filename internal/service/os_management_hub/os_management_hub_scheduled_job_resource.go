@@ -622,6 +622,7 @@ func (s *OsManagementHubScheduledJobResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ScheduledJob
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -716,6 +717,8 @@ func (s *OsManagementHubScheduledJobResourceCrud) Update() error {
 		request.TimeNextExecution = &oci_common.SDKTime{Time: tmp}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	response, err := s.Client.UpdateScheduledJob(context.Background(), request)
@@ -733,6 +736,8 @@ func (s *OsManagementHubScheduledJobResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ScheduledJobId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	_, err := s.Client.DeleteScheduledJob(context.Background(), request)
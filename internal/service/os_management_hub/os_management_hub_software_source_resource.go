@@ -546,6 +546,7 @@ func (s *OsManagementHubSoftwareSourceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SoftwareSource
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -572,6 +573,8 @@ func (s *OsManagementHubSoftwareSourceResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	_, err = s.Client.UpdateSoftwareSource(context.Background(), request)
@@ -594,6 +597,8 @@ func (s *OsManagementHubSoftwareSourceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SoftwareSourceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "os_management_hub")
 
 	_, err := s.Client.DeleteSoftwareSource(context.Background(), request)
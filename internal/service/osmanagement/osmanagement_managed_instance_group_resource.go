@@ -260,6 +260,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ManagedInstanceGroup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -300,6 +301,8 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ManagedInstanceGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
 	response, err := s.Client.UpdateManagedInstanceGroup(context.Background(), request)
@@ -359,6 +362,8 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ManagedInstanceGroupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
 	_, err := s.Client.DeleteManagedInstanceGroup(context.Background(), request)
@@ -287,6 +287,7 @@ func (s *OsmanagementManagedInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ManagedInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -306,6 +307,8 @@ func (s *OsmanagementManagedInstanceResourceCrud) Update() error {
 		request.NotificationTopicId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
 	response, err := s.Client.UpdateManagedInstance(context.Background(), request)
@@ -299,6 +299,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.SoftwareSource
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -358,6 +359,8 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SoftwareSourceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
 	response, err := s.Client.UpdateSoftwareSource(context.Background(), request)
@@ -375,6 +378,8 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.SoftwareSourceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
 	_, err := s.Client.DeleteSoftwareSource(context.Background(), request)
@@ -887,6 +887,7 @@ func (s *OspGatewaySubscriptionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Subscription
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -922,6 +923,8 @@ func (s *OspGatewaySubscriptionResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.SubscriptionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osp_gateway")
 
 	response, err := s.Client.UpdateSubscription(context.Background(), request)
@@ -395,6 +395,7 @@ func (s *PsqlBackupResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Backup
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -440,6 +441,8 @@ func (s *PsqlBackupResourceCrud) Update() error {
 		request.RetentionPeriod = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "psql")
 
 	response, err := s.Client.UpdateBackup(context.Background(), request)
@@ -457,6 +460,8 @@ func (s *PsqlBackupResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BackupId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "psql")
 
 	response, err := s.Client.DeleteBackup(context.Background(), request)
@@ -375,6 +375,7 @@ func (s *PsqlConfigurationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Configuration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -415,6 +416,8 @@ func (s *PsqlConfigurationResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "psql")
 
 	response, err := s.Client.UpdateConfiguration(context.Background(), request)
@@ -432,6 +435,8 @@ func (s *PsqlConfigurationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ConfigurationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "psql")
 
 	_, err := s.Client.DeleteConfiguration(context.Background(), request)
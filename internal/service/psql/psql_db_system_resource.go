@@ -914,6 +914,7 @@ func (s *PsqlDbSystemResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DbSystem
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1046,6 +1047,8 @@ func (s *PsqlDbSystemResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "psql")
 
 	response, err := s.Client.UpdateDbSystem(context.Background(), request)
@@ -1072,6 +1075,8 @@ func (s *PsqlDbSystemResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DbSystemId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "psql")
 
 	response, err := s.Client.DeleteDbSystem(context.Background(), request)
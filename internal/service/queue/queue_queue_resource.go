@@ -439,6 +439,7 @@ func (s *QueueQueueResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Queue
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -499,6 +500,8 @@ func (s *QueueQueueResourceCrud) Update() error {
 		request.VisibilityInSeconds = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "queue")
 
 	response, err := s.Client.UpdateQueue(context.Background(), request)
@@ -516,6 +519,8 @@ func (s *QueueQueueResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.QueueId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "queue")
 
 	response, err := s.Client.DeleteQueue(context.Background(), request)
@@ -6,5 +6,5 @@ package queue
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_queue_queue", QueueQueueResource())
+	tfresource.RegisterWorkRequestResource("oci_queue_queue", QueueQueueResource())
 }
@@ -502,6 +502,7 @@ func (s *RecoveryProtectedDatabaseResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ProtectedDatabase
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -581,6 +582,8 @@ func (s *RecoveryProtectedDatabaseResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "recovery")
 
 	response, err := s.Client.UpdateProtectedDatabase(context.Background(), request)
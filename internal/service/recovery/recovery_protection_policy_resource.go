@@ -353,6 +353,7 @@ func (s *RecoveryProtectionPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ProtectionPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -398,6 +399,8 @@ func (s *RecoveryProtectionPolicyResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ProtectionPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "recovery")
 
 	response, err := s.Client.UpdateProtectionPolicy(context.Background(), request)
@@ -415,6 +418,8 @@ func (s *RecoveryProtectionPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ProtectionPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "recovery")
 
 	response, err := s.Client.DeleteProtectionPolicy(context.Background(), request)
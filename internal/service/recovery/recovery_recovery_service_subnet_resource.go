@@ -388,6 +388,7 @@ func (s *RecoveryRecoveryServiceSubnetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.RecoveryServiceSubnet
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -451,6 +452,8 @@ func (s *RecoveryRecoveryServiceSubnetResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "recovery")
 
 	response, err := s.Client.UpdateRecoveryServiceSubnet(context.Background(), request)
@@ -468,6 +471,8 @@ func (s *RecoveryRecoveryServiceSubnetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RecoveryServiceSubnetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "recovery")
 
 	response, err := s.Client.DeleteRecoveryServiceSubnet(context.Background(), request)
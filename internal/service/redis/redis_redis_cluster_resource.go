@@ -481,6 +481,7 @@ func (s *RedisRedisClusterResourceCrud) Get() error {
 	}
 
 	s.Res = &response.RedisCluster
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -590,6 +591,8 @@ func (s *RedisRedisClusterResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.RedisClusterId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "redis")
 
 	response, err := s.Client.DeleteRedisCluster(context.Background(), request)
@@ -6,5 +6,5 @@ package redis
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_redis_redis_cluster", RedisRedisClusterResource())
+	tfresource.RegisterWorkRequestResource("oci_redis_redis_cluster", RedisRedisClusterResource())
 }
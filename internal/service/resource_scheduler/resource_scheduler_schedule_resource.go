@@ -580,6 +580,7 @@ func (s *ResourceSchedulerScheduleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Schedule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -674,6 +675,8 @@ func (s *ResourceSchedulerScheduleResourceCrud) Update() error {
 		request.TimeStarts = &oci_common.SDKTime{Time: tmp}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resource_scheduler")
 
 	response, err := s.Client.UpdateSchedule(context.Background(), request)
@@ -691,6 +694,8 @@ func (s *ResourceSchedulerScheduleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ScheduleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resource_scheduler")
 
 	_, err := s.Client.DeleteSchedule(context.Background(), request)
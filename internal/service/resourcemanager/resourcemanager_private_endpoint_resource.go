@@ -268,6 +268,7 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PrivateEndpoint
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -349,6 +350,8 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) Update() error {
 		request.VcnId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
 
 	response, err := s.Client.UpdatePrivateEndpoint(context.Background(), request)
@@ -366,6 +369,8 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PrivateEndpointId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
 
 	_, err := s.Client.DeletePrivateEndpoint(context.Background(), request)
@@ -6,5 +6,5 @@ package sch
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_sch_service_connector", SchServiceConnectorResource())
+	tfresource.RegisterWorkRequestResource("oci_sch_service_connector", SchServiceConnectorResource())
 }
@@ -820,6 +820,7 @@ func (s *SchServiceConnectorResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ServiceConnector
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -899,6 +900,8 @@ func (s *SchServiceConnectorResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
 	response, err := s.Client.UpdateServiceConnector(context.Background(), request)
@@ -916,6 +919,8 @@ func (s *SchServiceConnectorResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ServiceConnectorId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
 	response, err := s.Client.DeleteServiceConnector(context.Background(), request)
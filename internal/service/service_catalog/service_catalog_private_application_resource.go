@@ -421,6 +421,7 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PrivateApplication
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -471,6 +472,8 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) Update() error {
 		request.ShortDescription = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
 	response, err := s.Client.UpdatePrivateApplication(context.Background(), request)
@@ -491,6 +494,8 @@ func (s *ServiceCatalogPrivateApplicationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PrivateApplicationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
 	_, err := s.Client.DeletePrivateApplication(context.Background(), request)
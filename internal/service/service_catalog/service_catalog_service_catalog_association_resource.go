@@ -132,6 +132,7 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ServiceCatalogAssociation
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -141,6 +142,8 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ServiceCatalogAssociationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
 	_, err := s.Client.DeleteServiceCatalogAssociation(context.Background(), request)
@@ -181,6 +181,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ServiceCatalog
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -216,6 +217,8 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.ServiceCatalogId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
 	response, err := s.Client.UpdateServiceCatalog(context.Background(), request)
@@ -233,6 +236,8 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ServiceCatalogId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
 	_, err := s.Client.DeleteServiceCatalog(context.Background(), request)
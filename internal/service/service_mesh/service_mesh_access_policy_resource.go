@@ -522,6 +522,7 @@ func (s *ServiceMeshAccessPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AccessPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -574,6 +575,8 @@ func (s *ServiceMeshAccessPolicyResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.UpdateAccessPolicy(context.Background(), request)
@@ -591,6 +594,8 @@ func (s *ServiceMeshAccessPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AccessPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.DeleteAccessPolicy(context.Background(), request)
@@ -599,6 +599,7 @@ func (s *ServiceMeshIngressGatewayResourceCrud) Get() error {
 	}
 
 	s.Res = &response.IngressGateway
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -673,6 +674,8 @@ func (s *ServiceMeshIngressGatewayResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.UpdateIngressGateway(context.Background(), request)
@@ -690,6 +693,8 @@ func (s *ServiceMeshIngressGatewayResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IngressGatewayId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.DeleteIngressGateway(context.Background(), request)
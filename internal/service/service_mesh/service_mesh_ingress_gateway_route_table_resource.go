@@ -491,6 +491,7 @@ func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Get() error {
 	}
 
 	s.Res = &response.IngressGatewayRouteTable
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -549,6 +550,8 @@ func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.UpdateIngressGatewayRouteTable(context.Background(), request)
@@ -566,6 +569,8 @@ func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.IngressGatewayRouteTableId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.DeleteIngressGatewayRouteTable(context.Background(), request)
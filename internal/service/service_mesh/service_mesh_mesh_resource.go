@@ -409,6 +409,7 @@ func (s *ServiceMeshMeshResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Mesh
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -460,6 +461,8 @@ func (s *ServiceMeshMeshResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.UpdateMesh(context.Background(), request)
@@ -477,6 +480,8 @@ func (s *ServiceMeshMeshResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MeshId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.DeleteMesh(context.Background(), request)
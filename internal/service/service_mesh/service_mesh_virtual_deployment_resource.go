@@ -481,6 +481,7 @@ func (s *ServiceMeshVirtualDeploymentResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VirtualDeployment
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -555,6 +556,8 @@ func (s *ServiceMeshVirtualDeploymentResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VirtualDeploymentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.UpdateVirtualDeployment(context.Background(), request)
@@ -572,6 +575,8 @@ func (s *ServiceMeshVirtualDeploymentResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VirtualDeploymentId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.DeleteVirtualDeployment(context.Background(), request)
@@ -445,6 +445,7 @@ func (s *ServiceMeshVirtualServiceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VirtualService
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -515,6 +516,8 @@ func (s *ServiceMeshVirtualServiceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VirtualServiceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.UpdateVirtualService(context.Background(), request)
@@ -532,6 +535,8 @@ func (s *ServiceMeshVirtualServiceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VirtualServiceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.DeleteVirtualService(context.Background(), request)
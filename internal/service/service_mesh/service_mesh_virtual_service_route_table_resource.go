@@ -455,6 +455,7 @@ func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VirtualServiceRouteTable
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -513,6 +514,8 @@ func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VirtualServiceRouteTableId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.UpdateVirtualServiceRouteTable(context.Background(), request)
@@ -530,6 +533,8 @@ func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VirtualServiceRouteTableId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
 	response, err := s.Client.DeleteVirtualServiceRouteTable(context.Background(), request)
@@ -226,6 +226,7 @@ func (s *StackMonitoringBaselineableMetricResourceCrud) Get() error {
 	}
 
 	s.Res = &response.BaselineableMetric
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -302,6 +303,8 @@ func (s *StackMonitoringBaselineableMetricResourceCrud) Update() error {
 		request.TenancyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	response, err := s.Client.UpdateBaselineableMetric(context.Background(), request)
@@ -319,6 +322,8 @@ func (s *StackMonitoringBaselineableMetricResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.BaselineableMetricId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	_, err := s.Client.DeleteBaselineableMetric(context.Background(), request)
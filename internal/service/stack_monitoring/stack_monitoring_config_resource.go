@@ -204,6 +204,7 @@ func (s *StackMonitoringConfigResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Config
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -223,6 +224,8 @@ func (s *StackMonitoringConfigResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	response, err := s.Client.UpdateConfig(context.Background(), request)
@@ -240,6 +243,8 @@ func (s *StackMonitoringConfigResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ConfigId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	_, err := s.Client.DeleteConfig(context.Background(), request)
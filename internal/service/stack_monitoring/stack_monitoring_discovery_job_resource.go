@@ -374,6 +374,7 @@ func (s *StackMonitoringDiscoveryJobResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DiscoveryJob
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -383,6 +384,8 @@ func (s *StackMonitoringDiscoveryJobResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.DiscoveryJobId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	_, err := s.Client.DeleteDiscoveryJob(context.Background(), request)
@@ -565,6 +565,7 @@ func (s *StackMonitoringMetricExtensionResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MetricExtension
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 
 	return nil
 }
@@ -627,6 +628,8 @@ func (s *StackMonitoringMetricExtensionResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	response, err := s.Client.UpdateMetricExtension(context.Background(), request)
@@ -644,6 +647,8 @@ func (s *StackMonitoringMetricExtensionResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MetricExtensionId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	_, err := s.Client.DeleteMetricExtension(context.Background(), request)
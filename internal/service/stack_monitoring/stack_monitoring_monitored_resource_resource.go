@@ -783,6 +783,7 @@ func (s *StackMonitoringMonitoredResourceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MonitoredResource
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -919,6 +920,8 @@ func (s *StackMonitoringMonitoredResourceResourceCrud) Update() error {
 		request.ResourceTimeZone = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	response, err := s.Client.UpdateMonitoredResource(context.Background(), request)
@@ -941,6 +944,8 @@ func (s *StackMonitoringMonitoredResourceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MonitoredResourceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	response, err := s.Client.DeleteMonitoredResource(context.Background(), request)
@@ -440,6 +440,7 @@ func (s *StackMonitoringMonitoredResourceTaskResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MonitoredResourceTask
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -470,6 +471,8 @@ func (s *StackMonitoringMonitoredResourceTaskResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.MonitoredResourceTaskId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	response, err := s.Client.UpdateMonitoredResourceTask(context.Background(), request)
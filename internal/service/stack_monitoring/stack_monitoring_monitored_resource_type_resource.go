@@ -335,6 +335,7 @@ func (s *StackMonitoringMonitoredResourceTypeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.MonitoredResourceType
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -390,6 +391,8 @@ func (s *StackMonitoringMonitoredResourceTypeResourceCrud) Update() error {
 		request.SourceType = oci_stack_monitoring.SourceTypeEnum(sourceType.(string))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	response, err := s.Client.UpdateMonitoredResourceType(context.Background(), request)
@@ -407,6 +410,8 @@ func (s *StackMonitoringMonitoredResourceTypeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.MonitoredResourceTypeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	_, err := s.Client.DeleteMonitoredResourceType(context.Background(), request)
@@ -254,6 +254,7 @@ func (s *StackMonitoringProcessSetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ProcessSet
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -300,6 +301,8 @@ func (s *StackMonitoringProcessSetResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	response, err := s.Client.UpdateProcessSet(context.Background(), request)
@@ -317,6 +320,8 @@ func (s *StackMonitoringProcessSetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ProcessSetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
 	_, err := s.Client.DeleteProcessSet(context.Background(), request)
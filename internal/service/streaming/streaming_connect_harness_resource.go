@@ -198,6 +198,7 @@ func (s *StreamingConnectHarnessResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ConnectHarness
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -228,6 +229,8 @@ func (s *StreamingConnectHarnessResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
 	response, err := s.Client.UpdateConnectHarness(context.Background(), request)
@@ -245,6 +248,8 @@ func (s *StreamingConnectHarnessResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ConnectHarnessId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
 	_, err := s.Client.DeleteConnectHarness(context.Background(), request)
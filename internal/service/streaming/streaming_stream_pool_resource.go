@@ -337,6 +337,7 @@ func (s *StreamingStreamPoolResourceCrud) Get() error {
 	}
 
 	s.Res = &response.StreamPool
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -394,6 +395,8 @@ func (s *StreamingStreamPoolResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.StreamPoolId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
 	response, err := s.Client.UpdateStreamPool(context.Background(), request)
@@ -411,6 +414,8 @@ func (s *StreamingStreamPoolResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.StreamPoolId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
 	_, err := s.Client.DeleteStreamPool(context.Background(), request)
@@ -236,6 +236,7 @@ func (s *StreamingStreamResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Stream
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -271,6 +272,8 @@ func (s *StreamingStreamResourceCrud) Update() error {
 		request.StreamPoolId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
 	response, err := s.Client.UpdateStream(context.Background(), request)
@@ -288,6 +291,8 @@ func (s *StreamingStreamResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.StreamId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
 	_, err := s.Client.DeleteStream(context.Background(), request)
@@ -216,6 +216,8 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Delete() error {
 		request.TenancyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "usage_proxy")
 
 	_, err := s.Client.DeleteRedeemableUser(context.Background(), request)
@@ -447,6 +447,7 @@ func (s *VaultSecretResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Secret
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -534,6 +535,8 @@ func (s *VaultSecretResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vault")
 
 	response, err := s.Client.UpdateSecret(context.Background(), request)
@@ -376,6 +376,7 @@ func (s *VbsInstVbsInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VbsInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -421,6 +422,8 @@ func (s *VbsInstVbsInstanceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VbsInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vbs_inst")
 
 	response, err := s.Client.UpdateVbsInstance(context.Background(), request)
@@ -438,6 +441,8 @@ func (s *VbsInstVbsInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VbsInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vbs_inst")
 
 	response, err := s.Client.DeleteVbsInstance(context.Background(), request)
@@ -543,6 +543,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) Get() error {
 	}
 
 	s.Res = &response.VbInstance
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -621,6 +622,8 @@ func (s *VisualBuilderVbInstanceResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.VbInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
 	response, err := s.Client.UpdateVbInstance(context.Background(), request)
@@ -638,6 +641,8 @@ func (s *VisualBuilderVbInstanceResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.VbInstanceId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
 	response, err := s.Client.DeleteVbInstance(context.Background(), request)
@@ -6,6 +6,6 @@ package vn_monitoring
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_vn_monitoring_path_analysi", VnMonitoringPathAnalysiResource())
+	tfresource.RegisterWorkRequestResource("oci_vn_monitoring_path_analysi", VnMonitoringPathAnalysiResource())
 	tfresource.RegisterResource("oci_vn_monitoring_path_analyzer_test", VnMonitoringPathAnalyzerTestResource())
 }
@@ -465,6 +465,7 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) Get() error {
 	}
 
 	s.Res = &response.PathAnalyzerTest
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -549,6 +550,8 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vn_monitoring")
 
 	response, err := s.Client.UpdatePathAnalyzerTest(context.Background(), request)
@@ -566,6 +569,8 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.PathAnalyzerTestId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vn_monitoring")
 
 	_, err := s.Client.DeletePathAnalyzerTest(context.Background(), request)
@@ -6,8 +6,8 @@ package vulnerability_scanning
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
-	tfresource.RegisterResource("oci_vulnerability_scanning_container_scan_recipe", VulnerabilityScanningContainerScanRecipeResource())
-	tfresource.RegisterResource("oci_vulnerability_scanning_container_scan_target", VulnerabilityScanningContainerScanTargetResource())
+	tfresource.RegisterWorkRequestResource("oci_vulnerability_scanning_container_scan_recipe", VulnerabilityScanningContainerScanRecipeResource())
+	tfresource.RegisterWorkRequestResource("oci_vulnerability_scanning_container_scan_target", VulnerabilityScanningContainerScanTargetResource())
 	tfresource.RegisterResource("oci_vulnerability_scanning_host_scan_recipe", VulnerabilityScanningHostScanRecipeResource())
 	tfresource.RegisterResource("oci_vulnerability_scanning_host_scan_target", VulnerabilityScanningHostScanTargetResource())
 }
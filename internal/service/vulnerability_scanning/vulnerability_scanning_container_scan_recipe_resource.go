@@ -354,6 +354,7 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ContainerScanRecipe
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -405,6 +406,8 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
 	response, err := s.Client.UpdateContainerScanRecipe(context.Background(), request)
@@ -422,6 +425,8 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ContainerScanRecipeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
 	response, err := s.Client.DeleteContainerScanRecipe(context.Background(), request)
@@ -386,6 +386,7 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.ContainerScanTarget
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -442,6 +443,8 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
 	response, err := s.Client.UpdateContainerScanTarget(context.Background(), request)
@@ -459,6 +462,8 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.ContainerScanTargetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
 	response, err := s.Client.DeleteContainerScanTarget(context.Background(), request)
@@ -433,6 +433,7 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Get() error {
 	}
 
 	s.Res = &response.HostScanRecipe
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -512,6 +513,8 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
 	response, err := s.Client.UpdateHostScanRecipe(context.Background(), request)
@@ -646,6 +649,8 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.HostScanRecipeId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
 	response, err := s.Client.DeleteHostScanRecipe(context.Background(), request)
@@ -247,6 +247,7 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Get() error {
 	}
 
 	s.Res = &response.HostScanTarget
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -310,6 +311,8 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Update() error {
 		request.TargetCompartmentId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
 	response, err := s.Client.UpdateHostScanTarget(context.Background(), request)
@@ -421,6 +424,8 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.HostScanTargetId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
 	response, err := s.Client.DeleteHostScanTarget(context.Background(), request)
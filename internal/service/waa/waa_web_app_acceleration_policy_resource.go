@@ -413,6 +413,7 @@ func (s *WaaWebAppAccelerationPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.WebAppAccelerationPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -478,6 +479,8 @@ func (s *WaaWebAppAccelerationPolicyResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.WebAppAccelerationPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa")
 
 	response, err := s.Client.UpdateWebAppAccelerationPolicy(context.Background(), request)
@@ -495,6 +498,8 @@ func (s *WaaWebAppAccelerationPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.WebAppAccelerationPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa")
 
 	response, err := s.Client.DeleteWebAppAccelerationPolicy(context.Background(), request)
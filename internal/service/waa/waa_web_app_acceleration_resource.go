@@ -328,6 +328,7 @@ func (s *WaaWebAppAccelerationResourceCrud) Get() error {
 	}
 
 	s.Res = &response.WebAppAcceleration
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -376,6 +377,8 @@ func (s *WaaWebAppAccelerationResourceCrud) Update() error {
 		request.WebAppAccelerationPolicyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa")
 
 	response, err := s.Client.UpdateWebAppAcceleration(context.Background(), request)
@@ -393,6 +396,8 @@ func (s *WaaWebAppAccelerationResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.WebAppAccelerationId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa")
 
 	response, err := s.Client.DeleteWebAppAcceleration(context.Background(), request)
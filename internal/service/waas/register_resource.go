@@ -9,8 +9,8 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_waas_address_list", WaasAddressListResource())
 	tfresource.RegisterResource("oci_waas_certificate", WaasCertificateResource())
 	tfresource.RegisterResource("oci_waas_custom_protection_rule", WaasCustomProtectionRuleResource())
-	tfresource.RegisterResource("oci_waas_http_redirect", WaasHttpRedirectResource())
+	tfresource.RegisterWorkRequestResource("oci_waas_http_redirect", WaasHttpRedirectResource())
 	tfresource.RegisterResource("oci_waas_protection_rule", WaasProtectionRuleResource())
-	tfresource.RegisterResource("oci_waas_purge_cache", WaasPurgeCacheResource())
-	tfresource.RegisterResource("oci_waas_waas_policy", WaasWaasPolicyResource())
+	tfresource.RegisterWorkRequestResource("oci_waas_purge_cache", WaasPurgeCacheResource())
+	tfresource.RegisterWorkRequestResource("oci_waas_waas_policy", WaasWaasPolicyResource())
 }
@@ -205,6 +205,7 @@ func (s *WaasAddressListResourceCrud) Get() error {
 	}
 
 	s.Res = &response.AddressList
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -253,6 +254,8 @@ func (s *WaasAddressListResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
 	response, err := s.Client.UpdateAddressList(context.Background(), request)
@@ -270,6 +273,8 @@ func (s *WaasAddressListResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.AddressListId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
 	_, err := s.Client.DeleteAddressList(context.Background(), request)
@@ -373,6 +373,7 @@ func (s *WaasCertificateResourceCrud) Get() error {
 	}
 
 	s.Res = &response.Certificate
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -408,6 +409,8 @@ func (s *WaasCertificateResourceCrud) Update() error {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
 	response, err := s.Client.UpdateCertificate(context.Background(), request)
@@ -424,6 +427,8 @@ func (s *WaasCertificateResourceCrud) Delete() error {
 
 	tmp := s.D.Id()
 	request.CertificateId = &tmp
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, tfresource.WaasService, certificateService, globalvar.DeleteResource)
 
 	_, err := s.Client.DeleteCertificate(context.Background(), request)
@@ -207,6 +207,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) Get() error {
 	}
 
 	s.Res = &response.CustomProtectionRule
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -252,6 +253,8 @@ func (s *WaasCustomProtectionRuleResourceCrud) Update() error {
 		request.Template = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
 	response, err := s.Client.UpdateCustomProtectionRule(context.Background(), request)
@@ -269,6 +272,8 @@ func (s *WaasCustomProtectionRuleResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CustomProtectionRuleId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
 	_, err := s.Client.DeleteCustomProtectionRule(context.Background(), request)
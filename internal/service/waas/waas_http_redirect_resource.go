@@ -391,6 +391,7 @@ func (s *WaasHttpRedirectResourceCrud) Get() error {
 	}
 
 	s.Res = &response.HttpRedirect
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -442,6 +443,8 @@ func (s *WaasHttpRedirectResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
 	response, err := s.Client.UpdateHttpRedirect(context.Background(), request)
@@ -459,6 +462,8 @@ func (s *WaasHttpRedirectResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.HttpRedirectId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
 	response, err := s.Client.DeleteHttpRedirect(context.Background(), request)
@@ -348,6 +348,8 @@ func (s *WaasProtectionRuleResourceCrud) Update() error {
 
 	request.ProtectionRules = append(request.ProtectionRules, protectionRuleAction)
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
 	response, err := s.Client.UpdateProtectionRules(context.Background(), request)
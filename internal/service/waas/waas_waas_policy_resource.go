@@ -1648,6 +1648,7 @@ func (s *WaasWaasPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.WaasPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1734,6 +1735,8 @@ func (s *WaasWaasPolicyResourceCrud) Update() error {
 		}
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
 	response, err := s.Client.UpdateWaasPolicy(context.Background(), request)
@@ -1795,6 +1798,8 @@ func (s *WaasWaasPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.WaasPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
 	response, err := s.Client.DeleteWaasPolicy(context.Background(), request)
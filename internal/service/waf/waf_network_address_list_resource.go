@@ -347,6 +347,7 @@ func (s *WafNetworkAddressListResourceCrud) Get() error {
 	}
 
 	s.Res = &response.NetworkAddressList
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -366,6 +367,8 @@ func (s *WafNetworkAddressListResourceCrud) Update() error {
 		return err
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
 	response, err := s.Client.UpdateNetworkAddressList(context.Background(), request)
@@ -383,6 +386,8 @@ func (s *WafNetworkAddressListResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.NetworkAddressListId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
 	response, err := s.Client.DeleteNetworkAddressList(context.Background(), request)
@@ -1075,6 +1075,7 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Get() error {
 	}
 
 	s.Res = &response.WebAppFirewallPolicy
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -1190,6 +1191,8 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.WebAppFirewallPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
 	response, err := s.Client.UpdateWebAppFirewallPolicy(context.Background(), request)
@@ -1207,6 +1210,8 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.WebAppFirewallPolicyId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
 	response, err := s.Client.DeleteWebAppFirewallPolicy(context.Background(), request)
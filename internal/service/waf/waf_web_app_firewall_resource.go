@@ -324,6 +324,7 @@ func (s *WafWebAppFirewallResourceCrud) Get() error {
 	}
 
 	s.Res = &response.WebAppFirewall
+	tfresource.SetIfMatchETag(s.D, response.Etag)
 	return nil
 }
 
@@ -372,6 +373,8 @@ func (s *WafWebAppFirewallResourceCrud) Update() error {
 		request.WebAppFirewallPolicyId = &tmp
 	}
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
 	response, err := s.Client.UpdateWebAppFirewall(context.Background(), request)
@@ -389,6 +392,8 @@ func (s *WafWebAppFirewallResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.WebAppFirewallId = &tmp
 
+	request.IfMatch = tfresource.IfMatchETag(s.D)
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
 	response, err := s.Client.DeleteWebAppFirewall(context.Background(), request)
@@ -0,0 +1,121 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+)
+
+// The adapters run the CRUD types that do not take a context. The waiters of the CRUD helpers still stop when the
+// context is done; the SDK requests of the CRUD types are cancelled by their clients instead, when the clients come from
+// the provider meta passed to the resource operation (see OperationClientsProvider). The adapters are unwrapped to
+// check for the optional interfaces of the CRUD type, e.g. StatefullyCreatedResource or SynchronizedResource.
+
+type resourceCreatorAdapter struct {
+	ResourceCreator
+}
+
+func (a resourceCreatorAdapter) CreateWithContext(ctx context.Context) error {
+	return a.Create()
+}
+
+func (a resourceCreatorAdapter) unwrap() interface{} {
+	return a.ResourceCreator
+}
+
+type resourceReaderAdapter struct {
+	ResourceReader
+}
+
+func (a resourceReaderAdapter) GetWithContext(ctx context.Context) error {
+	return a.Get()
+}
+
+func (a resourceReaderAdapter) unwrap() interface{} {
+	return a.ResourceReader
+}
+
+type resourceFetcherAdapter struct {
+	ResourceFetcher
+}
+
+func (a resourceFetcherAdapter) GetWithContext(ctx context.Context) error {
+	return a.Get()
+}
+
+type resourceUpdaterAdapter struct {
+	ResourceUpdater
+}
+
+func (a resourceUpdaterAdapter) UpdateWithContext(ctx context.Context) error {
+	return a.Update()
+}
+
+func (a resourceUpdaterAdapter) unwrap() interface{} {
+	return a.ResourceUpdater
+}
+
+type resourceDeleterAdapter struct {
+	ResourceDeleter
+}
+
+func (a resourceDeleterAdapter) DeleteWithContext(ctx context.Context) error {
+	return a.Delete()
+}
+
+func (a resourceDeleterAdapter) unwrap() interface{} {
+	return a.ResourceDeleter
+}
+
+// AdaptResourceCreator adapts a ResourceCreator to ResourceCreatorWithContext
+func AdaptResourceCreator(sync ResourceCreator) ResourceCreatorWithContext {
+	if syncWithContext, ok := sync.(ResourceCreatorWithContext); ok {
+		return syncWithContext
+	}
+	return resourceCreatorAdapter{sync}
+}
+
+// AdaptResourceReader adapts a ResourceReader to ResourceReaderWithContext
+func AdaptResourceReader(sync ResourceReader) ResourceReaderWithContext {
+	if syncWithContext, ok := sync.(ResourceReaderWithContext); ok {
+		return syncWithContext
+	}
+	return resourceReaderAdapter{sync}
+}
+
+// AdaptResourceUpdater adapts a ResourceUpdater to ResourceUpdaterWithContext
+func AdaptResourceUpdater(sync ResourceUpdater) ResourceUpdaterWithContext {
+	if syncWithContext, ok := sync.(ResourceUpdaterWithContext); ok {
+		return syncWithContext
+	}
+	return resourceUpdaterAdapter{sync}
+}
+
+// AdaptResourceDeleter adapts a ResourceDeleter to ResourceDeleterWithContext
+func AdaptResourceDeleter(sync ResourceDeleter) ResourceDeleterWithContext {
+	if syncWithContext, ok := sync.(ResourceDeleterWithContext); ok {
+		return syncWithContext
+	}
+	return resourceDeleterAdapter{sync}
+}
+
+// unwrapCrud returns the CRUD type adapted to a context-aware interface, or the given one if it is not adapted
+func unwrapCrud(sync interface{}) interface{} {
+	if adapter, ok := sync.(interface{ unwrap() interface{} }); ok {
+		return adapter.unwrap()
+	}
+	return sync
+}
+
+// asResourceDeleterWithContext returns the CRUD type as a ResourceDeleterWithContext, if it can delete the resource
+func asResourceDeleterWithContext(sync interface{}) (ResourceDeleterWithContext, bool) {
+	crud := unwrapCrud(sync)
+	if deleter, ok := crud.(ResourceDeleterWithContext); ok {
+		return deleter, true
+	}
+	if deleter, ok := crud.(ResourceDeleter); ok {
+		return AdaptResourceDeleter(deleter), true
+	}
+	return nil, false
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// contextResourceCrud records the context its operations are called with
+type contextResourceCrud struct {
+	d   *workRequestFailureResourceData
+	ctx context.Context
+}
+
+func (s *contextResourceCrud) CreateWithContext(ctx context.Context) error {
+	s.ctx = ctx
+	s.d.SetId("ocid1.instance.1")
+	return nil
+}
+
+func (s *contextResourceCrud) GetWithContext(ctx context.Context) error {
+	s.ctx = ctx
+	return nil
+}
+
+func (s *contextResourceCrud) ID() string {
+	return s.d.Id()
+}
+
+func (s *contextResourceCrud) SetData() error {
+	return nil
+}
+
+func (s *contextResourceCrud) VoidState() {
+	s.d.SetId("")
+}
+
+// contextFetcherCrud records the context it is fetched with
+type contextFetcherCrud struct {
+	ctx     context.Context
+	fetches int
+}
+
+func (s *contextFetcherCrud) GetWithContext(ctx context.Context) error {
+	s.fetches++
+	s.ctx = ctx
+	return nil
+}
+
+// legacyFetcherCrud is a CRUD type that does not take a context, counting the times it is fetched
+type legacyFetcherCrud struct {
+	d       *schema.ResourceData
+	fetches int
+}
+
+func (s *legacyFetcherCrud) Get() error {
+	s.fetches++
+	return nil
+}
+
+func (s *legacyFetcherCrud) ResourceData() *schema.ResourceData {
+	return s.d
+}
+
+func TestUnitAdaptResourceCreator(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The CRUD types that do not take a context are adapted to the context-aware interfaces
+	legacy := &workRequestFailureResourceCrud{d: &workRequestFailureResourceData{}}
+	adapted := AdaptResourceCreator(legacy)
+	assert.Equal(t, legacy, unwrapCrud(adapted))
+	assert.NoError(t, adapted.CreateWithContext(ctx))
+	assert.Equal(t, 1, legacy.creations)
+	_, ok := asResourceDeleterWithContext(adapted)
+	assert.True(t, ok, "expected the adapted CRUD type to keep its optional interfaces")
+
+	// The CRUD types that can not delete the resource are not cleaned up after failing to be created
+	withContext := &contextResourceCrud{d: &workRequestFailureResourceData{}}
+	assert.Equal(t, withContext, unwrapCrud(withContext))
+	_, ok = asResourceDeleterWithContext(withContext)
+	assert.False(t, ok)
+}
+
+func TestUnitCreateResourceWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := &workRequestFailureResourceData{}
+	sync := &contextResourceCrud{d: d}
+	assert.Empty(t, CreateResourceWithContext(ctx, d, sync))
+	assert.Equal(t, ctx, sync.ctx)
+	assert.Equal(t, "ocid1.instance.1", d.Id())
+
+	assert.Empty(t, ReadResourceWithContext(ctx, sync))
+	assert.Equal(t, ctx, sync.ctx)
+}
+
+func TestUnitWaitForResourceConditionWithContext(t *testing.T) {
+	tests := []struct {
+		name        string
+		cancel      bool
+		wantErr     error
+		wantFetches int
+	}{
+		{
+			name:        "Test condition met",
+			wantFetches: 1,
+		},
+		{
+			name:        "Test operation interrupted",
+			cancel:      true,
+			wantErr:     context.Canceled,
+			wantFetches: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				cancel()
+			}
+
+			sync := &contextFetcherCrud{}
+			err := WaitForResourceConditionWithContext(ctx, sync, func() bool { return !tt.cancel }, time.Minute)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantFetches, sync.fetches)
+			assert.Equal(t, ctx, sync.ctx)
+		})
+	}
+}
+
+func TestUnitWithOperationContext_legacyWaiters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The waiters called by the legacy CRUD functions stop when the operation is interrupted
+	sync := &legacyFetcherCrud{d: (&schema.Resource{Schema: map[string]*schema.Schema{}}).Data(nil)}
+	var waitErr error
+	update := withOperationContext(func(d *schema.ResourceData, m interface{}) error {
+		assert.Equal(t, ctx, operationContext(d))
+		waitErr = WaitForResourceCondition(sync, func() bool { return false }, time.Hour)
+		return nil
+	}, false)
+	assert.Empty(t, update(ctx, sync.d, nil))
+	assert.Equal(t, context.Canceled, waitErr)
+	assert.Equal(t, 1, sync.fetches)
+
+	// and use no context outside of an operation
+	assert.Equal(t, context.Background(), operationContext(sync.d))
+	assert.Empty(t, resourceOperations)
+
+	start := time.Now()
+	sleepWithContext(ctx, time.Hour)
+	assert.True(t, time.Since(start) < time.Minute, "expected the sleep to stop when the context is done")
+}
@@ -27,6 +27,7 @@ import (
 
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -56,7 +57,7 @@ var (
 	}
 	convertResFieldsToDSFields             = convertResourceFieldsToDatasourceFields
 	jsonMarshal                            = json.Marshal
-	waitForStateRefreshVar                 = WaitForStateRefresh
+	waitForStateRefreshVar                 = WaitForStateRefreshWithContext
 	WaitForWorkRequestVar                  = WaitForWorkRequest
 	getWorkRequestErrorsVar                = getWorkRequestErrors
 	waitForStateRefreshForHybridPollingVar = waitForStateRefreshForHybridPolling
@@ -86,6 +87,10 @@ func (s *BaseCrud) VoidState() {
 	s.D.SetId("")
 }
 
+func (s *BaseCrud) ResourceData() *schema.ResourceData {
+	return s.D
+}
+
 // Default implementation, used in conjunction with State()
 func (s *BaseCrud) setState(sync StatefulResource) error {
 	// Pseudo code:
@@ -148,7 +153,7 @@ func waitForStateRefreshForHybridPolling(workRequestClient workReqClient, workRe
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
-		Refresh: stateRefreshFuncVar(sync),
+		Refresh: stateRefreshFuncVar(workRequestClientContext(workRequestClient), sync),
 		Timeout: timeout,
 	}
 
@@ -162,7 +167,7 @@ func waitForStateRefreshForHybridPolling(workRequestClient workReqClient, workRe
 		if _, ok := e.(*resource.UnexpectedStateError); ok {
 			retryPolicy := GetRetryPolicy(disableFoundRetries, "work_request")
 			retryPolicy.ShouldRetryOperation = workRequestShouldRetryFunc(timeout)
-			e = getWorkRequestErrorsVar(workRequestClient, workRequestIds, retryPolicy, entityType, action)
+			e = getWorkRequestErrorsVar(workRequestClientContext(workRequestClient), workRequestClient, workRequestIds, retryPolicy, entityType, action)
 			return e
 		}
 
@@ -208,7 +213,7 @@ func ResourceRefreshForHybridPolling(workRequestClient workReqClient, workReques
 	}
 
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+		sleepWithContext(operationContext(d), ew.ExtraWaitPostCreateDelete())
 	}
 
 	return nil
@@ -223,25 +228,91 @@ func CreateResourceUsingHybridPolling(sync ResourceCreator) error {
 }
 
 func CreateResource(d schemaResourceData, sync ResourceCreator) error {
-	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
+	ctx := operationContext(d)
+	warnings, err := createResourceWithContext(ctx, d, AdaptResourceCreator(sync))
+	reportWarnings(d, append(warnings, resourceWarnings(sync, nil)...))
+	return err
+}
+
+// CreateResourceWithContext creates the resource, and returns the errors and warnings of the creation as diagnostics
+func CreateResourceWithContext(ctx context.Context, d schemaResourceData, sync ResourceCreatorWithContext) diag.Diagnostics {
+	warnings, err := createResourceWithContext(ctx, d, sync)
+	return append(append(DiagnosticsFromError(err), warnings...), resourceWarnings(unwrapCrud(sync), d)...)
+}
+
+// createResourceWithContext creates the resource, and returns a warning listing the attempts whose work request failed
+// if it was created again after them
+func createResourceWithContext(ctx context.Context, d schemaResourceData, sync ResourceCreatorWithContext) (warnings diag.Diagnostics, err error) {
+	crud := unwrapCrud(sync)
+	ctx, span := startCrudSpan(ctx, "CreateResource", crud)
+	defer func() { endCrudSpan(span, d, err) }()
+
+	if synchronizedResource, ok := crud.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
 			defer mutex.Unlock()
 		}
 	}
 
-	if e := sync.Create(); e != nil {
-		return HandleError(sync, e)
+	retryPolicy := workRequestFailureRetryPolicy(d)
+	var attempts []string
+	for attempt := 1; ; attempt++ {
+		var failure *failedCreation
+		failure, err = createResourceOnce(ctx, d, sync)
+		if failure == nil {
+			break
+		}
+		attempts = append(attempts, fmt.Sprintf("  attempt %d: %v", attempt, failure.cause))
+		span.SetAttribute("oci.work_request.failed_attempts", attempt)
+		if retryPolicy == nil || attempt > retryPolicy.MaxRetries || !retryPolicy.isRetriable(failure.cause) || ctx.Err() != nil {
+			break
+		}
+
+		if cleanUpErr := cleanUpFailedCreation(ctx, d, sync, failure); cleanUpErr != nil {
+			// The failed resource is kept in the state, so that it is not left dangling
+			err = fmt.Errorf("%v\nthe resource that failed to be created could not be deleted before creating it again: %v", err, cleanUpErr)
+			break
+		}
+		backoff := retryPolicy.backoff(attempt)
+		log.Printf("[WARN] the work request to create the resource failed, creating it again in %v (retry %d of %d): %v", backoff, attempt, retryPolicy.MaxRetries, failure.cause)
+		workRequestFailureRetrySleepVar(ctx, backoff)
+	}
+
+	if err != nil {
+		if len(attempts) > 1 {
+			err = fmt.Errorf("%v\n\n%s", err, describeWorkRequestFailureAttempts(attempts))
+		}
+		return nil, err
+	}
+	if len(attempts) > 0 {
+		summary := fmt.Sprintf("The resource was created after %d failed attempt(s)", len(attempts))
+		detail := fmt.Sprintf("%s\n  attempt %d: succeeded", describeWorkRequestFailureAttempts(attempts), len(attempts)+1)
+		log.Printf("[WARN] %s\n%s", summary, detail)
+		warnings = append(warnings, diag.Diagnostic{Severity: diag.Warning, Summary: summary, Detail: detail})
+	}
+	return warnings, nil
+}
+
+// createResourceOnce creates the resource once. The failure is returned along with the error if the work request of the
+// creation did not succeed.
+func createResourceOnce(ctx context.Context, d schemaResourceData, sync ResourceCreatorWithContext) (*failedCreation, error) {
+	crud := unwrapCrud(sync)
+	if e := sync.CreateWithContext(ctx); e != nil {
+		if isWorkRequestFailure(e) {
+			return &failedCreation{resourceId: failedResourceId(d, sync, e), cause: e}, HandleError(crud, e)
+		}
+		return nil, HandleError(crud, e)
 	}
 
 	// ID is required for state refresh
 	d.SetId(sync.ID())
 
-	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+	if stateful, ok := crud.(StatefullyCreatedResource); ok {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+			var failure *failedCreation
 			if stateful.State() == FAILED {
+				failure = &failedCreation{resourceId: failedResourceId(d, sync, e), cause: e}
 				// Remove resource from state if asynchronous work request has failed so that it is recreated on next apply
-				// TODO: automatic retry on WorkRequestFailed
 				sync.VoidState()
 			}
 
@@ -250,26 +321,43 @@ func CreateResource(d schemaResourceData, sync ResourceCreator) error {
 				log.Printf("[ERROR] error setting data after WaitForStateRefresh() error: %v", setDataErr)
 			}
 
-			return e
+			return failure, e
 		}
 	}
 
 	d.SetId(sync.ID())
 	if e := sync.SetData(); e != nil {
-		return e
+		return nil, e
 	}
 
-	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+	if ew, waitOK := crud.(ExtraWaitPostCreateDelete); waitOK {
+		sleepWithContext(ctx, ew.ExtraWaitPostCreateDelete())
 	}
-	return nil
+	return nil, nil
 }
 
 func ReadResource(sync ResourceReader) error {
-	if e := sync.Get(); e != nil {
+	ctx := operationContext(crudResourceData(sync))
+	err := readResourceWithContext(ctx, AdaptResourceReader(sync))
+	reportWarnings(crudResourceData(sync), resourceWarnings(sync, nil))
+	return err
+}
+
+// ReadResourceWithContext reads the resource, and returns the errors and warnings of the read as diagnostics
+func ReadResourceWithContext(ctx context.Context, sync ResourceReaderWithContext) diag.Diagnostics {
+	err := readResourceWithContext(ctx, sync)
+	return append(DiagnosticsFromError(err), resourceWarnings(unwrapCrud(sync), nil)...)
+}
+
+func readResourceWithContext(ctx context.Context, sync ResourceReaderWithContext) (err error) {
+	crud := unwrapCrud(sync)
+	ctx, span := startCrudSpan(ctx, "ReadResource", crud)
+	defer func() { endCrudSpan(span, nil, err) }()
+
+	if e := sync.GetWithContext(ctx); e != nil {
 		log.Printf("ERROR IN GET: %v\n", e.Error())
 		handleMissingResourceError(sync, &e)
-		return HandleError(sync, e)
+		return HandleError(crud, e)
 	}
 
 	if e := sync.SetData(); e != nil {
@@ -277,7 +365,7 @@ func ReadResource(sync ResourceReader) error {
 	}
 
 	// Remove resource from state if it has been terminated so that it is recreated on next apply
-	if dr, ok := sync.(StatefullyDeletedResource); ok {
+	if dr, ok := crud.(StatefullyDeletedResource); ok {
 		for _, target := range dr.DeletedTarget() {
 			if dr.State() == target && dr.State() != string(oci_load_balancer.WorkRequestLifecycleStateSucceeded) {
 				dr.VoidState()
@@ -290,7 +378,24 @@ func ReadResource(sync ResourceReader) error {
 }
 
 func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
-	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
+	ctx := operationContext(d)
+	err := updateResourceWithContext(ctx, d, AdaptResourceUpdater(sync))
+	reportWarnings(d, resourceWarnings(sync, nil))
+	return err
+}
+
+// UpdateResourceWithContext updates the resource, and returns the errors and warnings of the update as diagnostics
+func UpdateResourceWithContext(ctx context.Context, d schemaResourceData, sync ResourceUpdaterWithContext) diag.Diagnostics {
+	err := updateResourceWithContext(ctx, d, sync)
+	return append(DiagnosticsFromError(err), resourceWarnings(unwrapCrud(sync), d)...)
+}
+
+func updateResourceWithContext(ctx context.Context, d schemaResourceData, sync ResourceUpdaterWithContext) (err error) {
+	crud := unwrapCrud(sync)
+	ctx, span := startCrudSpan(ctx, "UpdateResource", crud)
+	defer func() { endCrudSpan(span, d, err) }()
+
+	if synchronizedResource, ok := crud.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
 			defer mutex.Unlock()
@@ -298,14 +403,14 @@ func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
 	}
 
 	d.Partial(true)
-	if e := sync.Update(); e != nil {
+	if e := sync.UpdateWithContext(ctx); e != nil {
 
-		return HandleError(sync, e)
+		return HandleError(crud, e)
 	}
 	d.Partial(false)
 
-	if stateful, ok := sync.(StatefullyUpdatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
+	if stateful, ok := crud.(StatefullyUpdatedResource); ok {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
 
 			return e
 		}
@@ -323,31 +428,49 @@ func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
 // () -> Pending -> Deleted.
 // Finally, sets the ResourceData state to empty.
 func DeleteResource(d schemaResourceData, sync ResourceDeleter) error {
-	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
+	return deleteResourceWithContext(operationContext(d), d, AdaptResourceDeleter(sync))
+}
+
+// DeleteResourceWithContext deletes the resource, and returns the errors of the deletion as diagnostics
+func DeleteResourceWithContext(ctx context.Context, d schemaResourceData, sync ResourceDeleterWithContext) diag.Diagnostics {
+	return DiagnosticsFromError(deleteResourceWithContext(ctx, d, sync))
+}
+
+func deleteResourceWithContext(ctx context.Context, d schemaResourceData, sync ResourceDeleterWithContext) (err error) {
+	crud := unwrapCrud(sync)
+	ctx, span := startCrudSpan(ctx, "DeleteResource", crud)
+	defer func() { endCrudSpan(span, d, err) }()
+
+	if synchronizedResource, ok := crud.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
 			defer mutex.Unlock()
 		}
 	}
 
-	if e := sync.Delete(); e != nil {
+	return deleteResource(ctx, d, sync)
+}
+
+func deleteResource(ctx context.Context, d schemaResourceData, sync ResourceDeleterWithContext) error {
+	crud := unwrapCrud(sync)
+	if e := sync.DeleteWithContext(ctx); e != nil {
 		handleMissingResourceError(sync, &e)
-		return HandleError(sync, e)
+		return HandleError(crud, e)
 	}
 
-	if stateful, ok := sync.(StatefullyDeletedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutDelete), "deletion", stateful.DeletedPending(), stateful.DeletedTarget()); e != nil {
+	if stateful, ok := crud.(StatefullyDeletedResource); ok {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutDelete), "deletion", stateful.DeletedPending(), stateful.DeletedTarget()); e != nil {
 			handleMissingResourceError(sync, &e)
 			return e
 		}
 	}
 
-	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+	if ew, waitOK := crud.(ExtraWaitPostCreateDelete); waitOK {
+		sleepWithContext(ctx, ew.ExtraWaitPostCreateDelete())
 	}
 
-	if ew, waitOK := sync.(ExtraWaitPostDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostDelete())
+	if ew, waitOK := crud.(ExtraWaitPostDelete); waitOK {
+		sleepWithContext(ctx, ew.ExtraWaitPostDelete())
 	}
 
 	sync.VoidState()
@@ -355,9 +478,37 @@ func DeleteResource(d schemaResourceData, sync ResourceDeleter) error {
 	return nil
 }
 
-func stateRefreshFunc(sync StatefulResource) resource.StateRefreshFunc {
+// startCrudSpan starts the span of a CRUD helper, as a child of the span of the resource operation carried by ctx; the
+// SDK calls, retries and waits made with the returned context are its children. The CRUD helpers that do not take a
+// context get no span, their SDK calls are children of the span of the resource operation.
+func startCrudSpan(ctx context.Context, name string, sync interface{}) (context.Context, *utils.Span) {
+	ctx, span := utils.StartChildSpan(ctx, name, utils.SpanKindInternal)
+	span.SetAttribute("oci.resource.crud", fmt.Sprintf("%T", sync))
+	return ctx, span
+}
+
+func endCrudSpan(span *utils.Span, d schemaResourceData, err error) {
+	if id := resourceDataId(d); id != "" {
+		span.SetAttribute("oci.resource.id", id)
+	}
+	span.End(err)
+}
+
+func resourceDataId(d schemaResourceData) string {
+	if resourceData, ok := d.(interface{ Id() string }); ok {
+		return resourceData.Id()
+	}
+	return ""
+}
+
+// stateRefreshFunc fetches the resource with the context, if it takes one
+func stateRefreshFunc(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
+	fetcher := ResourceFetcherWithContext(resourceFetcherAdapter{sync})
+	if fetcherWithContext, ok := sync.(ResourceFetcherWithContext); ok {
+		fetcher = fetcherWithContext
+	}
 	return func() (res interface{}, s string, e error) {
-		if e = sync.Get(); e != nil {
+		if e = fetcher.GetWithContext(ctx); e != nil {
 			return nil, "", e
 		}
 		// We don't set all the state here, because not found errors are handled elsewhere.
@@ -372,8 +523,13 @@ func stateRefreshFunc(sync StatefulResource) resource.StateRefreshFunc {
 // Helper function to wait for Update to reach terminal state before doing another Update
 // Useful in situations where more than one Update is needed and prior Update needs to complete
 func WaitForUpdatedState(d schemaResourceData, sync ResourceUpdater) error {
-	if stateful, ok := sync.(StatefullyUpdatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
+	return WaitForUpdatedStateWithContext(operationContext(d), d, AdaptResourceUpdater(sync))
+}
+
+// WaitForUpdatedStateWithContext is WaitForUpdatedState, stopping when the context is done
+func WaitForUpdatedStateWithContext(ctx context.Context, d schemaResourceData, sync ResourceUpdaterWithContext) error {
+	if stateful, ok := unwrapCrud(sync).(StatefullyUpdatedResource); ok {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
 			return e
 		}
 	}
@@ -386,7 +542,7 @@ func WaitForUpdatedState(d schemaResourceData, sync ResourceUpdater) error {
 func WaitForCreatedState(d schemaResourceData, sync ResourceCreator) error {
 	d.SetId(sync.ID())
 	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+		if e := waitForStateRefreshVar(operationContext(d), stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
 			return e
 		}
 	}
@@ -399,11 +555,23 @@ func WaitForCreatedState(d schemaResourceData, sync ResourceCreator) error {
 // sync.D.Id must be set.
 // It does not set state from that refreshed state.
 func WaitForStateRefresh(sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) error {
+	return WaitForStateRefreshWithContext(operationContext(crudResourceData(sync)), sync, timeout, operationName, pending, target)
+}
+
+// WaitForStateRefreshWithContext is WaitForStateRefresh, stopping when the context is done
+func WaitForStateRefreshWithContext(ctx context.Context, sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) (err error) {
+	ctx, span := utils.StartChildSpan(ctx, "WaitForStateRefresh", utils.SpanKindInternal)
+	span.SetAttribute("oci.operation", operationName)
+	defer func() {
+		span.SetAttribute("oci.resource.state", sync.State())
+		span.End(err)
+	}()
+
 	// TODO: try to move this onto sync
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
-		Refresh: stateRefreshFuncVar(sync),
+		Refresh: stateRefreshFuncVar(ctx, sync),
 		Timeout: timeout,
 	}
 
@@ -412,7 +580,7 @@ func WaitForStateRefresh(sync StatefulResource, timeout time.Duration, operation
 		stateConf.PollInterval = 1
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		handleMissingResourceError(sync, &e)
 		if _, ok := e.(*resource.UnexpectedStateError); ok {
 			if len(target) > 0 {
@@ -773,12 +941,19 @@ func NormalizeBoolString(v string) (string, error) {
 // backoff. The terminating condition is specified as a boolean function; and this will return a timeout error if the
 // specified condition isn't reached within the specified timeout period.
 func WaitForResourceCondition(s ResourceFetcher, resourceChangedFunc func() bool, timeout time.Duration) error {
+	return WaitForResourceConditionWithContext(operationContext(crudResourceData(s)), resourceFetcherAdapter{s}, resourceChangedFunc, timeout)
+}
+
+// WaitForResourceConditionWithContext is WaitForResourceCondition, fetching the resource with the context and
+// stopping when it is done
+func WaitForResourceConditionWithContext(ctx context.Context, s ResourceFetcherWithContext, resourceChangedFunc func() bool, timeout time.Duration) error {
+
 	backoffTime := time.Second
 	startTime := time.Now()
 	endTime := startTime.Add(timeout)
 	lastAttempt := false
 	for {
-		if err := s.Get(); err != nil {
+		if err := s.GetWithContext(ctx); err != nil {
 			return err
 		}
 
@@ -803,7 +978,11 @@ func WaitForResourceCondition(s ResourceFetcher, resourceChangedFunc func() bool
 			backoffTime = 10 * time.Millisecond
 		}
 
-		time.Sleep(backoffTime)
+		select {
+		case <-time.After(backoffTime):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
 
 	return nil
@@ -822,6 +1001,10 @@ func GetDataSourceItemSchema(resourceSchema *schema.Resource) *schema.Resource {
 	// as though they were resources.
 	resourceSchema.Create = nil
 	resourceSchema.Read = nil
+	resourceSchema.CreateContext = nil
+	resourceSchema.ReadContext = nil
+	resourceSchema.UpdateContext = nil
+	resourceSchema.DeleteContext = nil
 
 	return convertResFieldsToDSFields(resourceSchema)
 }
@@ -841,6 +1024,10 @@ func GetSingularDataSourceItemSchema(resourceSchema *schema.Resource, addFieldMa
 	resourceSchema.Update = nil
 	resourceSchema.Delete = nil
 	resourceSchema.Read = readFunc
+	resourceSchema.CreateContext = nil
+	resourceSchema.ReadContext = nil
+	resourceSchema.UpdateContext = nil
+	resourceSchema.DeleteContext = nil
 	resourceSchema.Importer = nil
 	resourceSchema.Timeouts = nil
 	resourceSchema.CustomizeDiff = nil
@@ -932,6 +1119,17 @@ func WaitForWorkRequestWithErrorHandling(workRequestClient workReqClient, workRe
 
 func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, expectIdentifier bool) (*string, error) {
+	return WaitForWorkRequestWithContext(workRequestClientContext(workRequestClient), workRequestClient, workRequestId, entityType, action, timeout, disableFoundRetries, expectIdentifier)
+}
+
+// WaitForWorkRequestWithContext is WaitForWorkRequest, stopping when the context is done
+func WaitForWorkRequestWithContext(ctx context.Context, workRequestClient workReqClient, workRequestId *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
+	timeout time.Duration, disableFoundRetries bool, expectIdentifier bool) (identifier *string, err error) {
+	ctx, span := utils.StartChildSpan(ctx, "WaitForWorkRequest", utils.SpanKindInternal)
+	span.SetAttribute("oci.work_request.id", *workRequestId)
+	span.SetAttribute("oci.work_request.entity_type", entityType)
+	defer func() { span.End(err) }()
+
 	retryPolicy := GetRetryPolicy(disableFoundRetries, "work_request")
 	retryPolicy.ShouldRetryOperation = workRequestShouldRetryFunc(timeout)
 
@@ -949,7 +1147,7 @@ func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = workRequestClient.GetWorkRequest(context.Background(),
+			response, err = workRequestClient.GetWorkRequest(ctx,
 				oci_work_requests.GetWorkRequestRequest{
 					WorkRequestId: workRequestId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -962,9 +1160,7 @@ func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string,
 		Timeout: timeout,
 	}
 
-	var identifier *string
-
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
 		for _, res := range response.Resources {
 			if strings.Contains(strings.ToLower(*res.EntityType), strings.ToLower(entityType)) {
 				if res.Identifier != nil {
@@ -992,7 +1188,9 @@ func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string,
 			return nil, fmt.Errorf("work request succeeded but no identifier was found, workId: %s, entity: %s, action: %s",
 				*workRequestId, entityType, action)
 		}
-		return nil, getWorkRequestErrorsVar(workRequestClient, workRequestId, retryPolicy, entityType, action)
+		err = getWorkRequestErrorsVar(ctx, workRequestClient, workRequestId, retryPolicy, entityType, action)
+		setFailedResourceId(err, response.Resources, entityType)
+		return nil, err
 	}
 
 	return identifier, nil
@@ -1004,7 +1202,7 @@ func GetResourceIDFromWorkRequest(workRequestClient workReqClient, workRequestId
 
 	response := oci_work_requests.GetWorkRequestResponse{}
 	var err error
-	response, err = workRequestClient.GetWorkRequest(context.Background(),
+	response, err = workRequestClient.GetWorkRequest(workRequestClientContext(workRequestClient),
 		oci_work_requests.GetWorkRequestRequest{
 			WorkRequestId: workRequestId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -1047,8 +1245,8 @@ func workRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.
 	}
 }
 
-func getWorkRequestErrors(workRequestClient workReqClient, workRequestId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum) error {
-	response, err := workRequestClient.ListWorkRequestErrors(context.Background(), oci_work_requests.ListWorkRequestErrorsRequest{
+func getWorkRequestErrors(ctx context.Context, workRequestClient workReqClient, workRequestId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+	response, err := workRequestClient.ListWorkRequestErrors(ctx, oci_work_requests.ListWorkRequestErrorsRequest{
 		WorkRequestId: workRequestId,
 		RequestMetadata: oci_common.RequestMetadata{
 			RetryPolicy: retryPolicy,
@@ -1059,14 +1257,20 @@ func getWorkRequestErrors(workRequestClient workReqClient, workRequestId *string
 	}
 
 	allErrs := make([]string, 0)
+	var codes []string
 	for _, wrkErr := range response.Items {
 		allErrs = append(allErrs, *wrkErr.Message)
+		if wrkErr.Code != nil {
+			codes = append(codes, *wrkErr.Code)
+		}
 	}
 	errorMessage := strings.Join(allErrs, "\n")
 
-	workRequestErr := fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *workRequestId, entityType, action, errorMessage)
-
-	return workRequestErr
+	return &WorkRequestFailedError{
+		WorkRequestId: *workRequestId,
+		Codes:         codes,
+		Message:       fmt.Sprintf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *workRequestId, entityType, action, errorMessage),
+	}
 }
 
 // Helper to marshal JSON objects from service into strings that can be stored in state.
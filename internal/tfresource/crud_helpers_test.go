@@ -19,6 +19,9 @@ import (
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_vault "github.com/oracle/oci-go-sdk/v65/vault"
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/utils"
 )
 
 type extraWaitPostCreateDelete interface {
@@ -1039,7 +1042,7 @@ func TestUnitCreateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 					//return nil
 				}
@@ -1050,7 +1053,7 @@ func TestUnitCreateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					//return errors.New("default")
 					return nil
 				}
@@ -1066,6 +1069,44 @@ func TestUnitCreateResource(t *testing.T) {
 	}
 }
 
+func TestUnitCreateResource_tracing(t *testing.T) {
+	exporter := &utils.InMemorySpanExporter{}
+	utils.SetSpanExporter(exporter)
+	defer utils.SetSpanExporter(nil)
+
+	s := &ResourceCrud{}
+	reqResourceData := &mockResourceData{}
+	s.D = reqResourceData
+	waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+		_, sdkCallSpan := utils.StartSpan(ctx, "GET /instances", utils.SpanKindClient)
+		sdkCallSpan.End(nil)
+		return errors.New("default")
+	}
+	defer func() { waitForStateRefreshVar = WaitForStateRefreshWithContext }()
+
+	// The CRUD helpers record their span as a child of the span of the resource operation
+	ctx, operationSpan := utils.StartSpan(context.Background(), "oci_core_instance create", utils.SpanKindInternal)
+	assert.True(t, CreateResourceWithContext(ctx, reqResourceData, AdaptResourceCreator(s)).HasError())
+	operationSpan.End(nil)
+
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 3) {
+		sdkCallSpan, createSpan := spans[0], spans[1]
+		assert.Equal(t, "CreateResource", createSpan.Name)
+		assert.Equal(t, operationSpan.SpanId, createSpan.ParentSpanId)
+		assert.Equal(t, "default", createSpan.StatusMessage)
+		assert.Equal(t, "*tfresource.ResourceCrud", createSpan.Attributes["oci.resource.crud"])
+		assert.Equal(t, "GET /instances", sdkCallSpan.Name)
+		assert.Equal(t, createSpan.TraceId, sdkCallSpan.TraceId)
+		assert.Equal(t, createSpan.SpanId, sdkCallSpan.ParentSpanId)
+	}
+
+	// and record no span without the span of the resource operation
+	assert.Error(t, CreateResource(reqResourceData, s))
+	assert.Len(t, exporter.GetSpans(), 4)
+	assert.Equal(t, "", exporter.GetSpans()[3].ParentSpanId)
+}
+
 func TestUnitReadResource(t *testing.T) {
 	s := &readResourceCrud{}
 	reqResourceData := &mockResourceData{}
@@ -1115,7 +1156,7 @@ func TestUnitUpdateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1125,7 +1166,7 @@ func TestUnitUpdateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1161,7 +1202,7 @@ func TestUnitDeleteResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1171,7 +1212,7 @@ func TestUnitDeleteResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1207,7 +1248,7 @@ func TestUnitWaitForUpdatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1217,7 +1258,7 @@ func TestUnitWaitForUpdatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1253,7 +1294,7 @@ func TestUnitWaitForCreatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1263,7 +1304,7 @@ func TestUnitWaitForCreatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1298,7 +1339,7 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 			args:     args{sync: &ResourceCrud{D: &mockResourceData{}, id: "1"}, timeout: time.Second, operationName: "", pending: []string{}, target: []string{"SUCCEEDED"}},
 			gotError: false,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "SUCCEEDED", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
@@ -1311,7 +1352,7 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 			args:     args{sync: &ResourceCrud{D: &mockResourceData{}}, timeout: time.Second, operationName: "", pending: []string{}, target: []string{"FAILED"}},
 			gotError: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "FAILED", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
@@ -1324,7 +1365,7 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 			args:     args{sync: &ResourceCrud{D: &mockResourceData{}}, timeout: time.Second, operationName: "", pending: []string{"A"}, target: []string{"A"}},
 			gotError: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "ABC", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
@@ -1337,7 +1378,7 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 			args:     args{sync: &ResourceCrud{D: &mockResourceData{}}, timeout: time.Second, operationName: "", pending: []string{"A"}, target: []string{}},
 			gotError: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "ABC", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
@@ -1350,7 +1391,7 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 			args:     args{sync: &ResourceCrud{D: &mockResourceData{}}, timeout: 0, operationName: "", pending: []string{}, target: []string{}},
 			gotError: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "ABC", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
@@ -1456,7 +1497,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "", action: "CREATED", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "oci", gotError: false},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return nil
 				}
 			},
@@ -1467,7 +1508,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "default", action: "CREATED", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "", gotError: true},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1478,7 +1519,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "default", action: "", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "", gotError: true},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1489,7 +1530,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "default", action: "", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "", gotError: true},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1581,7 +1622,7 @@ func TestUnitgetWorkRequestErrors(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
-		if res := getWorkRequestErrors(test.args.workRequestClient, test.args.workRequestId, test.args.retryPolicy, test.args.entityType, test.args.action); (res != nil) != test.output {
+		if res := getWorkRequestErrors(context.Background(), test.args.workRequestClient, test.args.workRequestId, test.args.retryPolicy, test.args.entityType, test.args.action); (res != nil) != test.output {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.output)
 		}
 	}
@@ -1613,13 +1654,13 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, sync: &ResourceCrud{id: "1"}, timeout: time.Second, operationName: "default", pending: []string{}, target: []string{"SUCCEEDED"}},
 			output: false,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "SUCCEEDED", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1629,13 +1670,13 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, sync: &ResourceCrud{}, timeout: time.Second, operationName: "default", pending: []string{}, target: []string{"FAILED"}},
 			output: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "FAILED", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1645,13 +1686,13 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, sync: &ResourceCrud{}, timeout: time.Second, operationName: "default", pending: []string{"A"}, target: []string{"A"}},
 			output: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "ABC", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1661,13 +1702,13 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, sync: &ResourceCrud{}, timeout: 0, operationName: "default", pending: []string{}, target: []string{}},
 			output: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "ABC", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1710,7 +1751,7 @@ func TestUnitstateRefreshFunc(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Log("Running ", test.name)
-		if _, _, err := stateRefreshFunc(test.args.sync)(); (err != nil) != test.output {
+		if _, _, err := stateRefreshFunc(context.Background(), test.args.sync)(); (err != nil) != test.output {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", err, test.output)
 		}
 	}
@@ -4,6 +4,7 @@
 package tfresource
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -115,3 +116,36 @@ type StatefullyDeletedResource interface {
 type SynchronizedResource interface {
 	GetMutex() *sync.Mutex
 }
+
+// Context-aware CRUD interfaces, used by CreateResourceWithContext, ReadResourceWithContext, UpdateResourceWithContext
+// and DeleteResourceWithContext. The context is cancelled when Terraform is interrupted, and should be passed to the
+// SDK requests and waiters of the operation. The CRUD types that do not take a context are adapted to them with
+// AdaptResourceCreator, AdaptResourceReader, AdaptResourceUpdater and AdaptResourceDeleter.
+
+type ResourceCreatorWithContext interface {
+	ResourceDataWriter
+	// ID identifies the resource, or a work request to Create the resource.
+	ID() string
+	CreateWithContext(ctx context.Context) error
+}
+
+type ResourceFetcherWithContext interface {
+	GetWithContext(ctx context.Context) error
+}
+
+type ResourceReaderWithContext interface {
+	ResourceFetcherWithContext
+	ResourceDataWriter
+}
+
+type ResourceUpdaterWithContext interface {
+	ResourceDataWriter
+	UpdateWithContext(ctx context.Context) error
+}
+
+type ResourceDeleterWithContext interface {
+	ResourceVoider
+	// ID identifies the resource, or a work request to Create the resource.
+	ID() string
+	DeleteWithContext(ctx context.Context) error
+}
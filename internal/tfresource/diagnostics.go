@@ -0,0 +1,308 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+var (
+	// Quoted names, and names in camel case or with a path, of the request fields named in the error messages
+	quotedRequestFieldRegex = regexp.MustCompile("['\"`]([A-Za-z][A-Za-z0-9_]*(?:\\[[0-9]+\\])?(?:\\.[A-Za-z][A-Za-z0-9_]*(?:\\[[0-9]+\\])?)*)['\"`]")
+	requestFieldRegex       = regexp.MustCompile(`\b[a-z][A-Za-z0-9]*(?:\[[0-9]+\])?(?:\.[A-Za-z][A-Za-z0-9]*(?:\[[0-9]+\])?)*\b`)
+	requestFieldIndexRegex  = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*)(?:\[([0-9]+)\])?$`)
+
+	// Placeholders of the examples, e.g. `<compartment_ocid>`, and values meant to be replaced, e.g. `changeme`
+	placeholderValueRegex = regexp.MustCompile(`(?i)^(change_?me|replace_?me)$|<[A-Za-z0-9_.-]*_ocid>`)
+)
+
+// DiagnosticsError is an error that carries the diagnostics it stands for, so that the diagnostics, e.g. the attribute
+// the service rejected, reach Terraform through the CRUD functions that return an error
+type DiagnosticsError struct {
+	err         error
+	Diagnostics diag.Diagnostics
+}
+
+func (e *DiagnosticsError) Error() string {
+	return e.err.Error()
+}
+
+func (e *DiagnosticsError) Unwrap() error {
+	return e.err
+}
+
+// ErrorFromDiagnostics returns the errors of the diagnostics as an error, or nil if there are none
+func ErrorFromDiagnostics(diags diag.Diagnostics) error {
+	if !diags.HasError() {
+		return nil
+	}
+	var messages []string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail == "" {
+			messages = append(messages, d.Summary)
+		} else {
+			messages = append(messages, fmt.Sprintf("%s\n%s", d.Summary, d.Detail))
+		}
+	}
+	return &DiagnosticsError{err: errors.New(strings.Join(messages, "\n")), Diagnostics: diags}
+}
+
+// DiagnosticsFromError returns the diagnostics carried by the error, or an error diagnostic with its message
+func DiagnosticsFromError(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	var diagnosticsError *DiagnosticsError
+	if errors.As(err, &diagnosticsError) {
+		return diagnosticsError.Diagnostics
+	}
+	return diag.FromErr(err)
+}
+
+// Warning is a problem with the configuration of a resource that does not fail its operation, e.g. the use of a
+// deprecated field or of a placeholder value
+type Warning struct {
+	Summary string
+	// Attribute is the path of the attribute the warning is about, e.g. `shape_config.0.ocpus`, if any
+	Attribute    string
+	OpcRequestId string
+	Suggestion   string
+}
+
+// ResourceDataProvider is implemented by the CRUD types that embed BaseCrud, and returns the ResourceData of their
+// resource
+type ResourceDataProvider interface {
+	ResourceData() *schema.ResourceData
+}
+
+// ResourceWarner is implemented by the CRUD types that report warnings about the configuration of their resource
+type ResourceWarner interface {
+	Warnings() []Warning
+}
+
+func (w Warning) diagnostic() diag.Diagnostic {
+	suggestion := w.Suggestion
+	if suggestion == "" {
+		suggestion = getSuggestionForWarning(w)
+	}
+	detail := fmt.Sprintf("Suggestion: %s", suggestion)
+	if w.OpcRequestId != "" {
+		detail += fmt.Sprintf("\nOPC request ID: %s", w.OpcRequestId)
+	}
+	return diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       w.Summary,
+		Detail:        detail,
+		AttributePath: attributePathFromKey(w.Attribute),
+	}
+}
+
+// resourceWarnings returns the warnings reported by the CRUD type, and those about placeholder values left in the
+// configuration of the resource
+func resourceWarnings(sync interface{}, d schemaResourceData) diag.Diagnostics {
+	var warnings []Warning
+	if warner, ok := sync.(ResourceWarner); ok {
+		warnings = append(warnings, warner.Warnings()...)
+	}
+	if resourceData, ok := d.(*schema.ResourceData); ok && resourceData != nil {
+		warnings = append(warnings, placeholderValueWarnings(resourceData.GetRawConfig())...)
+	}
+
+	var diags diag.Diagnostics
+	for _, warning := range warnings {
+		diags = append(diags, warning.diagnostic())
+	}
+	return diags
+}
+
+// placeholderValueWarnings returns a warning for each string of the configuration that looks like a placeholder that
+// was not replaced, e.g. `<compartment_ocid>`, `changeme` or the value of the required attributes resource discovery
+// could not find
+func placeholderValueWarnings(config cty.Value) []Warning {
+	var warnings []Warning
+	if config.IsNull() || !config.IsWhollyKnown() {
+		return warnings
+	}
+	_ = cty.Walk(config, func(path cty.Path, v cty.Value) (bool, error) {
+		if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+			return true, nil
+		}
+		if value := v.AsString(); placeholderValueRegex.MatchString(value) || value == globalvar.PlaceholderValueForMissingAttribute {
+			attribute := keyFromAttributePath(path)
+			warnings = append(warnings, Warning{
+				Summary:    fmt.Sprintf("The value '%s' of '%s' looks like a placeholder", value, attribute),
+				Attribute:  attribute,
+				Suggestion: getSuggestionForPlaceholderValue(attribute),
+			})
+		}
+		return true, nil
+	})
+	return warnings
+}
+
+// attributePathFromServiceError returns the path of the attribute of the resource that stands for the request field
+// named in a 400 error, e.g. `cidr_block` for `createVcnDetails.cidrBlock`, or nil if there is none
+func attributePathFromServiceError(sync interface{}, tfError customError) cty.Path {
+	if tfError.TypeOfError != ServiceError || tfError.ErrorCode != 400 {
+		return nil
+	}
+	resourceDataProvider, ok := sync.(ResourceDataProvider)
+	if !ok {
+		return nil
+	}
+	resourceData := resourceDataProvider.ResourceData()
+	if resourceData == nil {
+		return nil
+	}
+	configType := resourceData.GetRawConfig().Type()
+	if !configType.IsObjectType() {
+		return nil
+	}
+
+	messages := []string{tfError.Message, tfError.OriginalMessage}
+	for _, argument := range tfError.MessageArgument {
+		messages = append(messages, fmt.Sprintf("'%s'", argument))
+	}
+	for _, field := range requestFieldNames(messages) {
+		if path := attributePathFromRequestField(configType, field); path != nil {
+			return path
+		}
+	}
+	return nil
+}
+
+// requestFieldNames returns the quoted names, then the names in camel case or with a path, of the messages
+func requestFieldNames(messages []string) []string {
+	var fields []string
+	for _, message := range messages {
+		for _, match := range quotedRequestFieldRegex.FindAllStringSubmatch(message, -1) {
+			fields = append(fields, match[1])
+		}
+	}
+	for _, message := range messages {
+		for _, match := range requestFieldRegex.FindAllString(message, -1) {
+			if strings.ContainsAny(match, ".[ABCDEFGHIJKLMNOPQRSTUVWXYZ") {
+				fields = append(fields, match)
+			}
+		}
+	}
+	return fields
+}
+
+// attributePathFromRequestField maps the path of a request field, e.g. `details.shapeConfig.ocpus`, to the path of the
+// attribute in the configuration, e.g. `shape_config.0.ocpus`. Maps and sets are not looked into.
+func attributePathFromRequestField(configType cty.Type, field string) cty.Path {
+	var path cty.Path
+	attributeType := configType
+	segments := strings.Split(field, ".")
+	for i, segment := range segments {
+		match := requestFieldIndexRegex.FindStringSubmatch(segment)
+		if match == nil || !attributeType.IsObjectType() {
+			break
+		}
+		name := toSnakeCase(match[1])
+		if !attributeType.HasAttribute(name) {
+			// The request details wrapping the fields of the resource, e.g. `createVcnDetails`
+			if i == 0 && len(segments) > 1 && strings.HasSuffix(strings.ToLower(match[1]), "details") {
+				continue
+			}
+			break
+		}
+		path = path.GetAttr(name)
+		attributeType = attributeType.AttributeType(name)
+
+		if attributeType.IsListType() {
+			index := 0
+			if match[2] != "" {
+				index, _ = strconv.Atoi(match[2])
+			}
+			if i == len(segments)-1 && match[2] == "" {
+				break
+			}
+			path = path.IndexInt(index)
+			attributeType = attributeType.ElementType()
+		}
+	}
+	return path
+}
+
+// attributePathFromKey returns the path of a flatmap key of an attribute, e.g. `shape_config.0.ocpus`
+func attributePathFromKey(key string) cty.Path {
+	if key == "" {
+		return nil
+	}
+	var path cty.Path
+	for _, part := range strings.Split(key, ".") {
+		if index, err := strconv.Atoi(part); err == nil {
+			path = path.IndexInt(index)
+		} else {
+			path = path.GetAttr(part)
+		}
+	}
+	return path
+}
+
+// keyFromAttributePath returns the flatmap key of the path of an attribute
+func keyFromAttributePath(path cty.Path) string {
+	var parts []string
+	for _, step := range path {
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			parts = append(parts, step.Name)
+		case cty.IndexStep:
+			if step.Key.Type().Equals(cty.Number) {
+				index, _ := step.Key.AsBigFloat().Int64()
+				parts = append(parts, strconv.FormatInt(index, 10))
+			} else if step.Key.Type().Equals(cty.String) {
+				parts = append(parts, step.Key.AsString())
+			}
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// reportWarnings reports the warnings of the CRUD helpers that return an error. They are added to the diagnostics of
+// the operation they are called from, or logged if they are not called from an operation, e.g. by resource discovery.
+func reportWarnings(d schemaResourceData, diags diag.Diagnostics) {
+	if len(diags) == 0 {
+		return
+	}
+	if addOperationWarnings(d, diags) {
+		return
+	}
+	logWarnings(diags)
+}
+
+// crudResourceData returns the ResourceData of the CRUD type, if it provides it
+func crudResourceData(sync interface{}) schemaResourceData {
+	if resourceDataProvider, ok := sync.(ResourceDataProvider); ok {
+		if resourceData := resourceDataProvider.ResourceData(); resourceData != nil {
+			return resourceData
+		}
+	}
+	return nil
+}
+
+// logWarnings logs the warnings that can not be reported to Terraform
+func logWarnings(diags diag.Diagnostics) {
+	for _, d := range diags {
+		if d.Severity == diag.Warning {
+			log.Printf("[WARN] %s: %s", d.Summary, d.Detail)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+type attributePathResourceCrud struct {
+	BaseCrud
+}
+
+// warningResourceCrud is created successfully, with a warning about the configuration
+type warningResourceCrud struct {
+	workRequestFailureResourceCrud
+}
+
+func (s *warningResourceCrud) Warnings() []Warning {
+	return []Warning{{Summary: "The 'hostname_label' field has been deprecated", Attribute: "hostname_label", OpcRequestId: "opc-request-id"}}
+}
+
+func TestUnitHandleError_attributePath(t *testing.T) {
+	defer func(check func(err error) (oci_common.ServiceErrorLocalizationMessage, bool)) {
+		serviceErrorCheck = check
+	}(serviceErrorCheck)
+
+	resourceSchema := map[string]*schema.Schema{
+		"cidr_block":    {Type: schema.TypeString, Optional: true},
+		"display_name":  {Type: schema.TypeString, Optional: true},
+		"freeform_tags": {Type: schema.TypeMap, Optional: true, Elem: schema.TypeString},
+		"shape_config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ocpus": {Type: schema.TypeFloat, Optional: true},
+				},
+			},
+		},
+	}
+	sync := &attributePathResourceCrud{BaseCrud{D: schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})}}
+
+	tests := []struct {
+		name            string
+		statusCode      int
+		message         string
+		messageArgument map[string]string
+		want            cty.Path
+	}{
+		{
+			name:       "Test field in camel case",
+			statusCode: 400,
+			message:    "Invalid cidrBlock: 10.0.0.0/33 is not a valid CIDR block",
+			want:       cty.GetAttrPath("cidr_block"),
+		},
+		{
+			name:       "Test field of the request details",
+			statusCode: 400,
+			message:    "createVcnDetails.displayName must not be empty",
+			want:       cty.GetAttrPath("display_name"),
+		},
+		{
+			name:       "Test quoted nested field",
+			statusCode: 400,
+			message:    "Invalid value for 'shapeConfig.ocpus'",
+			want:       cty.GetAttrPath("shape_config").IndexInt(0).GetAttr("ocpus"),
+		},
+		{
+			name:       "Test key of a map",
+			statusCode: 400,
+			message:    "Tag 'freeformTags.CostCenter' is too long",
+			want:       cty.GetAttrPath("freeform_tags"),
+		},
+		{
+			name:            "Test field in the message arguments",
+			statusCode:      400,
+			message:         "The value is not valid",
+			messageArgument: map[string]string{"field": "displayName"},
+			want:            cty.GetAttrPath("display_name"),
+		},
+		{
+			name:       "Test field that is not an attribute",
+			statusCode: 400,
+			message:    "Invalid vcnId",
+		},
+		{
+			name:       "Test error other than a 400",
+			statusCode: 409,
+			message:    "Invalid cidrBlock",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceFailure := &MockServiceFailure{StatusCode: tt.statusCode, Code: "InvalidParameter", Message: tt.message, MessageArgument: tt.messageArgument, OpcRequestID: "opc-request-id"}
+			serviceErrorCheck = func(err error) (oci_common.ServiceErrorLocalizationMessage, bool) {
+				return serviceFailure, true
+			}
+
+			diags := DiagnosticsFromError(HandleError(sync, serviceFailure))
+			if assert.Len(t, diags, 1) {
+				assert.Equal(t, diag.Error, diags[0].Severity)
+				assert.Contains(t, diags[0].Summary, tt.message)
+				assert.Contains(t, diags[0].Detail, "OPC request ID: opc-request-id")
+				assert.Equal(t, tt.want, diags[0].AttributePath)
+			}
+		})
+	}
+}
+
+func TestUnitDiagnosticsFromError(t *testing.T) {
+	assert.Nil(t, DiagnosticsFromError(nil))
+	assert.Nil(t, ErrorFromDiagnostics(diag.Diagnostics{{Severity: diag.Warning, Summary: "warning"}}))
+
+	diags := diag.Diagnostics{
+		{Severity: diag.Error, Summary: "400-InvalidParameter, Invalid cidrBlock", Detail: "Suggestion: update the parameter", AttributePath: cty.GetAttrPath("cidr_block")},
+		{Severity: diag.Warning, Summary: "warning"},
+	}
+	err := ErrorFromDiagnostics(diags)
+	if assert.Error(t, err) {
+		assert.Equal(t, "400-InvalidParameter, Invalid cidrBlock\nSuggestion: update the parameter", err.Error())
+		assert.Equal(t, diags, DiagnosticsFromError(err))
+	}
+
+	assert.Equal(t, diag.FromErr(errors.New("error")), DiagnosticsFromError(errors.New("error")))
+}
+
+func TestUnitPlaceholderValueWarnings(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"compartment_id": cty.StringVal("<compartment_ocid>"),
+		"display_name":   cty.StringVal("vcn"),
+		"password":       cty.StringVal("CHANGEME"),
+		"shape_config": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"image_id": cty.StringVal("ocid1.image.oc1..<image_ocid>"),
+		})}),
+		"hostname_label": cty.NullVal(cty.String),
+		"admin_password": cty.StringVal(globalvar.PlaceholderValueForMissingAttribute),
+		// Values that are legitimate, even if they look like placeholders
+		"description": cty.StringVal("<html>"),
+		"shape":       cty.StringVal("todo"),
+		"state":       cty.StringVal("TBD"),
+		"subnet_id":   cty.StringVal("xx"),
+	})
+
+	var attributes []string
+	for _, warning := range placeholderValueWarnings(config) {
+		attributes = append(attributes, warning.Attribute)
+		assert.NotEmpty(t, warning.Suggestion)
+	}
+	assert.ElementsMatch(t, []string{"compartment_id", "password", "shape_config.0.image_id", "admin_password"}, attributes)
+}
+
+func TestUnitCreateResource_warnings(t *testing.T) {
+	resourceSchema := &schema.Resource{Schema: map[string]*schema.Schema{"hostname_label": {Type: schema.TypeString, Optional: true}}}
+	d := resourceSchema.TestResourceData()
+	sync := &warningResourceCrud{workRequestFailureResourceCrud{d: &workRequestFailureResourceData{}}}
+
+	// The warnings are reported as diagnostics by the CRUD helpers that take a context
+	diags := CreateResourceWithContext(context.Background(), d, AdaptResourceCreator(sync))
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, cty.GetAttrPath("hostname_label"), diags[0].AttributePath)
+		assert.Contains(t, diags[0].Detail, "Suggestion: Remove 'hostname_label'")
+		assert.Contains(t, diags[0].Detail, "OPC request ID: opc-request-id")
+	}
+
+	// and only logged by the ones that do not, when they are not called from an operation
+	assert.NoError(t, CreateResource(d, sync))
+
+	// The operations report the warnings of the CRUD helpers they call, and the ones that apply the configuration the
+	// placeholder values left in it
+	config := cty.ObjectVal(map[string]cty.Value{"hostname_label": cty.StringVal("<compartment_ocid>")})
+	d = resourceSchema.Data(&terraform.InstanceState{RawConfig: config})
+	create := withOperationContext(func(d *schema.ResourceData, m interface{}) error {
+		return CreateResource(d, sync)
+	}, true)
+	diags = create(context.Background(), d, nil)
+	if assert.Len(t, diags, 2) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Contains(t, diags[0].Summary, "deprecated")
+		assert.Equal(t, diag.Warning, diags[1].Severity)
+		assert.Contains(t, diags[1].Summary, "looks like a placeholder")
+		assert.Equal(t, cty.GetAttrPath("hostname_label"), diags[1].AttributePath)
+	}
+	assert.Empty(t, resourceOperations)
+	read := withOperationContext(func(d *schema.ResourceData, m interface{}) error {
+		return nil
+	}, false)
+	assert.Empty(t, read(context.Background(), d, nil))
+}
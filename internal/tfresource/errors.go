@@ -5,8 +5,10 @@ package tfresource
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -15,6 +17,8 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
@@ -52,6 +56,8 @@ type customError struct {
 	ResourceDocs            string            `json:"resource_docs"`
 	SdkApiDocs              string            `json:"sdk_api_docs"`
 	JsonError               string            `json:"-"`
+	// Path of the attribute standing for the request field the service rejected, if known
+	attributePath cty.Path
 }
 
 // Create new error format for Terraform output
@@ -76,6 +82,10 @@ func newCustomError(sync interface{}, err error) error {
 			ResourceDocs:            getResourceDocsURL(sync),
 			SdkApiDocs:              failure.GetOperationReferenceLink(),
 		}
+		if tfError.ErrorCode == http.StatusPreconditionFailed {
+			// The If-Match etag of an update or delete did not match
+			tfError.Message = "The resource was changed outside of Terraform since it was last read, re-plan to refresh it. " + tfError.Message
+		}
 	} else if strings.Contains(errorMessage, "timeout while waiting for state") {
 		// Timeout error
 		tfError = customError{
@@ -109,8 +119,25 @@ func newCustomError(sync interface{}, err error) error {
 	tfError.VersionError = GetVersionAndDateError()
 	tfError.Suggestion = getSuggestionFromError(tfError)
 	tfError.JsonError = getJsonError(tfError)
+	tfError.attributePath = attributePathFromServiceError(sync, tfError)
 
-	return tfError.Error()
+	finalError := tfError.Error()
+	return &DiagnosticsError{err: finalError, Diagnostics: diag.Diagnostics{tfError.diagnostic(finalError)}}
+}
+
+// diagnostic returns the error as a diagnostic, summarized by the first line of its message and pointing at the
+// attribute the service rejected, if known
+func (tfE customError) diagnostic(finalError error) diag.Diagnostic {
+	summary, detail := finalError.Error(), ""
+	if i := strings.Index(summary, "\n"); i >= 0 {
+		summary, detail = summary[:i], summary[i+1:]
+	}
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       strings.TrimSpace(summary),
+		Detail:        strings.TrimSpace(detail),
+		AttributePath: tfE.attributePath,
+	}
 }
 func getJsonError(tfError customError) string {
 	errByte, err := json.Marshal(tfError)
@@ -213,6 +240,16 @@ func handleMissingResourceError(sync ResourceVoider, err *error) {
 
 func HandleError(sync interface{}, err error) error {
 	if err != nil {
+		var clientError *ClientError
+		if errors.As(err, &clientError) {
+			return clientError
+		}
+		if service := circuitBreakerOpenService(err); service != "" {
+			if resourceService := resourceServiceFromContext(operationContext(crudResourceData(sync))); resourceService != "" {
+				service = resourceService
+			}
+			return serviceHealthVar.recordFailedFast(service)
+		}
 		tfError := newCustomError(sync, err)
 		return tfError
 	}
@@ -318,5 +355,21 @@ func getVersionAndDateErrorImpl(version string, date string) string {
 }
 
 func isCircuitBreakerOpen(err error) bool {
-	return oci_common.IsCircuitBreakerError(err)
+	return oci_common.IsCircuitBreakerError(err) || (err != nil && circuitBreakerErrorRegex.MatchString(err.Error()))
+}
+
+// ClientError is the error of an SDK client that could not be created, e.g. because the credentials of the provider
+// are invalid. The typed accessors of the clients return a client whose requests fail with it, and HandleError returns
+// it as it is, since it is not an error of the service.
+type ClientError struct {
+	ClientName string
+	Err        error
+}
+
+func (e *ClientError) Error() string {
+	return fmt.Sprintf("unable to create '%s' client: %v", e.ClientName, e.Err)
+}
+
+func (e *ClientError) Unwrap() error {
+	return e.Err
 }
@@ -235,6 +235,20 @@ func TestUnitHandleError(t *testing.T) {
 	response = HandleError(temp, mockServiceFailure)
 	assert.Contains(t, response.Error(), "Request a service limit increase for this resource")
 
+	//Etag mismatch Case
+	mockServiceFailure = &MockServiceFailure{
+		StatusCode:   412,
+		Code:         "NoEtagMatch",
+		Message:      "The resource's etag does not match the one in the If-Match header",
+		OpcRequestID: "Not Applicable",
+	}
+	response = HandleError(temp, mockServiceFailure)
+	diags := DiagnosticsFromError(response)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, "412-NoEtagMatch, The resource was changed outside of Terraform since it was last read, re-plan to refresh it. "+
+			"The resource's etag does not match the one in the If-Match header", diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "Run terraform plan again")
+	}
 }
 
 func TestUnitGetJsonError(t *testing.T) {
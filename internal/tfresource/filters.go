@@ -5,16 +5,45 @@ package tfresource
 
 import (
 	"log"
+	"net"
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Operators of the filter sets, comparing the property to the filter values. A filter set without operator checks
+// for equality.
+const (
+	FilterOperatorEquals       = "equals"
+	FilterOperatorNotEquals    = "not_equals"
+	FilterOperatorGt           = "gt"
+	FilterOperatorLt           = "lt"
+	FilterOperatorGte          = "gte"
+	FilterOperatorLte          = "lte"
+	FilterOperatorContains     = "contains"
+	FilterOperatorStartsWith   = "starts_with"
+	FilterOperatorCidrContains = "cidr_contains"
+)
+
+var FilterOperators = []string{
+	FilterOperatorEquals,
+	FilterOperatorNotEquals,
+	FilterOperatorGt,
+	FilterOperatorLt,
+	FilterOperatorGte,
+	FilterOperatorLte,
+	FilterOperatorContains,
+	FilterOperatorStartsWith,
+	FilterOperatorCidrContains,
+}
+
 func DataSourceFiltersSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -38,6 +67,12 @@ func DataSourceFiltersSchema() *schema.Schema {
 					Optional: true,
 					Default:  false,
 				},
+
+				"operator": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(FilterOperators, false),
+				},
 			},
 		},
 	}
@@ -58,41 +93,13 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 	}
 
 	for _, f := range filters.List() {
-		fSet := f.(map[string]interface{})
-		keyword := fSet["name"].(string)
-		var pathElements []string
-		var err error
-		if pathElements, err = getFieldPathElements(resourceSchema, keyword); err != nil {
-			log.Printf(err.Error())
-			pathElements = []string{keyword}
-		}
-
-		isReg := false
-		if regex, regexOk := fSet["regex"]; regexOk {
-			isReg = regex.(bool)
-		}
-
-		// Create a string equality check strategy based on this filters "regex" flag
-		stringsEqual := func(propertyVal string, filterVal string) bool {
-			if isReg {
-				re, err := regexp.Compile(filterVal)
-				if err != nil {
-					// todo: when all SetData() fns are refactored to return a possible error, these log statements should
-					// be converted to errors for return propagation
-					log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
-					return false
-				}
-				return re.MatchString(propertyVal)
-			}
-
-			return filterVal == propertyVal
-		}
+		pathElements, matches := filterMatcher(f.(map[string]interface{}), resourceSchema)
 
 		// build a collection of items from matches against the set of filters
 		res := make([]map[string]interface{}, 0)
 		for _, item := range items {
 			targetVal, targetValOk := getValueFromPath(item, pathElements)
-			if targetValOk && orComparator(targetVal, fSet["values"].([]interface{}), stringsEqual) {
+			if targetValOk && matches(targetVal) {
 				res = append(res, item)
 			}
 		}
@@ -108,35 +115,7 @@ func ApplyFiltersInCollection(filters *schema.Set, items []interface{}, resource
 	}
 
 	for _, f := range filters.List() {
-		fSet := f.(map[string]interface{})
-		keyword := fSet["name"].(string)
-		var pathElements []string
-		var err error
-		if pathElements, err = getFieldPathElements(resourceSchema, keyword); err != nil {
-			log.Printf(err.Error())
-			pathElements = []string{keyword}
-		}
-
-		isReg := false
-		if regex, regexOk := fSet["regex"]; regexOk {
-			isReg = regex.(bool)
-		}
-
-		// Create a string equality check strategy based on this filters "regex" flag
-		stringsEqual := func(propertyVal string, filterVal string) bool {
-			if isReg {
-				re, err := regexp.Compile(filterVal)
-				if err != nil {
-					// todo: when all SetData() fns are refactored to return a possible error, these log statements should
-					// be converted to errors for return propagation
-					log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
-					return false
-				}
-				return re.MatchString(propertyVal)
-			}
-
-			return filterVal == propertyVal
-		}
+		pathElements, matches := filterMatcher(f.(map[string]interface{}), resourceSchema)
 
 		// build a collection of items from matches against the set of filters
 		res := make([]interface{}, 0)
@@ -146,7 +125,7 @@ func ApplyFiltersInCollection(filters *schema.Set, items []interface{}, resource
 				continue
 			}
 			targetVal, targetValOk := getValueFromPath(itemMap, pathElements)
-			if targetValOk && orComparator(targetVal, fSet["values"].([]interface{}), stringsEqual) {
+			if targetValOk && matches(targetVal) {
 				res = append(res, itemMap)
 			}
 		}
@@ -156,6 +135,48 @@ func ApplyFiltersInCollection(filters *schema.Set, items []interface{}, resource
 	return items
 }
 
+// filterMatcher returns the path of the property a filter set applies to, and the check of the property values
+// against the filter values
+func filterMatcher(fSet map[string]interface{}, resourceSchema map[string]*schema.Schema) ([]string, func(interface{}) bool) {
+	keyword := fSet["name"].(string)
+	pathElements, valueType, err := getFieldPathElements(resourceSchema, keyword)
+	if err != nil {
+		log.Printf(err.Error())
+		pathElements = []string{keyword}
+	}
+
+	isReg := false
+	if regex, regexOk := fSet["regex"]; regexOk {
+		isReg = regex.(bool)
+	}
+
+	operator := FilterOperatorEquals
+	if op, opOk := fSet["operator"].(string); opOk && op != "" {
+		operator = op
+	}
+
+	// Create a string equality check strategy based on this filters "regex" flag
+	stringsEqual := func(propertyVal string, filterVal string) bool {
+		if isReg {
+			re, err := regexp.Compile(filterVal)
+			if err != nil {
+				// todo: when all SetData() fns are refactored to return a possible error, these log statements should
+				// be converted to errors for return propagation
+				log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
+				return false
+			}
+			return re.MatchString(propertyVal)
+		}
+
+		return filterVal == propertyVal
+	}
+
+	filterValues := fSet["values"].([]interface{})
+	return pathElements, func(target interface{}) bool {
+		return operatorComparator(target, valueType, filterValues, operator, stringsEqual)
+	}
+}
+
 func getValueFromPath(item map[string]interface{}, path []string) (targetVal interface{}, targetValOk bool) {
 	workingMap := item
 	tempWorkingMap := item
@@ -209,16 +230,17 @@ func checkAndConvertNestedStructure(element interface{}) (map[string]interface{}
 	return nil, false
 }
 
-// Converts the filter name which is delimited by '.' into a list of XPath elements
+// Converts the filter name which is delimited by '.' into a list of XPath elements, along with the type of the values
+// of the property, or of its elements for a list, set or map
 // Read the filter name from left most token and look into schema map to interpret rest of the filter name string
-// e.g. for core_instance: freeform_tags.com.oracle.department -> ["freeform_tags", "com.oracle.department"], nil
-// e.g. for core_instance: source_details.source_type -> ["source_details", "source_type"], nil
-// e.g. for core_instance: source_details.source_type.xyz -> nil, error
-func getFieldPathElements(resourceSchema map[string]*schema.Schema, filterName string) ([]string, error) {
+// e.g. for core_instance: freeform_tags.com.oracle.department -> ["freeform_tags", "com.oracle.department"], TypeString, nil
+// e.g. for core_instance: source_details.source_type -> ["source_details", "source_type"], TypeString, nil
+// e.g. for core_instance: source_details.source_type.xyz -> nil, TypeInvalid, error
+func getFieldPathElements(resourceSchema map[string]*schema.Schema, filterName string) ([]string, schema.ValueType, error) {
 
 	if resourceSchema == nil {
 		log.Printf(`[WARN] schema is nil for filter name %s \n`, filterName)
-		return nil, fmt.Errorf("schema is nil for filter name %s", filterName)
+		return nil, schema.TypeInvalid, fmt.Errorf("schema is nil for filter name %s", filterName)
 	}
 
 	tokenizedFields := strings.Split(filterName, ".")
@@ -226,47 +248,55 @@ func getFieldPathElements(resourceSchema map[string]*schema.Schema, filterName s
 	//validate tokens
 	if len(tokenizedFields) == 0 {
 		log.Printf(`[WARN] Invalid filter name "%s"  \n`, filterName)
-		return nil, fmt.Errorf("invalid filter name %s", filterName)
+		return nil, schema.TypeInvalid, fmt.Errorf("invalid filter name %s", filterName)
 	}
 
 	if resourceSchema[tokenizedFields[0]] == nil {
 		log.Printf(`[WARN] Schema is nil for token %s for filter name "%s"\n`, tokenizedFields[0], filterName)
-		return nil, fmt.Errorf("schema is nil for token %s for filter name %s", tokenizedFields[0], filterName)
+		return nil, schema.TypeInvalid, fmt.Errorf("schema is nil for token %s for filter name %s", tokenizedFields[0], filterName)
 	}
 
 	var pathElements []string
+	valueType := schema.TypeInvalid
 	currentSchema := resourceSchema
 	for index, tokenizedField := range tokenizedFields {
 		if fieldSchema, ok := currentSchema[tokenizedField]; ok && isValidSchemaType(fieldSchema) {
 			// add current path element to pathElements
 			pathElements = append(pathElements, tokenizedField)
+			valueType = fieldSchema.Type
 			//check if nested
 			convertedElementSchema, conversionOk := fieldSchema.Elem.(*schema.Resource)
 			if !conversionOk { // No nested structure
 				if len(tokenizedFields) > index+1 { // have more tokens to handle
 					// if we have more tokens the schema type has to be map else error condition
 					if fieldSchema.Type != schema.TypeMap {
-						return nil, fmt.Errorf("invalid filter name format found %s", filterName)
+						return nil, schema.TypeInvalid, fmt.Errorf("invalid filter name format found %s", filterName)
 
 					}
 					pathElement := strings.Join(tokenizedFields[index+1:], ".")
 					pathElements = append(pathElements, pathElement)
 				}
+				if elemSchema, ok := fieldSchema.Elem.(*schema.Schema); ok {
+					valueType = elemSchema.Type
+				} else if fieldSchema.Type == schema.TypeMap {
+					// the elements of the maps without element schema are strings
+					valueType = schema.TypeString
+				}
 				break
 			} else {
 				// get next schema and handle next token
 				currentSchema = convertedElementSchema.Schema
 			}
 		} else {
-			return nil, fmt.Errorf("invalid schema found for filter name %s", filterName)
+			return nil, schema.TypeInvalid, fmt.Errorf("invalid schema found for filter name %s", filterName)
 		}
 	}
 
 	if len(pathElements) == 0 {
-		return nil, fmt.Errorf("path elements were not initialized properly")
+		return nil, schema.TypeInvalid, fmt.Errorf("path elements were not initialized properly")
 	}
 
-	return pathElements, nil
+	return pathElements, valueType, nil
 }
 
 func isValidSchemaType(fieldSchema *schema.Schema) bool {
@@ -352,3 +382,171 @@ func orComparator(target interface{}, filters []interface{}, stringsEqual String
 	}
 	return false
 }
+
+// operatorComparator returns true for any filter value the target property, whose values are of the schema type, matches
+// with the operator, or, for not_equals, if the target property equals none of them
+func operatorComparator(target interface{}, valueType schema.ValueType, filters []interface{}, operator string, stringsEqual StringCheck) bool {
+	val := reflect.ValueOf(target)
+	if !val.IsValid() {
+		return false
+	}
+
+	switch operator {
+	case FilterOperatorEquals:
+		return orComparator(target, filters, stringsEqual)
+	case FilterOperatorNotEquals:
+		return !orComparator(target, filters, stringsEqual)
+	}
+
+	for _, fVal := range filters {
+		if operatorMatches(val, valueType, operator, fVal.(string), stringsEqual) {
+			return true
+		}
+	}
+	return false
+}
+
+func operatorMatches(val reflect.Value, valueType schema.ValueType, operator string, filterVal string, stringsEqual StringCheck) bool {
+	switch operator {
+	case FilterOperatorGt, FilterOperatorLt, FilterOperatorGte, FilterOperatorLte:
+		comparison, ok := compareToFilterValue(val, valueType, filterVal)
+		if !ok {
+			return false
+		}
+		switch operator {
+		case FilterOperatorGt:
+			return comparison > 0
+		case FilterOperatorLt:
+			return comparison < 0
+		case FilterOperatorGte:
+			return comparison >= 0
+		default:
+			return comparison <= 0
+		}
+	case FilterOperatorContains:
+		// a string contains the filter value, an array of strings contains an element equal to it
+		if val.Kind() == reflect.String {
+			return strings.Contains(val.String(), filterVal)
+		}
+		return anyStringElement(val, func(element string) bool { return stringsEqual(element, filterVal) })
+	case FilterOperatorStartsWith:
+		if val.Kind() == reflect.String {
+			return strings.HasPrefix(val.String(), filterVal)
+		}
+		return anyStringElement(val, func(element string) bool { return strings.HasPrefix(element, filterVal) })
+	case FilterOperatorCidrContains:
+		if val.Kind() == reflect.String {
+			return cidrContains(val.String(), filterVal)
+		}
+		return anyStringElement(val, func(element string) bool { return cidrContains(element, filterVal) })
+	}
+	return false
+}
+
+// filterTimeLayouts are the layouts of the times the properties and the filter values are compared as: RFC3339, and the
+// one of time.Time.String(), which most data sources set the times of their items with, e.g. `2024-03-01 10:00:00 +0000 UTC`
+var filterTimeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999 -0700 MST"}
+
+func parseFilterTime(value string) (time.Time, bool) {
+	for _, layout := range filterTimeLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// compareToFilterValue returns -1, 0 or 1 as the target property is less than, equal to or greater than the filter
+// value, as the type of its schema. Numbers are compared as such, and so are the strings holding numbers, e.g. the
+// `size_in_gbs` of volumes, or times, e.g. `time_created`.
+func compareToFilterValue(val reflect.Value, valueType schema.ValueType, filterVal string) (int, bool) {
+	if val.Kind() == reflect.Interface || val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return 0, false
+		}
+		val = val.Elem()
+	}
+	switch valueType {
+	case schema.TypeInt, schema.TypeFloat:
+		switch val.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return compareToFilterNumber(float64(val.Int()), filterVal)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return compareToFilterNumber(float64(val.Uint()), filterVal)
+		case reflect.Float32, reflect.Float64:
+			return compareToFilterNumber(val.Float(), filterVal)
+		}
+		log.Printf("[WARN] Filtering with a comparison operator against a number field holding a %s", val.Kind())
+	case schema.TypeString:
+		if val.Kind() != reflect.String {
+			log.Printf("[WARN] Filtering with a comparison operator against a string field holding a %s", val.Kind())
+			return 0, false
+		}
+		if number, err := strconv.ParseFloat(val.String(), 64); err == nil {
+			return compareToFilterNumber(number, filterVal)
+		}
+		if propertyTime, ok := parseFilterTime(val.String()); ok {
+			filterTime, ok := parseFilterTime(filterVal)
+			if !ok {
+				log.Println("[WARN] Filtering against time field with a filter value that is not an RFC3339 time")
+				return 0, false
+			}
+			return propertyTime.Compare(filterTime), true
+		}
+		log.Println("[WARN] Filtering with a comparison operator against a string field that is neither a number nor a time")
+	default:
+		log.Printf("[WARN] Filtering with a comparison operator against a field of type %s", valueType)
+	}
+	return 0, false
+}
+
+func compareToFilterNumber(number float64, filterVal string) (int, bool) {
+	fFloat, err := strconv.ParseFloat(filterVal, 64)
+	if err != nil {
+		log.Println("[WARN] Filtering against number field with non-number filter value")
+		return 0, false
+	}
+	switch {
+	case number < fFloat:
+		return -1, true
+	case number > fFloat:
+		return 1, true
+	}
+	return 0, true
+}
+
+// anyStringElement returns true if the target property is an array with a string element that passes the check
+func anyStringElement(val reflect.Value, check func(string) bool) bool {
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		return false
+	}
+	for i := 0; i < val.Len(); i++ {
+		element := val.Index(i)
+		if element.Kind() == reflect.Interface {
+			element = element.Elem()
+		}
+		if element.Kind() == reflect.String && check(element.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// cidrContains returns true if the CIDR block contains the filter value, an IP address or a CIDR block
+func cidrContains(cidrBlock string, filterVal string) bool {
+	_, network, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return false
+	}
+	if ip := net.ParseIP(filterVal); ip != nil {
+		return network.Contains(ip)
+	}
+	_, filterNetwork, err := net.ParseCIDR(filterVal)
+	if err != nil {
+		log.Printf(`[WARN] Filtering with cidr_contains with "%s", neither an IP address nor a CIDR block`, filterVal)
+		return false
+	}
+	networkOnes, _ := network.Mask.Size()
+	filterOnes, _ := filterNetwork.Mask.Size()
+	return network.Contains(filterNetwork.IP) && filterOnes >= networkOnes
+}
@@ -9,10 +9,12 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
@@ -731,6 +733,126 @@ func TestUnitOrComparator(t *testing.T) {
 	}
 }
 
+// issue-routing-tag: terraform/default
+func TestUnitApplyFilters_operators(t *testing.T) {
+	items := []map[string]interface{}{
+		{
+			"display_name":  "data-volume",
+			"size_in_gbs":   "1024",
+			"vpus_per_gb":   10,
+			"cidr_block":    "10.0.1.0/24",
+			"cidr_blocks":   []interface{}{"10.0.0.0/16", "172.16.0.0/16"},
+			"time_created":  "2024-03-01T10:00:00Z",
+			"source_detail": []interface{}{map[string]interface{}{"size_in_mbs": 2048}},
+		},
+		{
+			"display_name":  "boot-volume",
+			"size_in_gbs":   "50",
+			"vpus_per_gb":   20,
+			"cidr_block":    "10.0.2.0/24",
+			"cidr_blocks":   []interface{}{"192.168.0.0/16"},
+			"time_created":  "2023-03-01T10:00:00Z",
+			"source_detail": []interface{}{map[string]interface{}{"size_in_mbs": 512}},
+		},
+	}
+
+	testSchema := map[string]*schema.Schema{
+		"display_name": {Type: schema.TypeString},
+		"size_in_gbs":  {Type: schema.TypeString},
+		"vpus_per_gb":  {Type: schema.TypeInt},
+		"cidr_block":   {Type: schema.TypeString},
+		"cidr_blocks":  {Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeString}},
+		"time_created": {Type: schema.TypeString},
+		"source_detail": {
+			Type:     schema.TypeList,
+			Computed: true,
+			MaxItems: 1,
+			Elem:     &schema.Resource{Schema: map[string]*schema.Schema{"size_in_mbs": {Type: schema.TypeInt}}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		filter   map[string]interface{}
+		expected []string
+	}{
+		{name: "Test equals by default", filter: map[string]interface{}{"name": "display_name", "values": []interface{}{"boot-volume"}}, expected: []string{"boot-volume"}},
+		{name: "Test not_equals", filter: map[string]interface{}{"name": "display_name", "operator": "not_equals", "values": []interface{}{"boot-volume", "other"}}, expected: []string{"data-volume"}},
+		{name: "Test not_equals with regex", filter: map[string]interface{}{"name": "display_name", "operator": "not_equals", "regex": true, "values": []interface{}{"^data-"}}, expected: []string{"boot-volume"}},
+		{name: "Test gt against number held in a string", filter: map[string]interface{}{"name": "size_in_gbs", "operator": "gt", "values": []interface{}{"500"}}, expected: []string{"data-volume"}},
+		{name: "Test lt against number held in a string", filter: map[string]interface{}{"name": "size_in_gbs", "operator": "lt", "values": []interface{}{"500"}}, expected: []string{"boot-volume"}},
+		{name: "Test gte against int", filter: map[string]interface{}{"name": "vpus_per_gb", "operator": "gte", "values": []interface{}{"20"}}, expected: []string{"boot-volume"}},
+		{name: "Test lte against int", filter: map[string]interface{}{"name": "vpus_per_gb", "operator": "lte", "values": []interface{}{"20"}}, expected: []string{"data-volume", "boot-volume"}},
+		{name: "Test gt against nested int", filter: map[string]interface{}{"name": "source_detail.size_in_mbs", "operator": "gt", "values": []interface{}{"1024"}}, expected: []string{"data-volume"}},
+		{name: "Test gt against time", filter: map[string]interface{}{"name": "time_created", "operator": "gt", "values": []interface{}{"2024-01-01T00:00:00Z"}}, expected: []string{"data-volume"}},
+		{name: "Test gt with non-number filter value", filter: map[string]interface{}{"name": "vpus_per_gb", "operator": "gt", "values": []interface{}{"ten"}}, expected: []string{}},
+		{name: "Test gt against string", filter: map[string]interface{}{"name": "display_name", "operator": "gt", "values": []interface{}{"a"}}, expected: []string{}},
+		{name: "Test contains against string", filter: map[string]interface{}{"name": "display_name", "operator": "contains", "values": []interface{}{"boot"}}, expected: []string{"boot-volume"}},
+		{name: "Test contains against array of strings", filter: map[string]interface{}{"name": "cidr_blocks", "operator": "contains", "values": []interface{}{"192.168.0.0/16"}}, expected: []string{"boot-volume"}},
+		{name: "Test starts_with", filter: map[string]interface{}{"name": "display_name", "operator": "starts_with", "values": []interface{}{"data", "other"}}, expected: []string{"data-volume"}},
+		{name: "Test cidr_contains IP address", filter: map[string]interface{}{"name": "cidr_block", "operator": "cidr_contains", "values": []interface{}{"10.0.1.5"}}, expected: []string{"data-volume"}},
+		{name: "Test cidr_contains CIDR block", filter: map[string]interface{}{"name": "cidr_block", "operator": "cidr_contains", "values": []interface{}{"10.0.2.128/25"}}, expected: []string{"boot-volume"}},
+		{name: "Test cidr_contains larger CIDR block", filter: map[string]interface{}{"name": "cidr_block", "operator": "cidr_contains", "values": []interface{}{"10.0.0.0/16"}}, expected: []string{}},
+		{name: "Test cidr_contains against array of CIDR blocks", filter: map[string]interface{}{"name": "cidr_blocks", "operator": "cidr_contains", "values": []interface{}{"172.16.4.1"}}, expected: []string{"data-volume"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filters := &schema.Set{F: schema.HashResource(DataSourceFiltersSchema().Elem.(*schema.Resource))}
+			filters.Add(test.filter)
+
+			names := []string{}
+			for _, item := range ApplyFilters(filters, items, testSchema) {
+				names = append(names, item["display_name"].(string))
+			}
+			assert.Equal(t, test.expected, names)
+
+			collection := make([]interface{}, len(items))
+			for i, item := range items {
+				collection[i] = item
+			}
+			assert.Len(t, ApplyFiltersInCollection(filters, collection, testSchema), len(test.expected))
+		})
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitApplyFilters_timeOperators(t *testing.T) {
+	// The items are set as by the oci_core_instances data source, whose times are formatted by time.Time.String()
+	instances := []oci_core.Instance{
+		{DisplayName: oci_common.String("new-instance"), TimeCreated: &oci_common.SDKTime{Time: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)}},
+		{DisplayName: oci_common.String("old-instance"), TimeCreated: &oci_common.SDKTime{Time: time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)}},
+	}
+	items := []map[string]interface{}{}
+	for _, r := range instances {
+		items = append(items, map[string]interface{}{"display_name": *r.DisplayName, "time_created": r.TimeCreated.String()})
+	}
+	assert.Equal(t, "2024-03-01 10:00:00 +0000 UTC", items[0]["time_created"])
+
+	tests := []struct {
+		name     string
+		filter   map[string]interface{}
+		expected []string
+	}{
+		{name: "Test gt RFC3339 time", filter: map[string]interface{}{"name": "time_created", "operator": "gt", "values": []interface{}{"2024-01-01T00:00:00Z"}}, expected: []string{"new-instance"}},
+		{name: "Test lt RFC3339 time with offset", filter: map[string]interface{}{"name": "time_created", "operator": "lt", "values": []interface{}{"2024-03-01T12:00:00+03:00"}}, expected: []string{"old-instance"}},
+		{name: "Test gte time of the data source", filter: map[string]interface{}{"name": "time_created", "operator": "gte", "values": []interface{}{"2024-03-01 10:00:00 +0000 UTC"}}, expected: []string{"new-instance"}},
+		{name: "Test lte time", filter: map[string]interface{}{"name": "time_created", "operator": "lte", "values": []interface{}{"2024-03-01T10:00:00Z"}}, expected: []string{"new-instance", "old-instance"}},
+		{name: "Test gt with non-time filter value", filter: map[string]interface{}{"name": "time_created", "operator": "gt", "values": []interface{}{"yesterday"}}, expected: []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filters := &schema.Set{F: schema.HashResource(DataSourceFiltersSchema().Elem.(*schema.Resource))}
+			filters.Add(test.filter)
+
+			names := []string{}
+			for _, item := range ApplyFilters(filters, items, CoreInstanceResource().Schema) {
+				names = append(names, item["display_name"].(string))
+			}
+			assert.Equal(t, test.expected, names)
+		})
+	}
+}
+
 // issue-routing-tag: terraform/default
 func TestUnitApplyFilters_multiProperty(t *testing.T) {
 	items := []map[string]interface{}{
@@ -885,50 +1007,77 @@ func TestUnitGetValue_MultiLevelMap(t *testing.T) {
 
 // issue-routing-tag: terraform/default
 func TestUnitGetPathElements_EmptyFilterName(t *testing.T) {
-	if _, error := getFieldPathElements(CoreInstanceResource().Schema, ""); error == nil {
+	if _, _, error := getFieldPathElements(CoreInstanceResource().Schema, ""); error == nil {
 		t.Error("expected non nil error")
 	}
 }
 
 // issue-routing-tag: terraform/default
 func TestUnitGetPathElements_NonExistentPropertyTopLevel(t *testing.T) {
-	if _, error := getFieldPathElements(CoreInstanceResource().Schema, "non_existent"); error == nil {
+	if _, _, error := getFieldPathElements(CoreInstanceResource().Schema, "non_existent"); error == nil {
 		t.Error("expected non nil error")
 	}
 }
 
 // issue-routing-tag: terraform/default
 func TestUnitGetPathElements_NonExistentPropertyNestedLevel(t *testing.T) {
-	if _, error := getFieldPathElements(CoreInstanceResource().Schema, "create_vnic_details.non_existent"); error == nil {
+	if _, _, error := getFieldPathElements(CoreInstanceResource().Schema, "create_vnic_details.non_existent"); error == nil {
 		t.Error("expected non nil error")
 	}
 }
 
 // issue-routing-tag: terraform/default
 func TestUnitGetPathElements_TopLevelPrimitive(t *testing.T) {
-	if path, error := getFieldPathElements(CoreInstanceResource().Schema, "boot_volume_id"); error != nil || !reflect.DeepEqual(path, []string{"boot_volume_id"}) {
+	if path, _, error := getFieldPathElements(CoreInstanceResource().Schema, "boot_volume_id"); error != nil || !reflect.DeepEqual(path, []string{"boot_volume_id"}) {
 		t.Errorf("unexpected path value %s found", path)
 	}
 }
 
 // issue-routing-tag: terraform/default
 func TestUnitGetPathElements_MultiLevelMap(t *testing.T) {
-	if path, error := getFieldPathElements(CoreInstanceResource().Schema, "create_vnic_details.defined_tags.namespace.key"); error != nil || !reflect.DeepEqual(path, []string{"create_vnic_details", "defined_tags", "namespace.key"}) {
+	if path, _, error := getFieldPathElements(CoreInstanceResource().Schema, "create_vnic_details.defined_tags.namespace.key"); error != nil || !reflect.DeepEqual(path, []string{"create_vnic_details", "defined_tags", "namespace.key"}) {
 		t.Errorf("unexpected path value %s found", path)
 	}
 }
 
 // issue-routing-tag: terraform/default
 func TestUnitGetPathElements_MultiLevelNonMap(t *testing.T) {
-	if path, error := getFieldPathElements(CoreInstanceResource().Schema, "launch_options.firmware"); error != nil || !reflect.DeepEqual(path, []string{"launch_options", "firmware"}) {
+	if path, _, error := getFieldPathElements(CoreInstanceResource().Schema, "launch_options.firmware"); error != nil || !reflect.DeepEqual(path, []string{"launch_options", "firmware"}) {
 		t.Errorf("unexpected path value %s found", path)
 	}
-	if _, error := getFieldPathElements(CoreInstanceResource().Schema, "launch_options.firmware.XYZ"); error == nil {
+	if _, _, error := getFieldPathElements(CoreInstanceResource().Schema, "launch_options.firmware.XYZ"); error == nil {
 		t.Errorf("Expected Error")
 	}
 }
 
 /*
+// issue-routing-tag: terraform/default
+func TestUnitGetPathElements_valueType(t *testing.T) {
+	resourceSchema := CoreInstanceResource().Schema
+	resourceSchema["size_in_gbs"] = &schema.Schema{Type: schema.TypeInt, Computed: true}
+	resourceSchema["ocpus"] = &schema.Schema{Type: schema.TypeFloat, Computed: true}
+	resourceSchema["nsg_ids"] = &schema.Schema{Type: schema.TypeSet, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}}
+
+	tests := []struct {
+		filterName string
+		valueType  schema.ValueType
+	}{
+		{filterName: "time_created", valueType: schema.TypeString},
+		{filterName: "async", valueType: schema.TypeBool},
+		{filterName: "size_in_gbs", valueType: schema.TypeInt},
+		{filterName: "ocpus", valueType: schema.TypeFloat},
+		{filterName: "nsg_ids", valueType: schema.TypeString},
+		{filterName: "freeform_tags.department", valueType: schema.TypeString},
+		{filterName: "create_vnic_details.defined_tags.namespace.key", valueType: schema.TypeString},
+		{filterName: "launch_options.firmware", valueType: schema.TypeString},
+	}
+	for _, test := range tests {
+		_, valueType, err := getFieldPathElements(resourceSchema, test.filterName)
+		assert.NoError(t, err, test.filterName)
+		assert.Equal(t, test.valueType, valueType, test.filterName)
+	}
+}
+
 // issue-routing-tag: terraform/default
 func TestUnitNestedMap(t *testing.T) {
 	item := map[string]interface{}{
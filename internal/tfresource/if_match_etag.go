@@ -0,0 +1,124 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IfMatchETagsEnabled is set in the provider block to send the etag of a resource, observed when it was last read,
+// with the requests that update or delete it
+var IfMatchETagsEnabled bool
+
+// ifMatchETagPrivateKey is the key of the etag of a resource in its private state, which Terraform keeps along with the
+// state of the resource and sends back with the next requests about it, without showing it
+const ifMatchETagPrivateKey = "if_match_etag"
+
+type privateETagContextKey struct{}
+
+// privateETag is the etag of the resource of a request of Terraform, read from the private state of the request and
+// added to the private state of its response
+type privateETag struct {
+	lock sync.Mutex
+	etag string
+	// Whether a request changed the resource since its etag was read, which makes the etag stale
+	changed bool
+}
+
+// WithPrivateETag returns the context to handle a request of Terraform about a resource with, from which its CRUD type
+// reads the etag kept in the private state of the request, along with the function that adds the etag the CRUD type
+// last read to the private state of the response. The etags are only kept when IfMatchETagsEnabled is set.
+func WithPrivateETag(ctx context.Context, private []byte) (context.Context, func(private []byte) []byte) {
+	if !IfMatchETagsEnabled {
+		return ctx, func(private []byte) []byte { return private }
+	}
+	state := &privateETag{}
+	var values map[string]interface{}
+	if len(private) > 0 && json.Unmarshal(private, &values) == nil {
+		state.etag, _ = values[ifMatchETagPrivateKey].(string)
+	}
+	return context.WithValue(ctx, privateETagContextKey{}, state), state.addTo
+}
+
+// addTo adds the etag to the private state of a response, unless it is unknown or stale
+func (p *privateETag) addTo(private []byte) []byte {
+	p.lock.Lock()
+	etag := p.etag
+	if p.changed {
+		etag = ""
+	}
+	p.lock.Unlock()
+	if etag == "" {
+		return private
+	}
+
+	values := make(map[string]interface{})
+	if len(private) > 0 {
+		if err := json.Unmarshal(private, &values); err != nil {
+			return private
+		}
+	}
+	values[ifMatchETagPrivateKey] = etag
+	result, err := json.Marshal(values)
+	if err != nil {
+		return private
+	}
+	return result
+}
+
+// ResourceChanged records that the operation of ctx sent a request that changes its resource, e.g. the update of a
+// compartment before the one of the other attributes, so that its later requests do not send the etag it made stale
+func ResourceChanged(ctx context.Context) {
+	if state, ok := ctx.Value(privateETagContextKey{}).(*privateETag); ok {
+		state.lock.Lock()
+		state.changed = true
+		state.lock.Unlock()
+	}
+}
+
+// privateETagOf returns the etag of the resource data, kept in the context of the operation it is used in, or nil if it
+// is not used in the operation of a request of Terraform about a resource, e.g. in the read of a data source
+func privateETagOf(d *schema.ResourceData) *privateETag {
+	if !IfMatchETagsEnabled || d == nil {
+		return nil
+	}
+	state, _ := operationContext(d).Value(privateETagContextKey{}).(*privateETag)
+	return state
+}
+
+// IfMatchETag returns the etag to set as the `IfMatch` of an update or delete request of the resource, or nil if
+// IfMatchETagsEnabled is not set, the etag of the resource is unknown or a previous request of the operation changed
+// the resource since its etag was read
+func IfMatchETag(d *schema.ResourceData) *string {
+	state := privateETagOf(d)
+	if state == nil {
+		return nil
+	}
+	state.lock.Lock()
+	defer state.lock.Unlock()
+	if state.changed || state.etag == "" {
+		return nil
+	}
+	etag := state.etag
+	return &etag
+}
+
+// SetIfMatchETag keeps the etag of the response of a request that read the resource, when IfMatchETagsEnabled is set
+func SetIfMatchETag(d *schema.ResourceData, etag *string) {
+	if etag == nil {
+		return
+	}
+	state := privateETagOf(d)
+	if state == nil {
+		return
+	}
+	state.lock.Lock()
+	defer state.lock.Unlock()
+	state.etag = *etag
+	state.changed = false
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitIfMatchETag(t *testing.T) {
+	defer func() { IfMatchETagsEnabled = false }()
+	resourceSchema := &schema.Resource{Schema: map[string]*schema.Schema{}}
+	private := []byte(`{"e2bfb730-ecaa-11e6-8f88-34363bc7c4c0":{"create":1200000000000},"if_match_etag":"etag-1"}`)
+	etag := "etag-1"
+	newETag := "etag-2"
+
+	tests := []struct {
+		name        string
+		enabled     bool
+		private     []byte
+		operation   func(ctx context.Context, d *schema.ResourceData)
+		wantETag    *string
+		wantPrivate string
+	}{
+		{
+			name:        "Test etag not sent by default",
+			private:     private,
+			wantPrivate: string(private),
+		},
+		{
+			name:        "Test etag of the private state is sent",
+			enabled:     true,
+			private:     private,
+			wantETag:    &etag,
+			wantPrivate: string(private),
+		},
+		{
+			name:    "Test etag read is sent and kept in the private state",
+			enabled: true,
+			private: private,
+			operation: func(ctx context.Context, d *schema.ResourceData) {
+				SetIfMatchETag(d, &newETag)
+			},
+			wantETag:    &newETag,
+			wantPrivate: `{"e2bfb730-ecaa-11e6-8f88-34363bc7c4c0":{"create":1200000000000},"if_match_etag":"etag-2"}`,
+		},
+		{
+			name:    "Test etag of a new resource",
+			enabled: true,
+			operation: func(ctx context.Context, d *schema.ResourceData) {
+				SetIfMatchETag(d, &newETag)
+			},
+			wantETag:    &newETag,
+			wantPrivate: `{"if_match_etag":"etag-2"}`,
+		},
+		{
+			name:    "Test etag not sent once the resource changed",
+			enabled: true,
+			private: []byte(`{"if_match_etag":"etag-1"}`),
+			operation: func(ctx context.Context, d *schema.ResourceData) {
+				ResourceChanged(ctx)
+			},
+			wantPrivate: `{"if_match_etag":"etag-1"}`,
+		},
+		{
+			name:    "Test etag read after the resource changed",
+			enabled: true,
+			private: []byte(`{"if_match_etag":"etag-1"}`),
+			operation: func(ctx context.Context, d *schema.ResourceData) {
+				ResourceChanged(ctx)
+				SetIfMatchETag(d, &newETag)
+			},
+			wantETag:    &newETag,
+			wantPrivate: `{"if_match_etag":"etag-2"}`,
+		},
+		{
+			name:    "Test response without etag",
+			enabled: true,
+			operation: func(ctx context.Context, d *schema.ResourceData) {
+				SetIfMatchETag(d, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			IfMatchETagsEnabled = tt.enabled
+			ctx, addPrivateETag := WithPrivateETag(context.Background(), tt.private)
+			d := resourceSchema.TestResourceData()
+			var gotETag *string
+			runResourceOperation(ctx, d, func() {
+				if tt.operation != nil {
+					tt.operation(ctx, d)
+				}
+				gotETag = IfMatchETag(d)
+			})
+			assert.Equal(t, tt.wantETag, gotETag)
+			if tt.wantPrivate == "" {
+				assert.Equal(t, tt.private, addPrivateETag(tt.private))
+			} else {
+				assert.JSONEq(t, tt.wantPrivate, string(addPrivateETag(tt.private)))
+			}
+		})
+	}
+}
+
+func TestUnitIfMatchETag_staleETagNotKept(t *testing.T) {
+	defer func() { IfMatchETagsEnabled = false }()
+	IfMatchETagsEnabled = true
+	ctx, addPrivateETag := WithPrivateETag(context.Background(), []byte(`{"if_match_etag":"etag-1","schema_version":"1"}`))
+	ResourceChanged(ctx)
+
+	var private map[string]interface{}
+	assert.NoError(t, json.Unmarshal(addPrivateETag([]byte(`{"schema_version":"1"}`)), &private))
+	assert.Equal(t, map[string]interface{}{"schema_version": "1"}, private)
+}
+
+func TestUnitIfMatchETag_withoutPrivateState(t *testing.T) {
+	defer func() { IfMatchETagsEnabled = false }()
+	IfMatchETagsEnabled = true
+	etag := "etag-1"
+
+	// The etag is not kept outside of the requests of Terraform about a resource, e.g. by the data sources or by
+	// resource discovery
+	d := (&schema.Resource{Schema: map[string]*schema.Schema{}}).TestResourceData()
+	runResourceOperation(context.Background(), d, func() {
+		SetIfMatchETag(d, &etag)
+		assert.Nil(t, IfMatchETag(d))
+	})
+	SetIfMatchETag(d, &etag)
+	assert.Nil(t, IfMatchETag(d))
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
+)
+
+// resourceOperation is the operation of Terraform a legacy CRUD function is run from
+type resourceOperation struct {
+	ctx      context.Context
+	warnings diag.Diagnostics
+}
+
+var (
+	// The operations the legacy CRUD functions are run from, by their ResourceData
+	resourceOperations     = make(map[*schema.ResourceData]*resourceOperation)
+	resourceOperationsLock sync.Mutex
+)
+
+// runResourceOperation runs the legacy CRUD function of the resource data in the operation of ctx, and returns the
+// warnings the CRUD helpers it calls report with reportWarnings
+func runResourceOperation(ctx context.Context, d *schema.ResourceData, operation func()) diag.Diagnostics {
+	resourceOperationsLock.Lock()
+	resourceOperations[d] = &resourceOperation{ctx: ctx}
+	resourceOperationsLock.Unlock()
+
+	operation()
+
+	resourceOperationsLock.Lock()
+	defer resourceOperationsLock.Unlock()
+	warnings := resourceOperations[d].warnings
+	delete(resourceOperations, d)
+	return warnings
+}
+
+// lookupResourceOperation returns the operation the resource data is used in. It must be called with
+// resourceOperationsLock held.
+func lookupResourceOperation(d schemaResourceData) *resourceOperation {
+	if resourceData, ok := d.(*schema.ResourceData); ok && resourceData != nil {
+		return resourceOperations[resourceData]
+	}
+	return nil
+}
+
+// addOperationWarnings adds the warnings to the operation the resource data is used in, and returns false if it is not
+// used in one
+func addOperationWarnings(d schemaResourceData, diags diag.Diagnostics) bool {
+	resourceOperationsLock.Lock()
+	defer resourceOperationsLock.Unlock()
+	operation := lookupResourceOperation(d)
+	if operation == nil {
+		return false
+	}
+	operation.warnings = append(operation.warnings, diags...)
+	return true
+}
+
+// operationContext returns the context of the operation the resource data is used in, so that the CRUD helpers and
+// waiters called by the legacy CRUD functions stop when Terraform cancels it. It returns context.Background() if the
+// resource data is not used in an operation, e.g. by resource discovery.
+func operationContext(d schemaResourceData) context.Context {
+	resourceOperationsLock.Lock()
+	defer resourceOperationsLock.Unlock()
+	if operation := lookupResourceOperation(d); operation != nil && operation.ctx != nil {
+		return operation.ctx
+	}
+	return context.Background()
+}
+
+// workRequestClientContext returns the context of the operation the requests of the work request client are sent
+// with, if it is one of the clients of an operation, or context.Background()
+func workRequestClientContext(workRequestClient workReqClient) context.Context {
+	if client, ok := workRequestClient.(*oci_work_requests.WorkRequestClient); ok && client != nil {
+		if dispatcher, ok := client.HTTPClient.(interface{ OperationContext() context.Context }); ok {
+			return dispatcher.OperationContext()
+		}
+	}
+	return context.Background()
+}
+
+// sleepWithContext sleeps for the duration, or until the context is done
+func sleepWithContext(ctx context.Context, duration time.Duration) {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
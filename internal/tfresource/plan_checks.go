@@ -0,0 +1,250 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+)
+
+// The checks in this file are CustomizeDiff functions that reject, at plan time, configurations the services would
+// reject mid-apply. They only check the values that are known, and that change, so that they do not fail plans for
+// resources that already exist as they are.
+
+// Minimum and maximum memory per OCPU, in GBs, of the flexible shapes
+const (
+	FlexShapeMinMemoryPerOcpu = 1.0
+	FlexShapeMaxMemoryPerOcpu = 64.0
+)
+
+// CidrBlocksLookupFunc returns the CIDR blocks a check compares a CIDR block of the resource with, e.g. the ones of
+// its VCN. It returns nil if they are unknown.
+type CidrBlocksLookupFunc func(ctx context.Context, d *schema.ResourceDiff, m interface{}) ([]string, error)
+
+// CidrBlocksDoNotOverlapDiff checks that the CIDR blocks of a list attribute, e.g. the `cidr_blocks` of a VCN, do not
+// overlap each other
+func CidrBlocksDoNotOverlapDiff(attr string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !isKnownChange(d, attr) {
+			return nil
+		}
+		cidrBlocks, ok := d.Get(attr).([]interface{})
+		if !ok {
+			return nil
+		}
+		var networks []*net.IPNet
+		for _, cidrBlock := range cidrBlocks {
+			_, network, err := net.ParseCIDR(fmt.Sprint(cidrBlock))
+			if err != nil {
+				return fmt.Errorf("%s: invalid CIDR block '%v': %v", attr, cidrBlock, err)
+			}
+			for _, other := range networks {
+				if cidrBlocksOverlap(network, other) {
+					return fmt.Errorf("%s: the CIDR blocks '%s' and '%s' overlap", attr, other, network)
+				}
+			}
+			networks = append(networks, network)
+		}
+		return nil
+	}
+}
+
+// CidrBlockWithinDiff checks that a CIDR block, e.g. the `cidr_block` of a subnet, is within one of the CIDR blocks
+// of what contains it, e.g. its VCN
+func CidrBlockWithinDiff(attr string, container string, containerCidrBlocks CidrBlocksLookupFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		network, ok := knownChangedCidrBlock(d, attr)
+		if !ok {
+			return nil
+		}
+		cidrBlocks, err := containerCidrBlocks(ctx, d, m)
+		if err != nil {
+			log.Printf("[WARN] the CIDR blocks of the %s of %s could not be checked: %v", container, attr, err)
+			return nil
+		}
+		if cidrBlocks == nil {
+			return nil
+		}
+		for _, cidrBlock := range cidrBlocks {
+			if _, containerNetwork, err := net.ParseCIDR(cidrBlock); err == nil && cidrBlockContains(containerNetwork, network) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: the CIDR block '%s' is not within the CIDR blocks of the %s: %s", attr, network, container, strings.Join(cidrBlocks, ", "))
+	}
+}
+
+// CidrBlockDoesNotOverlapDiff checks that a CIDR block, e.g. the `cidr_block` of a subnet, does not overlap the CIDR
+// blocks of its siblings, e.g. the other subnets of its VCN
+func CidrBlockDoesNotOverlapDiff(attr string, siblings string, siblingCidrBlocks CidrBlocksLookupFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		network, ok := knownChangedCidrBlock(d, attr)
+		if !ok {
+			return nil
+		}
+		cidrBlocks, err := siblingCidrBlocks(ctx, d, m)
+		if err != nil {
+			log.Printf("[WARN] the CIDR blocks of the %s of %s could not be checked: %v", siblings, attr, err)
+			return nil
+		}
+		for _, cidrBlock := range cidrBlocks {
+			if _, siblingNetwork, err := net.ParseCIDR(cidrBlock); err == nil && cidrBlocksOverlap(network, siblingNetwork) {
+				return fmt.Errorf("%s: the CIDR block '%s' overlaps the CIDR block '%s' of the %s", attr, network, cidrBlock, siblings)
+			}
+		}
+		return nil
+	}
+}
+
+// FlexShapeMemoryDiff checks that the memory per OCPU of a flexible shape, e.g. `VM.Standard.E4.Flex`, is within
+// FlexShapeMinMemoryPerOcpu and FlexShapeMaxMemoryPerOcpu, when both its OCPUs and memory are set
+func FlexShapeMemoryDiff(shapeAttr string, ocpusAttr string, memoryAttr string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.NewValueKnown(shapeAttr) || !d.NewValueKnown(ocpusAttr) || !d.NewValueKnown(memoryAttr) {
+			return nil
+		}
+		if d.Id() != "" && !d.HasChange(shapeAttr) && !d.HasChange(ocpusAttr) && !d.HasChange(memoryAttr) {
+			return nil
+		}
+		shape, _ := d.Get(shapeAttr).(string)
+		if !strings.HasSuffix(shape, ".Flex") {
+			return nil
+		}
+		ocpus, _ := d.Get(ocpusAttr).(float64)
+		memory, _ := d.Get(memoryAttr).(float64)
+		if ocpus <= 0 || memory <= 0 {
+			return nil
+		}
+		if memoryPerOcpu := memory / ocpus; memoryPerOcpu < FlexShapeMinMemoryPerOcpu || memoryPerOcpu > FlexShapeMaxMemoryPerOcpu {
+			return fmt.Errorf("%s: the memory of the %s shape must be between %v and %v GBs per OCPU, got %v GBs for %v OCPUs",
+				memoryAttr, shape, FlexShapeMinMemoryPerOcpu, FlexShapeMaxMemoryPerOcpu, memory, ocpus)
+		}
+		return nil
+	}
+}
+
+// KmsKeyRegionDiff checks that the KMS key of a resource is in the region of the resource, which the region function
+// returns
+func KmsKeyRegionDiff(keyAttr string, region func(d *schema.ResourceDiff, m interface{}) string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !isKnownChange(d, keyAttr) {
+			return nil
+		}
+		keyId, _ := d.Get(keyAttr).(string)
+		keyRegion := RegionOfOcid(keyId)
+		resourceRegion := region(d, m)
+		if keyRegion == "" || resourceRegion == "" {
+			return nil
+		}
+		if !strings.EqualFold(string(oci_common.StringToRegion(keyRegion)), string(oci_common.StringToRegion(resourceRegion))) {
+			return fmt.Errorf("%s: the key '%s' is in the %s region, it must be in the %s region of the resource", keyAttr, keyId, keyRegion, resourceRegion)
+		}
+		return nil
+	}
+}
+
+// RegionOfOcid returns the region in an OCID, e.g. `iad` in `ocid1.key.oc1.iad.<unique ID>`, or "" for OCIDs of
+// resources that are not regional
+func RegionOfOcid(ocid string) string {
+	parts := strings.Split(ocid, ".")
+	if len(parts) < 5 || parts[0] != "ocid1" {
+		return ""
+	}
+	return parts[3]
+}
+
+// MutuallyExclusiveGroupsDiff checks that the attributes of at most one of the groups are set in the configuration,
+// e.g. either the `image` or the `source_details` of an instance. Nested attributes are set as `source_details.0.source_id`.
+func MutuallyExclusiveGroupsDiff(groups ...[]string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		config := d.GetRawConfig()
+		var setGroups []string
+		for _, group := range groups {
+			for _, attr := range group {
+				if isSetInConfig(config, attr) {
+					setGroups = append(setGroups, strings.Join(group, ", "))
+					break
+				}
+			}
+		}
+		if len(setGroups) > 1 {
+			return fmt.Errorf("only one of [%s] can be set, got [%s]", joinGroups(groups), strings.Join(setGroups, "] and ["))
+		}
+		return nil
+	}
+}
+
+func joinGroups(groups [][]string) string {
+	joined := make([]string, len(groups))
+	for i, group := range groups {
+		joined[i] = strings.Join(group, ", ")
+	}
+	return strings.Join(joined, "] or [")
+}
+
+// isSetInConfig returns true if the attribute, e.g. `source_details.0.source_id`, is set in the configuration
+func isSetInConfig(config cty.Value, attr string) bool {
+	value := config
+	for _, step := range strings.Split(attr, ".") {
+		if value.IsNull() || !value.IsKnown() {
+			return !value.IsNull()
+		}
+		valueType := value.Type()
+		switch {
+		case valueType.IsObjectType():
+			if !valueType.HasAttribute(step) {
+				return false
+			}
+			value = value.GetAttr(step)
+		case valueType.IsListType() || valueType.IsTupleType():
+			index, err := strconv.Atoi(step)
+			if err != nil || index >= value.LengthInt() {
+				return false
+			}
+			value = value.Index(cty.NumberIntVal(int64(index)))
+		case valueType.IsMapType():
+			if !value.HasIndex(cty.StringVal(step)).True() {
+				return false
+			}
+			value = value.Index(cty.StringVal(step))
+		default:
+			return false
+		}
+	}
+	return !value.IsNull()
+}
+
+func isKnownChange(d *schema.ResourceDiff, attr string) bool {
+	return d.NewValueKnown(attr) && (d.Id() == "" || d.HasChange(attr))
+}
+
+func knownChangedCidrBlock(d *schema.ResourceDiff, attr string) (*net.IPNet, bool) {
+	if !isKnownChange(d, attr) {
+		return nil, false
+	}
+	cidrBlock, _ := d.Get(attr).(string)
+	_, network, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return nil, false
+	}
+	return network, true
+}
+
+func cidrBlocksOverlap(a *net.IPNet, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func cidrBlockContains(container *net.IPNet, network *net.IPNet) bool {
+	containerOnes, containerBits := container.Mask.Size()
+	networkOnes, networkBits := network.Mask.Size()
+	return containerBits == networkBits && networkOnes >= containerOnes && container.Contains(network.IP)
+}
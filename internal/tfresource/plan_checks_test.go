@@ -0,0 +1,183 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func planCheckTestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cidr_block":    {Type: schema.TypeString, Optional: true},
+		"cidr_blocks":   {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"image":         {Type: schema.TypeString, Optional: true},
+		"kms_key_id":    {Type: schema.TypeString, Optional: true},
+		"memory_in_gbs": {Type: schema.TypeFloat, Optional: true},
+		"ocpus":         {Type: schema.TypeFloat, Optional: true},
+		"shape":         {Type: schema.TypeString, Optional: true},
+		"source_details": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{"source_id": {Type: schema.TypeString, Optional: true}},
+			},
+		},
+	}
+}
+
+func staticCidrBlocks(cidrBlocks []string, err error) CidrBlocksLookupFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) ([]string, error) {
+		return cidrBlocks, err
+	}
+}
+
+// planCheckDiff plans the creation of a resource with the configuration, or its update if state is set, and returns
+// the error of the check
+func planCheckDiff(t *testing.T, check schema.CustomizeDiffFunc, state map[string]string, config map[string]interface{}) error {
+	resource := &schema.Resource{Schema: planCheckTestSchema(), CustomizeDiff: check}
+	configJson, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("invalid configuration: %v", err)
+	}
+	rawConfig, err := ctyjson.Unmarshal(configJson, resource.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("invalid configuration: %v", err)
+	}
+	instanceState := &terraform.InstanceState{RawConfig: rawConfig}
+	if state != nil {
+		instanceState.ID = "ocid1.test.oc1..aaaa"
+		instanceState.Attributes = state
+	}
+	_, err = resource.Diff(context.Background(), instanceState, terraform.NewResourceConfigRaw(config), nil)
+	return err
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitPlanChecks(t *testing.T) {
+	regionOf := func(region string) func(d *schema.ResourceDiff, m interface{}) string {
+		return func(d *schema.ResourceDiff, m interface{}) string { return region }
+	}
+
+	tests := []struct {
+		name    string
+		check   schema.CustomizeDiffFunc
+		state   map[string]string
+		config  map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:   "Test CIDR blocks that do not overlap",
+			check:  CidrBlocksDoNotOverlapDiff("cidr_blocks"),
+			config: map[string]interface{}{"cidr_blocks": []interface{}{"10.0.0.0/16", "10.1.0.0/16"}},
+		},
+		{
+			name:    "Test CIDR blocks that overlap",
+			check:   CidrBlocksDoNotOverlapDiff("cidr_blocks"),
+			config:  map[string]interface{}{"cidr_blocks": []interface{}{"10.0.0.0/16", "10.0.128.0/24"}},
+			wantErr: "cidr_blocks: the CIDR blocks '10.0.0.0/16' and '10.0.128.0/24' overlap",
+		},
+		{
+			name:   "Test CIDR blocks that overlap but do not change",
+			check:  CidrBlocksDoNotOverlapDiff("cidr_blocks"),
+			state:  map[string]string{"id": "ocid1.test.oc1..aaaa", "cidr_blocks.#": "2", "cidr_blocks.0": "10.0.0.0/16", "cidr_blocks.1": "10.0.128.0/24"},
+			config: map[string]interface{}{"cidr_blocks": []interface{}{"10.0.0.0/16", "10.0.128.0/24"}},
+		},
+		{
+			name:   "Test CIDR block within the CIDR blocks of its VCN",
+			check:  CidrBlockWithinDiff("cidr_block", "VCN", staticCidrBlocks([]string{"192.168.0.0/16", "10.0.0.0/16"}, nil)),
+			config: map[string]interface{}{"cidr_block": "10.0.1.0/24"},
+		},
+		{
+			name:    "Test CIDR block outside the CIDR blocks of its VCN",
+			check:   CidrBlockWithinDiff("cidr_block", "VCN", staticCidrBlocks([]string{"10.0.0.0/16"}, nil)),
+			config:  map[string]interface{}{"cidr_block": "10.0.0.0/8"},
+			wantErr: "cidr_block: the CIDR block '10.0.0.0/8' is not within the CIDR blocks of the VCN: 10.0.0.0/16",
+		},
+		{
+			name:   "Test CIDR block not checked if the CIDR blocks of its VCN can not be looked up",
+			check:  CidrBlockWithinDiff("cidr_block", "VCN", staticCidrBlocks(nil, fmt.Errorf("not authorized"))),
+			config: map[string]interface{}{"cidr_block": "10.0.0.0/8"},
+		},
+		{
+			name:    "Test CIDR block that overlaps another subnet",
+			check:   CidrBlockDoesNotOverlapDiff("cidr_block", "other subnets of the VCN", staticCidrBlocks([]string{"10.0.0.0/24", "10.0.1.0/24"}, nil)),
+			config:  map[string]interface{}{"cidr_block": "10.0.1.128/25"},
+			wantErr: "cidr_block: the CIDR block '10.0.1.128/25' overlaps the CIDR block '10.0.1.0/24' of the other subnets of the VCN",
+		},
+		{
+			name:   "Test CIDR block that does not overlap the other subnets",
+			check:  CidrBlockDoesNotOverlapDiff("cidr_block", "other subnets of the VCN", staticCidrBlocks([]string{"10.0.0.0/24"}, nil)),
+			config: map[string]interface{}{"cidr_block": "10.0.1.0/24"},
+		},
+		{
+			name:   "Test flex shape memory within bounds",
+			check:  FlexShapeMemoryDiff("shape", "ocpus", "memory_in_gbs"),
+			config: map[string]interface{}{"shape": "VM.Standard.E4.Flex", "ocpus": 2, "memory_in_gbs": 32},
+		},
+		{
+			name:    "Test flex shape memory above bounds",
+			check:   FlexShapeMemoryDiff("shape", "ocpus", "memory_in_gbs"),
+			config:  map[string]interface{}{"shape": "VM.Standard.E4.Flex", "ocpus": 1, "memory_in_gbs": 128},
+			wantErr: "memory_in_gbs: the memory of the VM.Standard.E4.Flex shape must be between 1 and 64 GBs per OCPU, got 128 GBs for 1 OCPUs",
+		},
+		{
+			name:   "Test memory of shape that is not flexible",
+			check:  FlexShapeMemoryDiff("shape", "ocpus", "memory_in_gbs"),
+			config: map[string]interface{}{"shape": "VM.Standard2.1", "ocpus": 1, "memory_in_gbs": 128},
+		},
+		{
+			name:   "Test KMS key in the region of the resource",
+			check:  KmsKeyRegionDiff("kms_key_id", regionOf("us-ashburn-1")),
+			config: map[string]interface{}{"kms_key_id": "ocid1.key.oc1.iad.aaaa.bbbb"},
+		},
+		{
+			name:    "Test KMS key in another region",
+			check:   KmsKeyRegionDiff("kms_key_id", regionOf("us-phoenix-1")),
+			config:  map[string]interface{}{"kms_key_id": "ocid1.key.oc1.iad.aaaa.bbbb"},
+			wantErr: "kms_key_id: the key 'ocid1.key.oc1.iad.aaaa.bbbb' is in the iad region, it must be in the us-phoenix-1 region of the resource",
+		},
+		{
+			name:   "Test KMS key not checked without the region of the resource",
+			check:  KmsKeyRegionDiff("kms_key_id", regionOf("")),
+			config: map[string]interface{}{"kms_key_id": "ocid1.key.oc1.iad.aaaa.bbbb"},
+		},
+		{
+			name:   "Test one of the mutually exclusive groups set",
+			check:  MutuallyExclusiveGroupsDiff([]string{"image"}, []string{"source_details.0.source_id"}),
+			config: map[string]interface{}{"source_details": []interface{}{map[string]interface{}{"source_id": "ocid1.image.oc1..aaaa"}}},
+		},
+		{
+			name:    "Test mutually exclusive groups both set",
+			check:   MutuallyExclusiveGroupsDiff([]string{"image"}, []string{"source_details.0.source_id"}),
+			config:  map[string]interface{}{"image": "ocid1.image.oc1..aaaa", "source_details": []interface{}{map[string]interface{}{"source_id": "ocid1.image.oc1..aaaa"}}},
+			wantErr: "only one of [image] or [source_details.0.source_id] can be set, got [image] and [source_details.0.source_id]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := planCheckDiff(t, tt.check, tt.state, tt.config)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRegionOfOcid(t *testing.T) {
+	assert.Equal(t, "iad", RegionOfOcid("ocid1.key.oc1.iad.aaaa.bbbb"))
+	assert.Equal(t, "", RegionOfOcid("ocid1.tenancy.oc1..aaaa"))
+	assert.Equal(t, "", RegionOfOcid("not-an-ocid"))
+}
@@ -1,20 +1,287 @@
 package tfresource
 
 import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
 )
 
+// OperationClientsProvider is implemented by the provider clients, and returns the clients to use for a resource
+// operation, which send their SDK requests with the context of the operation
+type OperationClientsProvider interface {
+	ClientsWithContext(ctx context.Context) interface{}
+}
+
+// RegionalClientsProvider is implemented by the provider clients, and returns the clients to use for a resource
+// that is managed in a region other than the provider's region
+type RegionalClientsProvider interface {
+	ClientsForRegion(region string) (interface{}, error)
+}
+
+var (
+	// The SDK service of the registered resources, e.g. `database` for `oci_database_db_system`
+	resourceServices = make(map[string]string)
+	// The service whose resources are being registered by RegisterServiceResources
+	registeringService string
+)
+
+// RegisterServiceResources registers the resources of a service with the register function of its package, and records
+// their service, e.g. `database` for the resources registered by the `database` package
+func RegisterServiceResources(service string, register func()) {
+	registeringService = service
+	defer func() { registeringService = "" }()
+	register()
+}
+
+// ResourceServiceName returns the SDK service of a registered resource, e.g. `database` for `oci_database_db_system`,
+// or "" if it was not registered with RegisterServiceResources
+func ResourceServiceName(name string) string {
+	return resourceServices[name]
+}
+
 func RegisterResource(name string, resourceSchema *schema.Resource) {
 	if globalvar.OciResources == nil {
 		globalvar.OciResources = make(map[string]*schema.Resource)
 	}
+	if registeringService != "" {
+		resourceServices[name] = registeringService
+	}
+	addRegionOverride(resourceSchema)
+	addDefaultTags(resourceSchema)
+	addDefaultTimeouts(name, resourceSchema)
 	globalvar.OciResources[name] = resourceSchema
 }
 
+// RegisterWorkRequestResource registers a resource whose creation waits on a work request, and adds the
+// `work_request_failure_retries` block to it, to create it again when the work request fails
+func RegisterWorkRequestResource(name string, resourceSchema *schema.Resource) {
+	addWorkRequestFailureRetries(resourceSchema)
+	RegisterResource(name, resourceSchema)
+}
+
 func RegisterDatasource(name string, datasourceSchema *schema.Resource) {
 	if globalvar.OciDatasources == nil {
 		globalvar.OciDatasources = make(map[string]*schema.Resource)
 	}
 	globalvar.OciDatasources[name] = datasourceSchema
 }
+
+// addRegionOverride adds an optional `region` attribute to the resource, and makes its CRUD operations use the
+// clients for that region. Resources that already have a `region` attribute of their own are left untouched.
+func addRegionOverride(resourceSchema *schema.Resource) {
+	if resourceSchema == nil || resourceSchema.Schema == nil {
+		return
+	}
+	if _, exists := resourceSchema.Schema[globalvar.RegionAttrName]; exists {
+		return
+	}
+
+	resourceSchema.Schema[globalvar.RegionAttrName] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The region in which to manage the resource. It must be the provider's region or one of its `additional_regions`. By default, the provider's region is used.",
+	}
+	resourceSchema.Create = withRegionalClients(resourceSchema.Create)
+	resourceSchema.Read = withRegionalClients(resourceSchema.Read)
+	resourceSchema.Update = withRegionalClients(resourceSchema.Update)
+	resourceSchema.Delete = withRegionalClients(resourceSchema.Delete)
+	resourceSchema.CreateContext = withRegionalClientsContext(resourceSchema.CreateContext)
+	resourceSchema.ReadContext = withRegionalClientsContext(resourceSchema.ReadContext)
+	resourceSchema.UpdateContext = withRegionalClientsContext(resourceSchema.UpdateContext)
+	resourceSchema.DeleteContext = withRegionalClientsContext(resourceSchema.DeleteContext)
+}
+
+func withRegionalClients(fn func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if fn == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients, err := clientsForResourceRegion(d, m)
+		if err != nil {
+			return err
+		}
+		return fn(d, clients)
+	}
+}
+
+func withRegionalClientsContext(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		clients, err := clientsForResourceRegion(d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return fn(ctx, d, clients)
+	}
+}
+
+func clientsForResourceRegion(d schemaResourceData, m interface{}) (interface{}, error) {
+	region, ok := d.GetOkExists(globalvar.RegionAttrName)
+	if !ok || region.(string) == "" {
+		return m, nil
+	}
+	if regionalClientsProvider, ok := m.(RegionalClientsProvider); ok {
+		return regionalClientsProvider.ClientsForRegion(region.(string))
+	}
+	return m, nil
+}
+
+// ClientsForResourceDiff returns the clients to use for the resource of the diff, which are the ones of its `region`
+// if it is set
+func ClientsForResourceDiff(d *schema.ResourceDiff, m interface{}) (interface{}, error) {
+	if !d.NewValueKnown(globalvar.RegionAttrName) {
+		return m, nil
+	}
+	region, ok := d.GetOk(globalvar.RegionAttrName)
+	if !ok || region.(string) == "" {
+		return m, nil
+	}
+	if regionalClientsProvider, ok := m.(RegionalClientsProvider); ok {
+		return regionalClientsProvider.ClientsForRegion(region.(string))
+	}
+	return m, nil
+}
+
+// WithOperationContexts returns copies of the resources, or data sources, whose CRUD operations take the context of
+// Terraform. The context is passed to the operations through the provider meta (see OperationClientsProvider), so that
+// their SDK requests are cancelled when Terraform is interrupted. The operations do not get their timeouts as
+// deadlines, the waiters already use them. The registered resources are left as they are, so that their operations can
+// still be called directly, e.g. by resource discovery.
+func WithOperationContexts(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	result := make(map[string]*schema.Resource, len(resources))
+	for name, resourceSchema := range resources {
+		result[name] = withOperationContexts(name, resourceSchema)
+	}
+	return result
+}
+
+func withOperationContexts(name string, resourceSchema *schema.Resource) *schema.Resource {
+	if resourceSchema == nil {
+		return nil
+	}
+	operations := *resourceSchema
+	if operations.Create != nil {
+		operations.CreateWithoutTimeout = withLogContext(name, "create", withSpan(name, "create", withServiceHealth(name, withOperationContext(operations.Create, true))))
+		operations.Create = nil
+	}
+	if operations.CreateContext != nil {
+		operations.CreateContext = withLogContext(name, "create", withSpan(name, "create", withServiceHealth(name, withOperationClients(operations.CreateContext, true))))
+	}
+	if operations.Read != nil {
+		operations.ReadWithoutTimeout = withLogContext(name, "read", withSpan(name, "read", withServiceHealth(name, withOperationContext(operations.Read, false))))
+		operations.Read = nil
+	}
+	if operations.ReadContext != nil {
+		operations.ReadContext = withLogContext(name, "read", withSpan(name, "read", withServiceHealth(name, withOperationClients(operations.ReadContext, false))))
+	}
+	if operations.Update != nil {
+		operations.UpdateWithoutTimeout = withLogContext(name, "update", withSpan(name, "update", withServiceHealth(name, withOperationContext(operations.Update, true))))
+		operations.Update = nil
+	}
+	if operations.UpdateContext != nil {
+		operations.UpdateContext = withLogContext(name, "update", withSpan(name, "update", withServiceHealth(name, withOperationClients(operations.UpdateContext, true))))
+	}
+	if operations.Delete != nil {
+		operations.DeleteWithoutTimeout = withLogContext(name, "delete", withSpan(name, "delete", withServiceHealth(name, withOperationContext(operations.Delete, false))))
+		operations.Delete = nil
+	}
+	if operations.DeleteContext != nil {
+		operations.DeleteContext = withLogContext(name, "delete", withSpan(name, "delete", withServiceHealth(name, withOperationClients(operations.DeleteContext, false))))
+	}
+	return &operations
+}
+
+// withLogContext adds the resource type, ID and phase of the operation to its context, so that its SDK calls carry
+// them in the structured logs, and logs the completion of the operation
+func withLogContext(name string, phase string, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if !utils.StructuredLoggingEnabled() {
+			return fn(ctx, d, m)
+		}
+
+		logContext := &utils.LogContext{ResourceType: name, Phase: phase, ResourceData: d}
+		start := time.Now()
+		diags := fn(utils.WithLogContext(ctx, logContext), d, m)
+
+		entry := utils.StructuredLogEntry{
+			Message:      phase + " completed",
+			ResourceType: name,
+			ResourceId:   logContext.ResourceId(),
+			Phase:        phase,
+			LatencyMs:    time.Since(start).Milliseconds(),
+		}
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				entry.Level = "ERROR"
+				entry.Message = phase + " failed"
+				entry.Error = diagnostic.Summary
+				break
+			}
+		}
+		utils.LogStructured(entry)
+		return diags
+	}
+}
+
+// withSpan records the operation as the root span of a trace, and adds it to the context of the operation so that the
+// spans of its CRUD helpers and SDK calls are its children
+func withSpan(name string, phase string, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ctx, span := utils.StartSpan(ctx, name+" "+phase, utils.SpanKindInternal)
+		if span == nil {
+			return fn(ctx, d, m)
+		}
+		span.SetAttribute("oci.resource.type", name)
+		span.SetAttribute("oci.operation", phase)
+
+		diags := fn(ctx, d, m)
+		if id := d.Id(); id != "" {
+			span.SetAttribute("oci.resource.id", id)
+		}
+		var err error
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				err = errors.New(diagnostic.Summary)
+				break
+			}
+		}
+		span.End(err)
+		return diags
+	}
+}
+
+// withOperationContext runs the legacy CRUD operation with clients that send their requests with the context of
+// Terraform, and with CRUD helpers and waiters that stop when it is done
+func withOperationContext(fn func(*schema.ResourceData, interface{}) error, appliesConfig bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return withOperationClients(func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return DiagnosticsFromError(fn(d, m))
+	}, appliesConfig)
+}
+
+// withOperationClients runs the CRUD operation with clients that send their requests with the context of Terraform, and
+// reports the warnings of the CRUD helpers that return an error. The operations that apply the configuration also
+// report the placeholder values left in it as warnings.
+func withOperationClients(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, appliesConfig bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if clientsProvider, ok := m.(OperationClientsProvider); ok {
+			m = clientsProvider.ClientsWithContext(ctx)
+		}
+		var diags diag.Diagnostics
+		warnings := runResourceOperation(ctx, d, func() { diags = fn(ctx, d, m) })
+		diags = append(diags, warnings...)
+		if appliesConfig {
+			for _, warning := range placeholderValueWarnings(d.GetRawConfig()) {
+				diags = append(diags, warning.diagnostic())
+			}
+		}
+		return diags
+	}
+}
@@ -1,11 +1,21 @@
 package tfresource
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestUnitRegisterHelper(t *testing.T) {
@@ -42,3 +52,317 @@ func TestUnitRegisterHelper(t *testing.T) {
 		})
 	}
 }
+
+type mockRegionalClientsProvider struct {
+	region string
+}
+
+func (p *mockRegionalClientsProvider) ClientsForRegion(region string) (interface{}, error) {
+	if region == "invalid-region" {
+		return nil, fmt.Errorf("region '%s' is not configured for the provider", region)
+	}
+	return &mockRegionalClientsProvider{region: region}, nil
+}
+
+func TestUnitRegisterResource_regionOverride(t *testing.T) {
+	var usedClients interface{}
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			usedClients = m
+			return nil
+		},
+	}
+	RegisterResource("oci_test_region_override", testResource)
+
+	regionSchema, exists := testResource.Schema[globalvar.RegionAttrName]
+	assert.True(t, exists)
+	assert.True(t, regionSchema.Optional)
+	assert.True(t, regionSchema.ForceNew)
+	assert.Nil(t, testResource.Read)
+
+	providerClients := &mockRegionalClientsProvider{region: "us-phoenix-1"}
+	tests := []struct {
+		name       string
+		region     string
+		wantRegion string
+		wantErr    bool
+	}{
+		{
+			name:       "Test region not set uses provider clients",
+			region:     "",
+			wantRegion: "us-phoenix-1",
+		},
+		{
+			name:       "Test region set uses regional clients",
+			region:     "us-ashburn-1",
+			wantRegion: "us-ashburn-1",
+		},
+		{
+			name:    "Test region not configured for the provider",
+			region:  "invalid-region",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usedClients = nil
+			d := testResource.TestResourceData()
+			if tt.region != "" {
+				d.Set(globalvar.RegionAttrName, tt.region)
+			}
+			err := testResource.Create(d, providerClients)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, usedClients)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRegion, usedClients.(*mockRegionalClientsProvider).region)
+		})
+	}
+
+	// Resources with a region attribute of their own are not modified
+	regionalResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			globalvar.RegionAttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	RegisterResource("oci_test_regional_resource", regionalResource)
+	assert.True(t, regionalResource.Schema[globalvar.RegionAttrName].Required)
+}
+
+type mockOperationClientsProvider struct {
+	ctx context.Context
+}
+
+func (p *mockOperationClientsProvider) ClientsWithContext(ctx context.Context) interface{} {
+	return &mockOperationClientsProvider{ctx: ctx}
+}
+
+func TestUnitWithOperationContexts(t *testing.T) {
+	var usedClients interface{}
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			usedClients = m
+			return nil
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return fmt.Errorf("resource not found")
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return nil
+		},
+	}
+	resources := WithOperationContexts(map[string]*schema.Resource{"oci_test_operation_context": testResource})
+
+	// The registered resource is left as it is
+	assert.NotNil(t, testResource.Create)
+	assert.NotNil(t, testResource.Read)
+	assert.Nil(t, testResource.CreateWithoutTimeout)
+
+	operations := resources["oci_test_operation_context"]
+	assert.Nil(t, operations.Create)
+	assert.Nil(t, operations.Read)
+	assert.Nil(t, operations.Delete)
+	assert.Nil(t, operations.UpdateWithoutTimeout)
+	assert.NoError(t, operations.InternalValidate(nil, true))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := operations.TestResourceData()
+	assert.False(t, operations.CreateWithoutTimeout(ctx, d, &mockOperationClientsProvider{}).HasError())
+	assert.Equal(t, ctx, usedClients.(*mockOperationClientsProvider).ctx)
+
+	// The provider meta is passed as it is when it can not bind the context
+	assert.False(t, operations.CreateWithoutTimeout(ctx, d, nil).HasError())
+	assert.Nil(t, usedClients)
+
+	diags := operations.ReadWithoutTimeout(ctx, d, nil)
+	if assert.True(t, diags.HasError()) {
+		assert.Equal(t, "resource not found", diags[0].Summary)
+	}
+}
+
+func TestUnitWithOperationContexts_contextCrud(t *testing.T) {
+	var usedCtx context.Context
+	var usedClients interface{}
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			usedCtx = operationContext(d)
+			usedClients = m
+			d.SetId("ocid1.test.1")
+			return nil
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return nil
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return nil
+		},
+	}
+	addRegionOverride(testResource)
+	assert.Contains(t, testResource.Schema, globalvar.RegionAttrName)
+
+	// The context-aware operations are wrapped in place, and run with the clients and the context of the operation
+	operations := withOperationContexts("oci_test_context_crud", testResource)
+	assert.Nil(t, operations.CreateWithoutTimeout)
+	assert.NoError(t, operations.InternalValidate(nil, true))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := operations.TestResourceData()
+	assert.False(t, operations.CreateContext(ctx, d, &mockOperationClientsProvider{}).HasError())
+	assert.Equal(t, ctx, usedCtx)
+	assert.Equal(t, ctx, usedClients.(*mockOperationClientsProvider).ctx)
+
+	// and the clients of the region of the resource
+	d = operations.TestResourceData()
+	d.Set(globalvar.RegionAttrName, "us-ashburn-1")
+	assert.False(t, operations.CreateContext(ctx, d, &mockRegionalClientsProvider{}).HasError())
+	assert.Equal(t, "us-ashburn-1", usedClients.(*mockRegionalClientsProvider).region)
+}
+
+func TestUnitRegisterResource_clientError(t *testing.T) {
+	clientError := &ClientError{ClientName: "oci_core.VirtualNetworkClient", Err: fmt.Errorf("can not read private key")}
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+		// The requests of a client that could not be created fail with its error
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return HandleError(nil, fmt.Errorf("%w", clientError))
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return nil
+		},
+	}
+	operations := withOperationContexts("oci_test_client_error", testResource)
+
+	diags := operations.CreateWithoutTimeout(context.Background(), testResource.TestResourceData(), nil)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, "unable to create 'oci_core.VirtualNetworkClient' client: can not read private key", diags[0].Summary)
+	}
+}
+
+func TestUnitWithOperationContexts_logContext(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "oci_tf_structured.log")
+	os.Setenv(globalvar.EnvOCITFLogFormat, globalvar.StructuredLogFormatJson)
+	os.Setenv(globalvar.EnvOCITFLogFile, logPath)
+	defer os.Unsetenv(globalvar.EnvOCITFLogFormat)
+	defer os.Unsetenv(globalvar.EnvOCITFLogFile)
+
+	var usedClients interface{}
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			usedClients = m
+			d.SetId("ocid1.vcn.oc1..vcn")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return nil
+		},
+	}
+	operations := withOperationContexts("oci_core_vcn", testResource)
+
+	// The SDK clients of the operation get its log context with the context of the operation
+	d := operations.TestResourceData()
+	assert.False(t, operations.CreateWithoutTimeout(context.Background(), d, &mockOperationClientsProvider{}).HasError())
+	logContext := utils.LogContextFrom(usedClients.(*mockOperationClientsProvider).ctx)
+	if assert.NotNil(t, logContext) {
+		assert.Equal(t, "oci_core_vcn", logContext.ResourceType)
+		assert.Equal(t, "create", logContext.Phase)
+		assert.Equal(t, "ocid1.vcn.oc1..vcn", logContext.ResourceId())
+	}
+
+	content, err := ioutil.ReadFile(logPath)
+	assert.NoError(t, err)
+	var entry utils.StructuredLogEntry
+	assert.NoError(t, json.Unmarshal(bytes.TrimSpace(content), &entry))
+	assert.Equal(t, "create completed", entry.Message)
+	assert.Equal(t, "oci_core_vcn", entry.ResourceType)
+	assert.Equal(t, "ocid1.vcn.oc1..vcn", entry.ResourceId)
+}
+
+func TestUnitWithOperationContexts_span(t *testing.T) {
+	exporter := &utils.InMemorySpanExporter{}
+	utils.SetSpanExporter(exporter)
+	defer utils.SetSpanExporter(nil)
+
+	var usedClients interface{}
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			usedClients = m
+			d.SetId("ocid1.vcn.oc1..vcn")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return fmt.Errorf("resource not found")
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return nil
+		},
+	}
+	operations := withOperationContexts("oci_core_vcn", testResource)
+
+	// The SDK clients of the operation get its span with the context of the operation
+	d := operations.TestResourceData()
+	assert.False(t, operations.CreateWithoutTimeout(context.Background(), d, &mockOperationClientsProvider{}).HasError())
+	assert.True(t, operations.ReadWithoutTimeout(context.Background(), d, nil).HasError())
+
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 2) {
+		assert.Same(t, spans[0], utils.SpanFromContext(usedClients.(*mockOperationClientsProvider).ctx))
+		assert.Equal(t, "oci_core_vcn create", spans[0].Name)
+		assert.Equal(t, "", spans[0].ParentSpanId)
+		assert.Equal(t, "ocid1.vcn.oc1..vcn", spans[0].Attributes["oci.resource.id"])
+		assert.Equal(t, "oci_core_vcn read", spans[1].Name)
+		assert.Equal(t, "resource not found", spans[1].StatusMessage)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+const (
+	defaultTimeoutsServiceAttrName = "service"
+	defaultTimeoutsCreateAttrName  = "create"
+	defaultTimeoutsUpdateAttrName  = "update"
+	defaultTimeoutsDeleteAttrName  = "delete"
+)
+
+var (
+	// ServiceDefaultTimeouts are the default timeouts of the resources of the services whose operations usually take
+	// longer than DefaultTimeout, keyed by the SDK service the resources are registered with, e.g. `database` for
+	// `oci_database_db_system`. They are used by the resources that do not set timeouts of their own.
+	ServiceDefaultTimeouts = map[string]*schema.ResourceTimeout{
+		"analytics":       {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"bds":             {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"blockchain":      {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"containerengine": {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"database":        {Create: &TwoHours, Update: &TwoHours, Delete: &TwoHours},
+		"goldengate":      {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"integration":     {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"mysql":           {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"oce":             {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"ocvp":            {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"oda":             {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"opensearch":      {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"psql":            {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+		"redis":           {Create: &OneHour, Update: &OneHour, Delete: &OneHour},
+	}
+
+	// The timeouts of the registered resources, before the `default_timeouts` blocks of the provider apply
+	registeredTimeouts = map[string]schema.ResourceTimeout{}
+)
+
+// DefaultTimeoutsOverride overrides the default create, update and delete timeouts of the resources of a service, or
+// of all the resources if Service is ""
+type DefaultTimeoutsOverride struct {
+	Service string
+	Create  *time.Duration
+	Update  *time.Duration
+	Delete  *time.Duration
+}
+
+// DefaultTimeoutsServiceName returns the SDK service name of a service, either named as its SDK service, e.g.
+// `goldengate`, or as in the type of its resources, e.g. `golden_gate` for `oci_golden_gate_deployment`
+func DefaultTimeoutsServiceName(service string) string {
+	return strings.ToLower(strings.Replace(service, "_", "", -1))
+}
+
+// DefaultTimeoutsSchema is the schema of the `default_timeouts` blocks of the provider, whose services are validated by
+// validateService
+func DefaultTimeoutsSchema(description string, validateService schema.SchemaValidateFunc) *schema.Schema {
+	durationSchema := func(operation string) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDuration,
+			Description:  fmt.Sprintf("The default %s timeout of the resources, e.g. `45m` or `2h`.", operation),
+		}
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				defaultTimeoutsServiceAttrName: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateService,
+					Description:  "The service of the resources, e.g. `database` for `oci_database_db_system` but not for `oci_database_management_managed_database`, whose service is `database_management`. All the resources are if not set.",
+				},
+				defaultTimeoutsCreateAttrName: durationSchema("create"),
+				defaultTimeoutsUpdateAttrName: durationSchema("update"),
+				defaultTimeoutsDeleteAttrName: durationSchema("delete"),
+			},
+		},
+	}
+}
+
+func validateDuration(i interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(i.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// DefaultTimeoutsFromData returns the overrides set in the `default_timeouts` blocks
+func DefaultTimeoutsFromData(d schemaResourceData) []DefaultTimeoutsOverride {
+	timeouts, ok := d.GetOkExists(globalvar.DefaultTimeoutsAttrName)
+	if !ok {
+		return nil
+	}
+	timeoutsList, ok := timeouts.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	duration := func(timeoutsMap map[string]interface{}, key string) *time.Duration {
+		value, ok := timeoutsMap[key].(string)
+		if !ok || value == "" {
+			return nil
+		}
+		return GetTimeoutDuration(value)
+	}
+
+	var overrides []DefaultTimeoutsOverride
+	for _, item := range timeoutsList {
+		timeoutsMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		override := DefaultTimeoutsOverride{
+			Create: duration(timeoutsMap, defaultTimeoutsCreateAttrName),
+			Update: duration(timeoutsMap, defaultTimeoutsUpdateAttrName),
+			Delete: duration(timeoutsMap, defaultTimeoutsDeleteAttrName),
+		}
+		service, _ := timeoutsMap[defaultTimeoutsServiceAttrName].(string)
+		override.Service = DefaultTimeoutsServiceName(service)
+		overrides = append(overrides, override)
+	}
+	return overrides
+}
+
+// ApplyDefaultTimeouts sets the default timeouts of the registered resources to their own, overridden by the ones of
+// their service, then of all the resources, in the overrides. The `timeouts` block of a resource still takes
+// precedence over them. Timeouts of operations a resource has no timeout for are left unset, as they are not in its
+// schema.
+//
+// The timeouts are set on the registered resources, which the provider configurations of the process share, so the
+// overrides of the last configured provider apply to all of them, e.g. to the aliased providers of a configuration.
+// Each call starts again from the timeouts of the registered resources, so the overrides of a previous call do not
+// carry over.
+func ApplyDefaultTimeouts(overrides []DefaultTimeoutsOverride) {
+	// The overrides of all the resources apply first, so that the ones of the services take precedence
+	sorted := make([]DefaultTimeoutsOverride, len(overrides))
+	copy(sorted, overrides)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i].Service) < len(sorted[j].Service)
+	})
+
+	for name, timeouts := range registeredTimeouts {
+		resourceSchema, ok := globalvar.OciResources[name]
+		if !ok {
+			continue
+		}
+		timeouts := timeouts
+		for _, override := range sorted {
+			if override.Service != "" && ResourceServiceName(name) != override.Service {
+				continue
+			}
+			if override.Create != nil && timeouts.Create != nil {
+				timeouts.Create = override.Create
+			}
+			if override.Update != nil && timeouts.Update != nil {
+				timeouts.Update = override.Update
+			}
+			if override.Delete != nil && timeouts.Delete != nil {
+				timeouts.Delete = override.Delete
+			}
+		}
+		// The timeouts are set in place, since the provider serves copies of the registered resources sharing them
+		if resourceSchema.Timeouts == nil {
+			resourceSchema.Timeouts = &schema.ResourceTimeout{}
+		}
+		*resourceSchema.Timeouts = timeouts
+	}
+}
+
+// addDefaultTimeouts sets the create, update and delete timeouts the resource lacks to the default ones of its service.
+// The resources using DefaultTimeout get the ones of their service. The operations may be implemented with any of the
+// CRUD function variants, e.g. CreateContext once the resource is wrapped.
+func addDefaultTimeouts(name string, resourceSchema *schema.Resource) {
+	if resourceSchema == nil || !hasCreate(resourceSchema) {
+		return
+	}
+
+	defaults := serviceDefaultTimeouts(name)
+	var timeouts schema.ResourceTimeout
+	switch resourceSchema.Timeouts {
+	case nil:
+	case DefaultTimeout:
+		timeouts = *defaults
+	default:
+		timeouts = *resourceSchema.Timeouts
+	}
+	if timeouts.Create == nil {
+		timeouts.Create = defaults.Create
+	}
+	if timeouts.Update == nil && hasUpdate(resourceSchema) {
+		timeouts.Update = defaults.Update
+	}
+	if timeouts.Delete == nil && hasDelete(resourceSchema) {
+		timeouts.Delete = defaults.Delete
+	}
+
+	registeredTimeouts[name] = timeouts
+	resourceSchema.Timeouts = &timeouts
+}
+
+func hasCreate(resourceSchema *schema.Resource) bool {
+	return resourceSchema.Create != nil || resourceSchema.CreateContext != nil || resourceSchema.CreateWithoutTimeout != nil
+}
+
+func hasUpdate(resourceSchema *schema.Resource) bool {
+	return resourceSchema.Update != nil || resourceSchema.UpdateContext != nil || resourceSchema.UpdateWithoutTimeout != nil
+}
+
+func hasDelete(resourceSchema *schema.Resource) bool {
+	return resourceSchema.Delete != nil || resourceSchema.DeleteContext != nil || resourceSchema.DeleteWithoutTimeout != nil
+}
+
+// serviceDefaultTimeouts returns the default timeouts of the service the resource is registered with, or
+// DefaultTimeout
+func serviceDefaultTimeouts(name string) *schema.ResourceTimeout {
+	if timeouts, ok := ServiceDefaultTimeouts[ResourceServiceName(name)]; ok {
+		return timeouts
+	}
+	return DefaultTimeout
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+func testCrudFunc(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+func testCrudContextFunc(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
+
+// registerTestResourceServices registers the services of the resources of the tests, as RegisterServiceResources does,
+// and returns the function that removes them
+func registerTestResourceServices() func() {
+	services := map[string]string{
+		"oci_core_vcn":                             "core",
+		"oci_database_db_system":                   "database",
+		"oci_database_management_managed_database": "databasemanagement",
+		"oci_golden_gate_deployment":               "goldengate",
+	}
+	for name, service := range services {
+		resourceServices[name] = service
+	}
+	return func() {
+		for name := range services {
+			delete(resourceServices, name)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitAddDefaultTimeouts(t *testing.T) {
+	defer registerTestResourceServices()()
+	fiveMinutes := 5 * time.Minute
+
+	tests := []struct {
+		name         string
+		resourceName string
+		resource     *schema.Resource
+		want         *schema.ResourceTimeout
+	}{
+		{
+			name:         "Test resource without timeouts",
+			resourceName: "oci_core_vcn",
+			resource:     &schema.Resource{Create: testCrudFunc, Update: testCrudFunc, Delete: testCrudFunc},
+			want:         &schema.ResourceTimeout{Create: &TwentyMinutes, Update: &TwentyMinutes, Delete: &TwentyMinutes},
+		},
+		{
+			name:         "Test resource that can not be updated",
+			resourceName: "oci_core_vcn",
+			resource:     &schema.Resource{Create: testCrudFunc, Delete: testCrudFunc},
+			want:         &schema.ResourceTimeout{Create: &TwentyMinutes, Delete: &TwentyMinutes},
+		},
+		{
+			name:         "Test resource with default timeout of its service",
+			resourceName: "oci_database_db_system",
+			resource:     &schema.Resource{Create: testCrudFunc, Delete: testCrudFunc, Timeouts: DefaultTimeout},
+			want:         &schema.ResourceTimeout{Create: &TwoHours, Update: &TwoHours, Delete: &TwoHours},
+		},
+		{
+			name:         "Test resource with timeouts of its own",
+			resourceName: "oci_database_db_system",
+			resource:     &schema.Resource{Create: testCrudFunc, Update: testCrudFunc, Delete: testCrudFunc, Timeouts: &schema.ResourceTimeout{Create: &fiveMinutes}},
+			want:         &schema.ResourceTimeout{Create: &fiveMinutes, Update: &TwoHours, Delete: &TwoHours},
+		},
+		{
+			name:         "Test resource of a service whose name differs from its type",
+			resourceName: "oci_golden_gate_deployment",
+			resource:     &schema.Resource{Create: testCrudFunc, Delete: testCrudFunc},
+			want:         &schema.ResourceTimeout{Create: &OneHour, Delete: &OneHour},
+		},
+		{
+			name:         "Test resource of another service with the type prefix of a service",
+			resourceName: "oci_database_management_managed_database",
+			resource:     &schema.Resource{Create: testCrudFunc, Delete: testCrudFunc, Timeouts: DefaultTimeout},
+			want:         &schema.ResourceTimeout{Create: &TwentyMinutes, Update: &TwentyMinutes, Delete: &TwentyMinutes},
+		},
+		{
+			name:         "Test resource not registered with a service",
+			resourceName: "oci_database_unregistered",
+			resource:     &schema.Resource{Create: testCrudFunc, Delete: testCrudFunc},
+			want:         &schema.ResourceTimeout{Create: &TwentyMinutes, Delete: &TwentyMinutes},
+		},
+		{
+			name:         "Test resource with context CRUD functions",
+			resourceName: "oci_core_vcn",
+			resource:     &schema.Resource{CreateContext: testCrudContextFunc, UpdateWithoutTimeout: testCrudContextFunc, DeleteContext: testCrudContextFunc},
+			want:         &schema.ResourceTimeout{Create: &TwentyMinutes, Update: &TwentyMinutes, Delete: &TwentyMinutes},
+		},
+		{
+			name:         "Test resource with create without timeout",
+			resourceName: "oci_database_db_system",
+			resource:     &schema.Resource{CreateWithoutTimeout: testCrudContextFunc, Delete: testCrudFunc},
+			want:         &schema.ResourceTimeout{Create: &TwoHours, Delete: &TwoHours},
+		},
+		{
+			name:         "Test resource without create",
+			resourceName: "oci_core_vcn",
+			resource:     &schema.Resource{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(timeouts map[string]schema.ResourceTimeout) {
+				registeredTimeouts = timeouts
+			}(registeredTimeouts)
+			registeredTimeouts = map[string]schema.ResourceTimeout{}
+
+			addDefaultTimeouts(tt.resourceName, tt.resource)
+			assert.Equal(t, tt.want, tt.resource.Timeouts)
+		})
+	}
+	assert.Equal(t, &schema.ResourceTimeout{Create: &TwentyMinutes, Update: &TwentyMinutes, Delete: &TwentyMinutes}, DefaultTimeout)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitApplyDefaultTimeouts(t *testing.T) {
+	defer func(timeouts map[string]schema.ResourceTimeout, resources map[string]*schema.Resource) {
+		registeredTimeouts = timeouts
+		globalvar.OciResources = resources
+	}(registeredTimeouts, globalvar.OciResources)
+	registeredTimeouts = map[string]schema.ResourceTimeout{}
+	globalvar.OciResources = map[string]*schema.Resource{}
+	defer registerTestResourceServices()()
+
+	vcn := &schema.Resource{Create: testCrudFunc, Update: testCrudFunc, Delete: testCrudFunc, Timeouts: DefaultTimeout}
+	dbSystem := &schema.Resource{Create: testCrudFunc, Delete: testCrudFunc}
+	managedDatabase := &schema.Resource{Create: testCrudFunc, Delete: testCrudFunc}
+	for name, resource := range map[string]*schema.Resource{"oci_core_vcn": vcn, "oci_database_db_system": dbSystem, "oci_database_management_managed_database": managedDatabase} {
+		addDefaultTimeouts(name, resource)
+		globalvar.OciResources[name] = resource
+	}
+
+	minutes := func(m int) *time.Duration {
+		duration := time.Duration(m) * time.Minute
+		return &duration
+	}
+	ApplyDefaultTimeouts([]DefaultTimeoutsOverride{
+		{Service: "database", Create: minutes(180)},
+		{Create: minutes(30), Update: minutes(40)},
+	})
+	assert.Equal(t, &schema.ResourceTimeout{Create: minutes(30), Update: minutes(40), Delete: &TwentyMinutes}, vcn.Timeouts)
+	assert.Equal(t, &schema.ResourceTimeout{Create: minutes(180), Delete: &TwoHours}, dbSystem.Timeouts)
+	assert.Equal(t, &schema.ResourceTimeout{Create: minutes(30), Delete: &TwentyMinutes}, managedDatabase.Timeouts)
+
+	// The service may be named as in the type of its resources
+	ApplyDefaultTimeouts([]DefaultTimeoutsOverride{{Service: DefaultTimeoutsServiceName("database_management"), Create: minutes(90)}})
+	assert.Equal(t, &schema.ResourceTimeout{Create: minutes(90), Delete: &TwentyMinutes}, managedDatabase.Timeouts)
+	assert.Equal(t, &schema.ResourceTimeout{Create: &TwoHours, Delete: &TwoHours}, dbSystem.Timeouts)
+
+	// The overrides of a previous call do not accumulate
+	ApplyDefaultTimeouts([]DefaultTimeoutsOverride{{Update: minutes(50)}})
+	ApplyDefaultTimeouts([]DefaultTimeoutsOverride{{Update: minutes(50)}})
+	assert.Equal(t, &schema.ResourceTimeout{Create: &TwentyMinutes, Update: minutes(50), Delete: &TwentyMinutes}, vcn.Timeouts)
+	assert.Equal(t, &schema.ResourceTimeout{Create: &TwoHours, Delete: &TwoHours}, dbSystem.Timeouts)
+
+	// The timeouts of the resources are restored without overrides
+	ApplyDefaultTimeouts(nil)
+	assert.Equal(t, &schema.ResourceTimeout{Create: &TwentyMinutes, Update: &TwentyMinutes, Delete: &TwentyMinutes}, vcn.Timeouts)
+	assert.Equal(t, &schema.ResourceTimeout{Create: &TwoHours, Delete: &TwoHours}, dbSystem.Timeouts)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitDefaultTimeoutsFromData(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{globalvar.DefaultTimeoutsAttrName: DefaultTimeoutsSchema("", nil)}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		globalvar.DefaultTimeoutsAttrName: []interface{}{
+			map[string]interface{}{"create": "30m", "delete": "1h"},
+			map[string]interface{}{"service": "database", "update": "3h"},
+			map[string]interface{}{"service": "golden_gate", "delete": "3h"},
+		},
+	})
+
+	thirtyMinutes, threeHours := 30*time.Minute, 3*time.Hour
+	assert.Equal(t, []DefaultTimeoutsOverride{
+		{Create: &thirtyMinutes, Delete: &OneHour},
+		{Service: "database", Update: &threeHours},
+		{Service: "goldengate", Delete: &threeHours},
+	}, DefaultTimeoutsFromData(d))
+
+	_, errs := validateDuration("2 hours", "create")
+	assert.Len(t, errs, 1)
+}
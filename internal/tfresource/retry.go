@@ -4,6 +4,8 @@
 package tfresource
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math/rand"
@@ -77,14 +79,20 @@ func getRetryBackoffDurationWithExpectedRetryDurationFn(response oci_common.OCIO
 	if attempt > quadraticBackoffCap {
 		attempt = quadraticBackoffCap
 	}
-	retryBackoffRange := time.Duration(2*attempt*attempt)*time.Second - minRetryBackoff
+	var backoffDuration time.Duration
+	override := retryOverrideFor(service, response)
+	if override != nil {
+		backoffDuration = override.backoff(attempt)
+	} else {
+		retryBackoffRange := time.Duration(2*attempt*attempt)*time.Second - minRetryBackoff
 
-	// Jitter the backoff time. The actual backoff time might be anywhere within the minimum and quadratic backoff time to avoid clustering.
-	backoffDuration := time.Duration(rand.Int63n(int64(retryBackoffRange+1))) + minRetryBackoff
+		// Jitter the backoff time. The actual backoff time might be anywhere within the minimum and quadratic backoff time to avoid clustering.
+		backoffDuration = time.Duration(rand.Int63n(int64(retryBackoffRange+1))) + minRetryBackoff
+	}
 
 	// If we are about to exceed the retry duration; then reduce the backoff so that next attempt happens roughly when
 	// the entire retry duration is supposed to expire. Jitter is necessary again to avoid clustering.
-	expectedRetryDuration := expectedRetryDurationFn(response, disableNotFoundRetries, service, optionals...)
+	expectedRetryDuration := override.expectedRetryDuration(response, expectedRetryDurationFn(response, disableNotFoundRetries, service, optionals...))
 	timeWaited := GetElapsedRetryDuration(startTime)
 	if timeWaited < expectedRetryDuration && timeWaited+backoffDuration > expectedRetryDuration {
 		extraJitterRange := int64(float64(expectedRetryDuration) * 0.05)
@@ -94,9 +102,31 @@ func getRetryBackoffDurationWithExpectedRetryDurationFn(response oci_common.OCIO
 		}
 	}
 	utils.Logf("Time elapsed for retry: %v;  Expected retry duration: %v \n", timeWaited.Round(time.Second), expectedRetryDuration.Round(time.Second))
+	recordRetryAttemptSpan(response, service, backoffDuration)
 	return backoffDuration
 }
 
+// recordRetryAttemptSpan records the backoff before the next attempt of an SDK call as a span, which lasts as long as the
+// backoff. It is a child of the span carried by the context of the request that is retried.
+func recordRetryAttemptSpan(response oci_common.OCIOperationResponse, service string, backoffDuration time.Duration) {
+	ctx := context.Background()
+	attributes := map[string]interface{}{
+		"oci.service":       service,
+		"oci.retry.attempt": int(response.AttemptNumber),
+		"oci.retry.backoff": backoffDuration.String(),
+	}
+	if response.Response != nil && response.Response.HTTPResponse() != nil {
+		attributes["http.status_code"] = response.Response.HTTPResponse().StatusCode
+		if request := response.Response.HTTPResponse().Request; request != nil {
+			ctx = request.Context()
+		}
+	}
+	if response.Error != nil {
+		attributes["error"] = response.Error.Error()
+	}
+	utils.RecordSpan(ctx, "RetryAttempt", utils.SpanKindInternal, time.Now().Add(backoffDuration), attributes)
+}
+
 func GetElapsedRetryDuration(firstAttemptTime time.Time) time.Duration {
 	return time.Now().Sub(firstAttemptTime)
 }
@@ -337,7 +367,13 @@ func getLogAnalyticsExpectedRetryDuration(response oci_common.OCIOperationRespon
 
 func ShouldRetry(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, startTime time.Time, optionals ...interface {
 }) bool {
-	return GetElapsedRetryDuration(startTime) < getExpectedRetryDuration(response, disableNotFoundRetries, service, optionals...)
+	// Stop retrying once the operation sending the request is interrupted
+	if errors.Is(response.Error, context.Canceled) || errors.Is(response.Error, context.DeadlineExceeded) {
+		return false
+	}
+	expectedRetryDuration := getExpectedRetryDuration(response, disableNotFoundRetries, service, optionals...)
+	expectedRetryDuration = retryOverrideFor(service, response).expectedRetryDuration(response, expectedRetryDuration)
+	return GetElapsedRetryDuration(startTime) < expectedRetryDuration
 }
 
 // Because this function notes the start time for making should retry decisions, it's advised
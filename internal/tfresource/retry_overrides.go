@@ -0,0 +1,273 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+const (
+	retryServiceAttrName            = "service"
+	retryOperationAttrName          = "operation"
+	retryMaxDurationSecondsAttrName = "max_duration_seconds"
+	retryBaseBackoffSecondsAttrName = "base_backoff_seconds"
+	retryJitterAttrName             = "jitter"
+	retryRetriableStatusesAttrName  = "retriable_statuses"
+	retryRetriableCodesAttrName     = "retriable_codes"
+
+	defaultRetryBaseBackoff = 2 * time.Second
+	defaultRetryJitter      = 1.0
+)
+
+// retryServiceAliases are the services whose name in GetRetryPolicy, once its underscores are removed, is not the name
+// of their SDK service
+var retryServiceAliases = map[string]string{
+	"catalog":                  "datacatalog",
+	"containerinstance":        "containerinstances",
+	"datasafeprivateendpoints": "datasafe",
+	"disworkspace":             "dataintegration",
+	"domain":                   "identity",
+	"model":                    "ailanguage",
+	"workrequest":              globalvar.WorkRequest,
+}
+
+// RetryOverridesFromConfig are the overrides set in the `retry` blocks of the provider
+var RetryOverridesFromConfig []RetryOverride
+
+// RetryOverride overrides the automatic retries of the requests of a service, e.g. `identity`, or of one of its
+// operations, e.g. `CreateCompartment`
+type RetryOverride struct {
+	Service string
+	// The operation the override applies to, or "" for all the operations of the service
+	Operation string
+	// The duration to retry for, or nil to keep the one of the service
+	MaxDuration *time.Duration
+	// The backoff is BaseBackoff times the square of the attempt number
+	BaseBackoff time.Duration
+	// The fraction of the backoff that is randomized, from 0 to 1
+	Jitter float64
+	// The statuses and error codes of the service errors to retry instead of the ones the service retries, or none to
+	// keep retrying the latter
+	RetriableStatuses []int
+	RetriableCodes    []string
+}
+
+// RetryServiceName returns the SDK service name of a service, either named as in GetRetryPolicy, e.g. `object_storage`,
+// or as its SDK service, e.g. `objectstorage`
+func RetryServiceName(service string) string {
+	name := strings.ToLower(strings.Replace(service, "_", "", -1))
+	if alias, ok := retryServiceAliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+// RetrySchema is the schema of the `retry` blocks of the provider, whose services are validated by validateService
+func RetrySchema(description string, validateService schema.SchemaValidateFunc) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				retryServiceAttrName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateService,
+					Description:  "The service whose requests are retried, e.g. `identity` or `object_storage`.",
+				},
+				retryOperationAttrName: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The operation whose requests are retried, e.g. `CreateCompartment`. All the operations of the service are if not set.",
+				},
+				retryMaxDurationSecondsAttrName: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of seconds to retry the requests for. The duration of the service is kept if not set.",
+				},
+				retryBaseBackoffSecondsAttrName: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      int(defaultRetryBaseBackoff / time.Second),
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of seconds the backoff between two attempts is multiplied by, along with the square of the attempt number.",
+				},
+				retryJitterAttrName: {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Default:      defaultRetryJitter,
+					ValidateFunc: validation.FloatBetween(0, 1),
+					Description:  "The fraction of the backoff that is randomized, from 0 to 1.",
+				},
+				retryRetriableStatusesAttrName: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeInt},
+					Description: "The HTTP statuses of the service errors to retry, e.g. `404`. They are retried instead of the errors the service retries, e.g. the throttling and internal errors, unless they are listed too.",
+				},
+				retryRetriableCodesAttrName: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The codes of the service errors to retry, e.g. `NotAuthorizedOrNotFound`. They are retried instead of the errors the service retries, e.g. the throttling and internal errors, unless they are listed too.",
+				},
+			},
+		},
+	}
+}
+
+// RetryOverridesFromData returns the overrides set in the `retry` blocks
+func RetryOverridesFromData(d schemaResourceData) []RetryOverride {
+	retries, ok := d.GetOkExists(globalvar.RetryAttrName)
+	if !ok {
+		return nil
+	}
+	retriesList, ok := retries.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var overrides []RetryOverride
+	for _, item := range retriesList {
+		retryMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		override := RetryOverride{BaseBackoff: defaultRetryBaseBackoff, Jitter: defaultRetryJitter}
+		service, _ := retryMap[retryServiceAttrName].(string)
+		override.Service = RetryServiceName(service)
+		override.Operation, _ = retryMap[retryOperationAttrName].(string)
+		if maxDurationSeconds, ok := retryMap[retryMaxDurationSecondsAttrName].(int); ok && maxDurationSeconds > 0 {
+			maxDuration := time.Duration(maxDurationSeconds) * time.Second
+			override.MaxDuration = &maxDuration
+		}
+		if baseBackoffSeconds, ok := retryMap[retryBaseBackoffSecondsAttrName].(int); ok && baseBackoffSeconds > 0 {
+			override.BaseBackoff = time.Duration(baseBackoffSeconds) * time.Second
+		}
+		if jitter, ok := retryMap[retryJitterAttrName].(float64); ok {
+			override.Jitter = jitter
+		}
+		if statuses, ok := retryMap[retryRetriableStatusesAttrName].([]interface{}); ok {
+			for _, status := range statuses {
+				if status, ok := status.(int); ok {
+					override.RetriableStatuses = append(override.RetriableStatuses, status)
+				}
+			}
+		}
+		if codes, ok := retryMap[retryRetriableCodesAttrName].([]interface{}); ok {
+			for _, code := range codes {
+				if code, ok := code.(string); ok && code != "" {
+					override.RetriableCodes = append(override.RetriableCodes, code)
+				}
+			}
+		}
+		overrides = append(overrides, override)
+	}
+	return overrides
+}
+
+// retryOverrideFor returns the override of the operation of the response if there is one, else the one of the service,
+// or nil
+func retryOverrideFor(service string, response oci_common.OCIOperationResponse) *RetryOverride {
+	var match *RetryOverride
+	var operationName *string
+	service = RetryServiceName(service)
+	for i := range RetryOverridesFromConfig {
+		override := &RetryOverridesFromConfig[i]
+		if RetryServiceName(override.Service) != service {
+			continue
+		}
+		if override.Operation == "" {
+			if match == nil {
+				match = override
+			}
+			continue
+		}
+		if operationName == nil {
+			name := retryOperationName(response)
+			operationName = &name
+		}
+		if strings.EqualFold(override.Operation, *operationName) {
+			return override
+		}
+	}
+	return match
+}
+
+// retryOperationName returns the name of the operation of the response, e.g. `CreateCompartment`
+func retryOperationName(response oci_common.OCIOperationResponse) string {
+	if failure, ok := response.Error.(interface{ GetOperationName() string }); ok && failure.GetOperationName() != "" {
+		return failure.GetOperationName()
+	}
+	if response.Response == nil {
+		return ""
+	}
+	responseType := reflect.TypeOf(response.Response)
+	if responseType.Kind() == reflect.Ptr {
+		responseType = responseType.Elem()
+	}
+	return strings.TrimSuffix(responseType.Name(), "Response")
+}
+
+// expectedRetryDuration returns the duration to retry the request of the response for, given the one of its service.
+// The retriable statuses and codes, when set, replace the service errors the service retries, which are no longer
+// retried.
+func (o *RetryOverride) expectedRetryDuration(response oci_common.OCIOperationResponse, serviceRetryDuration time.Duration) time.Duration {
+	if o == nil || response.Error == nil {
+		return serviceRetryDuration
+	}
+	if failure, ok := isServiceErrorVar(response.Error); ok && (len(o.RetriableStatuses) > 0 || len(o.RetriableCodes) > 0) {
+		if !o.isRetriable(failure) {
+			return 0
+		}
+		if o.MaxDuration != nil {
+			return *o.MaxDuration
+		}
+		if serviceRetryDuration == 0 {
+			return ShortRetryTime
+		}
+		return serviceRetryDuration
+	}
+	if o.MaxDuration != nil && serviceRetryDuration > 0 {
+		return *o.MaxDuration
+	}
+	return serviceRetryDuration
+}
+
+func (o *RetryOverride) isRetriable(failure oci_common.ServiceError) bool {
+	for _, status := range o.RetriableStatuses {
+		if status == failure.GetHTTPStatusCode() {
+			return true
+		}
+	}
+	for _, code := range o.RetriableCodes {
+		if strings.EqualFold(code, failure.GetCode()) {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the backoff before the next attempt, jittered so that the attempts of concurrent requests do not
+// cluster
+func (o *RetryOverride) backoff(attempt uint) time.Duration {
+	maxBackoff := o.BaseBackoff * time.Duration(attempt*attempt)
+	jitterRange := int64(float64(maxBackoff) * o.Jitter)
+	backoff := maxBackoff - time.Duration(jitterRange) + time.Duration(rand.Int63n(jitterRange+1))
+	if backoff < minRetryBackoff {
+		return minRetryBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+type GetCompartmentResponse struct {
+	TestOCIResponse
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitShouldRetry_retryOverrides(t *testing.T) {
+	defer func(shortRetryTime time.Duration, overrides []RetryOverride) {
+		ShortRetryTime = shortRetryTime
+		RetryOverridesFromConfig = overrides
+	}(ShortRetryTime, RetryOverridesFromConfig)
+	ShortRetryTime = 2 * time.Minute
+	ConfiguredRetryDuration = nil
+
+	minutes := func(m int) *time.Duration {
+		duration := time.Duration(m) * time.Minute
+		return &duration
+	}
+	notFound := &MockServiceFailure{StatusCode: 404, Code: "NotAuthorizedOrNotFound", OperationName: "CreatePolicy"}
+	conflict := &MockServiceFailure{StatusCode: 409, Code: "Conflict", OperationName: "CreateCompartment"}
+
+	tests := []struct {
+		name      string
+		overrides []RetryOverride
+		service   string
+		response  common.OCIOperationResponse
+		elapsed   time.Duration
+		want      bool
+	}{
+		{
+			name:     "Test error not retried by the service",
+			service:  "identity",
+			response: common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 404}, Error: notFound},
+			want:     false,
+		},
+		{
+			name:      "Test retriable code",
+			overrides: []RetryOverride{{Service: "identity", RetriableCodes: []string{"NotAuthorizedOrNotFound"}, MaxDuration: minutes(5)}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 404}, Error: notFound},
+			elapsed:   4 * time.Minute,
+			want:      true,
+		},
+		{
+			name:      "Test retriable code after the max duration",
+			overrides: []RetryOverride{{Service: "identity", RetriableCodes: []string{"NotAuthorizedOrNotFound"}, MaxDuration: minutes(5)}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 404}, Error: notFound},
+			elapsed:   6 * time.Minute,
+			want:      false,
+		},
+		{
+			name:      "Test retriable status without max duration",
+			overrides: []RetryOverride{{Service: "identity", RetriableStatuses: []int{404}}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 404}, Error: notFound},
+			elapsed:   time.Minute,
+			want:      true,
+		},
+		{
+			name:      "Test error neither in the retriable statuses nor retried by the service",
+			overrides: []RetryOverride{{Service: "identity", RetriableStatuses: []int{409}}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 404}, Error: notFound},
+			want:      false,
+		},
+		{
+			name:      "Test throttling not retried when not in the retriable statuses",
+			overrides: []RetryOverride{{Service: "identity", RetriableStatuses: []int{404}}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 429}, Error: &MockServiceFailure{StatusCode: 429, Code: "TooManyRequests"}},
+			elapsed:   time.Minute,
+			want:      false,
+		},
+		{
+			name:      "Test throttling retried when in the retriable statuses",
+			overrides: []RetryOverride{{Service: "identity", RetriableStatuses: []int{404, 429}}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 429}, Error: &MockServiceFailure{StatusCode: 429, Code: "TooManyRequests"}},
+			elapsed:   time.Minute,
+			want:      true,
+		},
+		{
+			name:      "Test internal error not retried when not in the retriable codes",
+			overrides: []RetryOverride{{Service: "identity", RetriableCodes: []string{"NotAuthorizedOrNotFound"}}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 500}, Error: &MockServiceFailure{StatusCode: 500, Code: "InternalServerError"}},
+			elapsed:   time.Minute,
+			want:      false,
+		},
+		{
+			name:      "Test internal error retried by the service without retriable statuses and codes",
+			overrides: []RetryOverride{{Service: "identity", MaxDuration: minutes(5)}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 500}, Error: &MockServiceFailure{StatusCode: 500, Code: "InternalServerError"}},
+			elapsed:   4 * time.Minute,
+			want:      true,
+		},
+		{
+			name:      "Test override of the GetRetryPolicy name of the service",
+			overrides: []RetryOverride{{Service: "object_storage", RetriableStatuses: []int{404}}},
+			service:   "object_storage",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 404}, Error: notFound},
+			elapsed:   time.Minute,
+			want:      true,
+		},
+		{
+			name:      "Test override of the SDK name of the service",
+			overrides: []RetryOverride{{Service: "objectstorage", RetriableStatuses: []int{404}}},
+			service:   "object_storage",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 404}, Error: notFound},
+			elapsed:   time.Minute,
+			want:      true,
+		},
+		{
+			name:      "Test override of another service",
+			overrides: []RetryOverride{{Service: "identity", RetriableStatuses: []int{404}}},
+			service:   "core",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 404}, Error: notFound},
+			want:      false,
+		},
+		{
+			name:      "Test max duration of the service",
+			overrides: []RetryOverride{{Service: "identity", MaxDuration: minutes(10)}, {Service: "identity", Operation: "CreateCompartment", MaxDuration: minutes(1)}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 409}, Error: &MockServiceFailure{StatusCode: 409, Code: "Conflict", OperationName: "CreatePolicy"}},
+			elapsed:   5 * time.Minute,
+			want:      true,
+		},
+		{
+			name:      "Test max duration of the operation",
+			overrides: []RetryOverride{{Service: "identity", MaxDuration: minutes(10)}, {Service: "identity", Operation: "CreateCompartment", MaxDuration: minutes(1)}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 409}, Error: conflict},
+			elapsed:   5 * time.Minute,
+			want:      false,
+		},
+		{
+			name:      "Test operation of the response type",
+			overrides: []RetryOverride{{Service: "identity", Operation: "GetCompartment", RetriableStatuses: []int{404}}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: GetCompartmentResponse{TestOCIResponse{statusCode: 404}}, Error: &MockServiceFailure{StatusCode: 404, Code: "NotAuthorizedOrNotFound"}},
+			elapsed:   time.Minute,
+			want:      true,
+		},
+		{
+			name:      "Test successful response",
+			overrides: []RetryOverride{{Service: "identity", RetriableStatuses: []int{200}, MaxDuration: minutes(5)}},
+			service:   "identity",
+			response:  common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 200}},
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RetryOverridesFromConfig = tt.overrides
+			assert.Equal(t, tt.want, ShouldRetry(tt.response, false, tt.service, time.Now().Add(-tt.elapsed)))
+		})
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetRetryBackoffDuration_retryOverrides(t *testing.T) {
+	defer func(overrides []RetryOverride) {
+		RetryOverridesFromConfig = overrides
+	}(RetryOverridesFromConfig)
+	expectedRetryDuration := func(response common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
+		return time.Hour
+	}
+	response := common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 429}, Error: &MockServiceFailure{StatusCode: 429, Code: "TooManyRequests"}, AttemptNumber: 2}
+
+	RetryOverridesFromConfig = []RetryOverride{{Service: "identity", BaseBackoff: 3 * time.Second}}
+	assert.Equal(t, 12*time.Second, getRetryBackoffDurationWithExpectedRetryDurationFn(response, false, "identity", time.Now(), expectedRetryDuration))
+
+	RetryOverridesFromConfig = []RetryOverride{{Service: "identity", BaseBackoff: 3 * time.Second, Jitter: 0.5}}
+	for i := 0; i < 10; i++ {
+		backoff := getRetryBackoffDurationWithExpectedRetryDurationFn(response, false, "identity", time.Now(), expectedRetryDuration)
+		assert.True(t, backoff >= 6*time.Second && backoff <= 12*time.Second, "unexpected backoff %v", backoff)
+	}
+
+	// The backoff is reduced so that the last attempt happens when the max duration expires
+	maxDuration := 5 * time.Second
+	RetryOverridesFromConfig = []RetryOverride{{Service: "identity", BaseBackoff: 3 * time.Second, MaxDuration: &maxDuration}}
+	backoff := getRetryBackoffDurationWithExpectedRetryDurationFn(response, false, "identity", time.Now(), expectedRetryDuration)
+	assert.True(t, backoff < 12*time.Second, "unexpected backoff %v", backoff)
+}
+
+func TestUnitRetryOverridesFromData(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{globalvar.RetryAttrName: RetrySchema("", nil)}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		globalvar.RetryAttrName: []interface{}{
+			map[string]interface{}{
+				"service":              "identity",
+				"max_duration_seconds": 600,
+				"retriable_statuses":   []interface{}{404, 409},
+				"retriable_codes":      []interface{}{"NotAuthorizedOrNotFound"},
+			},
+			map[string]interface{}{
+				"service":              "object_storage",
+				"operation":            "PutObject",
+				"base_backoff_seconds": 5,
+				"jitter":               0.25,
+			},
+		},
+	})
+
+	maxDuration := 10 * time.Minute
+	assert.Equal(t, []RetryOverride{
+		{Service: "identity", MaxDuration: &maxDuration, BaseBackoff: 2 * time.Second, Jitter: 1, RetriableStatuses: []int{404, 409}, RetriableCodes: []string{"NotAuthorizedOrNotFound"}},
+		{Service: "objectstorage", Operation: "PutObject", BaseBackoff: 5 * time.Second, Jitter: 0.25},
+	}, RetryOverridesFromData(d))
+}
+
+func TestUnitRetryServiceName(t *testing.T) {
+	assert.Equal(t, "identity", RetryServiceName("identity"))
+	assert.Equal(t, "objectstorage", RetryServiceName("object_storage"))
+	assert.Equal(t, "objectstorage", RetryServiceName("objectstorage"))
+	assert.Equal(t, "datacatalog", RetryServiceName("catalog"))
+	assert.Equal(t, globalvar.WorkRequest, RetryServiceName("work_request"))
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/sony/gobreaker"
+)
+
+var (
+	// The error the SDK returns for the requests its open circuit breaker did not send, e.g.
+	// `circuit breaker is open, so this request was not sent to the VirtualNetwork service.`
+	circuitBreakerErrorRegex = regexp.MustCompile(`^(?:circuit breaker is open|too many requests), so this request was not sent to the (\S*) service`)
+
+	serviceHealthVar = newServiceHealth()
+)
+
+// serviceHealthStats is the health of the requests made by the clients of a service during the run
+type serviceHealthStats struct {
+	Requests     int
+	Errors       int
+	BreakerTrips int
+	FailedFast   int
+
+	breakerState gobreaker.State
+	// Whether an operation failed fast with the diagnostic explaining the open circuit breaker since it opened
+	failedFastReported bool
+}
+
+// serviceHealth tracks the health of the clients of each service across the run, e.g. of the `core` service, so that
+// the operations on the resources of a service whose circuit breaker is open fail fast, and logs the state changes of
+// their circuit breakers as they are observed
+type serviceHealth struct {
+	lock     sync.Mutex
+	services map[string]*serviceHealthStats
+	// The circuit breakers of the clients of each service
+	breakers map[string][]*oci_common.OciCircuitBreaker
+}
+
+func newServiceHealth() *serviceHealth {
+	return &serviceHealth{services: map[string]*serviceHealthStats{}, breakers: map[string][]*oci_common.OciCircuitBreaker{}}
+}
+
+func (h *serviceHealth) stats(service string) *serviceHealthStats {
+	stats, ok := h.services[service]
+	if !ok {
+		stats = &serviceHealthStats{breakerState: gobreaker.StateClosed}
+		h.services[service] = stats
+	}
+	return stats
+}
+
+// WatchServiceHealth tracks the health of the requests of a client of a service, e.g. of the `core` service, and the
+// state of its circuit breaker
+func WatchServiceHealth(service string, client *oci_common.BaseClient) {
+	serviceHealthVar.watch(service, client.Configuration.CircuitBreaker)
+	client.HTTPClient = &serviceHealthDispatcher{dispatcher: client.HTTPClient, service: service}
+}
+
+func (h *serviceHealth) watch(service string, breaker *oci_common.OciCircuitBreaker) {
+	if breaker == nil || breaker.Cb == nil {
+		return
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for _, watched := range h.breakers[service] {
+		if watched == breaker {
+			return
+		}
+	}
+	h.breakers[service] = append(h.breakers[service], breaker)
+}
+
+// serviceHealthDispatcher is an HTTPRequestDispatcher that counts the requests of a client of a service, and its
+// errors that count towards tripping the circuit breaker. The SDK only calls it for the requests its circuit breaker
+// lets through.
+type serviceHealthDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	service    string
+}
+
+func (d *serviceHealthDispatcher) Do(r *http.Request) (*http.Response, error) {
+	response, err := d.dispatcher.Do(r)
+	failed := err != nil || (response != nil && (response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError))
+	serviceHealthVar.recordRequest(d.service, failed)
+	return response, err
+}
+
+func (h *serviceHealth) recordRequest(service string, failed bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	stats := h.stats(service)
+	stats.Requests++
+	if failed {
+		stats.Errors++
+	}
+	h.observeBreakerState(service, stats, h.breakerState(service))
+}
+
+// breakerState returns the state of the circuit breakers of the clients of the service, which is open if one of them
+// is open
+func (h *serviceHealth) breakerState(service string) gobreaker.State {
+	state := gobreaker.StateClosed
+	for _, breaker := range h.breakers[service] {
+		switch breaker.Cb.State() {
+		case gobreaker.StateOpen:
+			return gobreaker.StateOpen
+		case gobreaker.StateHalfOpen:
+			state = gobreaker.StateHalfOpen
+		}
+	}
+	return state
+}
+
+type resourceServiceContextKey struct{}
+
+// withServiceHealth fails the CRUD operation fast if the circuit breaker of the service of the resource is open, and
+// runs it with the service of the resource in its context otherwise
+func withServiceHealth(name string, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		service := ResourceServiceName(name)
+		if err := checkServiceHealth(service); err != nil {
+			return DiagnosticsFromError(err)
+		}
+		if service != "" {
+			ctx = context.WithValue(ctx, resourceServiceContextKey{}, service)
+		}
+		return fn(ctx, d, m)
+	}
+}
+
+// resourceServiceFromContext returns the service of the resource of the operation of the context, or ""
+func resourceServiceFromContext(ctx context.Context) string {
+	service, _ := ctx.Value(resourceServiceContextKey{}).(string)
+	return service
+}
+
+// checkServiceHealth returns the error of an operation on a resource of the service if the circuit breaker of one of
+// its clients is open, so that the operation fails fast instead of each of its requests failing on its own
+func checkServiceHealth(service string) error {
+	return serviceHealthVar.check(service)
+}
+
+func (h *serviceHealth) check(service string) error {
+	if service == "" {
+		return nil
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	breakerState := h.breakerState(service)
+	if breakerState != gobreaker.StateOpen {
+		if stats, ok := h.services[service]; ok {
+			h.observeBreakerState(service, stats, breakerState)
+		}
+		return nil
+	}
+	return h.failFast(service)
+}
+
+// recordFailedFast returns the error of an operation whose request the open circuit breaker of the service did not send
+func (h *serviceHealth) recordFailedFast(service string) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.failFast(service)
+}
+
+// failFast counts the operation on a resource of the service that failed fast, and returns its error. Only the first
+// operation to fail fast after the circuit breaker opened gets the diagnostic explaining it, the following ones refer to
+// it. It must be called with the lock held.
+func (h *serviceHealth) failFast(service string) error {
+	stats := h.stats(service)
+	h.observeBreakerState(service, stats, gobreaker.StateOpen)
+	stats.FailedFast++
+	explained := stats.failedFastReported
+	stats.failedFastReported = true
+	return circuitBreakerOpenError(service, explained)
+}
+
+// observeBreakerState logs the change of state of the circuit breakers of the service, if it changed
+func (h *serviceHealth) observeBreakerState(service string, stats *serviceHealthStats, breakerState gobreaker.State) {
+	if breakerState == stats.breakerState {
+		return
+	}
+	level := "INFO"
+	if breakerState == gobreaker.StateOpen {
+		level = "WARN"
+		stats.BreakerTrips++
+		stats.failedFastReported = false
+	}
+	stats.breakerState = breakerState
+	log.Printf("[%s] the circuit breaker of the %s service is %s: %s", level, service, breakerState, stats)
+}
+
+func (stats *serviceHealthStats) String() string {
+	return fmt.Sprintf("%d request(s), %d error(s), %d circuit breaker trip(s), %d operation(s) failed fast",
+		stats.Requests, stats.Errors, stats.BreakerTrips, stats.FailedFast)
+}
+
+// circuitBreakerOpenService returns the service whose circuit breaker did not send the request of the error, or ""
+func circuitBreakerOpenService(err error) string {
+	if match := circuitBreakerErrorRegex.FindStringSubmatch(err.Error()); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// LogServiceHealthSummary logs the requests, errors and circuit breaker trips of each service used during the run
+func LogServiceHealthSummary() {
+	for _, line := range serviceHealthVar.summary() {
+		log.Print(line)
+	}
+}
+
+func (h *serviceHealth) summary() []string {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if len(h.services) == 0 {
+		return nil
+	}
+	services := make([]string, 0, len(h.services))
+	for service := range h.services {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	lines := []string{"[INFO] health of the services:"}
+	for _, service := range services {
+		stats := h.services[service]
+		level := "INFO"
+		if stats.BreakerTrips > 0 {
+			level = "WARN"
+		}
+		lines = append(lines, fmt.Sprintf("[%s]   %s: %s", level, service, stats))
+	}
+	return lines
+}
+
+// circuitBreakerOpenError is the error of the operations not attempted because the circuit breaker of their service is
+// open. The operations that fail fast after the one whose error explains it refer to that error.
+func circuitBreakerOpenError(service string, explained bool) error {
+	summary := fmt.Sprintf("The circuit breaker of the %s service is open, the operation was not attempted", service)
+	detail := fmt.Sprintf("The requests to the %s service failed too many times recently, so the operations on its resources fail "+
+		"until the circuit breaker closes, instead of each timing out.\n"+
+		"Suggestion: Check the errors of the first failed operations and the status of the service in the region, then apply again. "+
+		"The circuit breaker can be disabled by setting the OCI_SDK_DEFAULT_CIRCUITBREAKER_ENABLED environment variable to false.", service)
+	if explained {
+		detail = fmt.Sprintf("See the first error about the circuit breaker of the %s service.", service)
+	}
+	return &DiagnosticsError{
+		err:         fmt.Errorf("%s\n%s", summary, detail),
+		Diagnostics: diag.Diagnostics{{Severity: diag.Error, Summary: summary, Detail: detail}},
+	}
+}
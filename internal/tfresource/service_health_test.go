@@ -0,0 +1,143 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/assert"
+)
+
+// statusDispatcher responds to the requests with a status
+type statusDispatcher struct {
+	statusCode int
+}
+
+func (d *statusDispatcher) Do(r *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: d.statusCode, Header: http.Header{}}, nil
+}
+
+// circuitBreakerResourceCrud is read with a client whose circuit breaker is open
+type circuitBreakerResourceCrud struct{}
+
+func (s *circuitBreakerResourceCrud) Get() error {
+	return errors.New("circuit breaker is open, so this request was not sent to the VirtualNetwork service.\n\n The circuit breaker was opened because the VirtualNetwork service failed too many times recently.")
+}
+
+func (s *circuitBreakerResourceCrud) SetData() error {
+	return nil
+}
+
+func (s *circuitBreakerResourceCrud) VoidState() {}
+
+func TestUnitWatchServiceHealth(t *testing.T) {
+	defer func(health *serviceHealth) {
+		serviceHealthVar = health
+	}(serviceHealthVar)
+	serviceHealthVar = newServiceHealth()
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+	resourceServices["oci_test_circuit_breaker"] = "core"
+	defer delete(resourceServices, "oci_test_circuit_breaker")
+
+	breaker := oci_common.NewCircuitBreaker(oci_common.NewCircuitBreakerSettingWithOptions(
+		oci_common.WithMinimumRequests(2), oci_common.WithFailureRateThreshold(0.6),
+		oci_common.WithSuccessStatCodeMap(map[int]bool{http.StatusServiceUnavailable: false}), oci_common.WithServiceName("VirtualNetwork")))
+	dispatcher := &statusDispatcher{statusCode: http.StatusOK}
+	client := &oci_common.BaseClient{HTTPClient: dispatcher}
+	client.Configuration.CircuitBreaker = breaker
+	WatchServiceHealth("core", client)
+
+	// The SDK sends the requests through the circuit breaker, which trips after the failed ones
+	send := func() {
+		_, _ = breaker.Cb.Execute(func() (interface{}, error) {
+			response, err := client.HTTPClient.Do(&http.Request{})
+			if err == nil && response.StatusCode >= http.StatusInternalServerError {
+				return nil, &MockServiceFailure{StatusCode: response.StatusCode, Code: "ServiceUnavailable"}
+			}
+			return response, err
+		})
+	}
+	send()
+	dispatcher.statusCode = http.StatusServiceUnavailable
+	send()
+	send()
+	assert.Equal(t, gobreaker.StateOpen, breaker.Cb.State())
+	assert.NotContains(t, logs.String(), "circuit breaker of the core service")
+
+	// The operations on the resources of the service fail fast while the circuit breaker is open, only the first one
+	// with the diagnostic explaining it
+	attempted := false
+	read := withServiceHealth("oci_test_circuit_breaker", func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		attempted = true
+		return nil
+	})
+	for i := 0; i < 2; i++ {
+		diags := read(context.Background(), nil, nil)
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, "The circuit breaker of the core service is open, the operation was not attempted", diags[0].Summary)
+			if i == 0 {
+				assert.Contains(t, diags[0].Detail, "Suggestion:")
+			} else {
+				assert.Equal(t, "See the first error about the circuit breaker of the core service.", diags[0].Detail)
+			}
+		}
+	}
+	assert.False(t, attempted)
+
+	// The requests the open circuit breaker did not send fail the same way
+	err := ReadResource(&circuitBreakerResourceCrud{})
+	if assert.Error(t, err) {
+		diags := DiagnosticsFromError(err)
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, "The circuit breaker of the VirtualNetwork service is open, the operation was not attempted", diags[0].Summary)
+		}
+	}
+
+	// The state change is logged once, when it is observed
+	assert.Equal(t, 1, bytes.Count(logs.Bytes(), []byte("[WARN] the circuit breaker of the core service is open: "+
+		"3 request(s), 2 error(s), 1 circuit breaker trip(s), 0 operation(s) failed fast")))
+	assert.Equal(t, []string{
+		"[INFO] health of the services:",
+		"[WARN]   VirtualNetwork: 0 request(s), 0 error(s), 1 circuit breaker trip(s), 1 operation(s) failed fast",
+		"[WARN]   core: 3 request(s), 2 error(s), 1 circuit breaker trip(s), 2 operation(s) failed fast",
+	}, serviceHealthVar.summary())
+}
+
+func TestUnitServiceHealth_breakerStates(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	health := newServiceHealth()
+	health.recordRequest("core", true)
+	assert.Contains(t, health.recordFailedFast("core").Error(), "Suggestion:")
+	health.observeBreakerState("core", health.stats("core"), gobreaker.StateHalfOpen)
+	health.recordRequest("core", false)
+	health.recordRequest("identity", false)
+
+	assert.Contains(t, logs.String(), "[WARN] the circuit breaker of the core service is open")
+	assert.Contains(t, logs.String(), "[INFO] the circuit breaker of the core service is half-open")
+	assert.Contains(t, logs.String(), "[INFO] the circuit breaker of the core service is closed")
+	assert.NotContains(t, logs.String(), "identity")
+
+	// The first operation to fail fast after the circuit breaker opens again gets the diagnostic explaining it
+	assert.Contains(t, health.recordFailedFast("core").Error(), "Suggestion:")
+	assert.NotContains(t, health.recordFailedFast("core").Error(), "Suggestion:")
+	assert.Equal(t, map[string]*serviceHealthStats{
+		"core":     {Requests: 2, Errors: 1, BreakerTrips: 2, FailedFast: 3, breakerState: gobreaker.StateOpen, failedFastReported: true},
+		"identity": {Requests: 1, breakerState: gobreaker.StateClosed},
+	}, health.services)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+//go:embed suggestions.yaml
+var embeddedSuggestions []byte
+
+var (
+	suggestionCatalogOnce sync.Once
+	suggestionCatalogVar  *suggestionCatalog
+
+	limitNameRegexes = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)(?:limits?|quotas?)[^:]*exceeded[^:]*:\s*([A-Za-z0-9_-]+(?:,\s*[A-Za-z0-9_-]+)*)`),
+		regexp.MustCompile(`(?i)(?:limit|quota) '([^']+)'`),
+	}
+)
+
+// suggestionEntry gives the suggestion for the errors matching all of its keys that are set
+type suggestionEntry struct {
+	Service    string `yaml:"service"`
+	Status     int    `yaml:"status"`
+	Code       string `yaml:"code"`
+	Operation  string `yaml:"operation"`
+	Message    string `yaml:"message"`
+	Suggestion string `yaml:"suggestion"`
+
+	operationRegex *regexp.Regexp
+	messageRegex   *regexp.Regexp
+	template       *template.Template
+}
+
+type suggestionCatalog struct {
+	Suggestions []*suggestionEntry `yaml:"suggestions"`
+}
+
+// suggestionData is given to the templates of the suggestions
+type suggestionData struct {
+	customError
+	// The IAM verb the operation of the error requires, e.g. `inspect` for the list operations
+	PolicyVerb string
+	// The name of the limit or quota named by the error, if any
+	LimitName string
+}
+
+// getSuggestionCatalog returns the catalog of suggestions, made of those of the file set in OCI_TF_SUGGESTIONS_PATH,
+// if any, followed by the embedded ones
+func getSuggestionCatalog() *suggestionCatalog {
+	suggestionCatalogOnce.Do(func() {
+		if suggestionCatalogVar != nil {
+			return
+		}
+		catalog, err := parseSuggestionCatalog(embeddedSuggestions)
+		if err != nil {
+			log.Printf("[ERROR] invalid embedded suggestions: %v", err)
+			catalog = &suggestionCatalog{}
+		}
+		if path := os.Getenv(globalvar.EnvOCITFSuggestionsPath); path != "" {
+			userCatalog, err := loadSuggestionCatalog(path)
+			if err != nil {
+				log.Printf("[WARN] ignoring the suggestions of '%s': %v", path, err)
+			} else {
+				catalog.Suggestions = append(userCatalog.Suggestions, catalog.Suggestions...)
+			}
+		}
+		suggestionCatalogVar = catalog
+	})
+	return suggestionCatalogVar
+}
+
+func loadSuggestionCatalog(path string) (*suggestionCatalog, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseSuggestionCatalog(content)
+}
+
+func parseSuggestionCatalog(content []byte) (*suggestionCatalog, error) {
+	catalog := &suggestionCatalog{}
+	if err := yaml.UnmarshalStrict(content, catalog); err != nil {
+		return nil, err
+	}
+	for i, entry := range catalog.Suggestions {
+		if err := entry.compile(); err != nil {
+			return nil, fmt.Errorf("suggestion %d: %v", i+1, err)
+		}
+	}
+	return catalog, nil
+}
+
+func (e *suggestionEntry) compile() (err error) {
+	if e.Suggestion == "" {
+		return fmt.Errorf("the suggestion is not set")
+	}
+	if e.Operation != "" {
+		if e.operationRegex, err = regexp.Compile(e.Operation); err != nil {
+			return fmt.Errorf("invalid operation pattern: %v", err)
+		}
+	}
+	if e.Message != "" {
+		if e.messageRegex, err = regexp.Compile(e.Message); err != nil {
+			return fmt.Errorf("invalid message pattern: %v", err)
+		}
+	}
+	if e.template, err = template.New("suggestion").Parse(e.Suggestion); err != nil {
+		return fmt.Errorf("invalid suggestion: %v", err)
+	}
+	return nil
+}
+
+func (e *suggestionEntry) matches(tfError customError) bool {
+	if e.Service != "" && !strings.HasPrefix(normalizeServiceName(tfError.Service), normalizeServiceName(e.Service)) {
+		return false
+	}
+	if e.Status != 0 && e.Status != tfError.ErrorCode {
+		return false
+	}
+	if e.Code != "" && !strings.EqualFold(e.Code, tfError.ErrorCodeName) {
+		return false
+	}
+	if e.operationRegex != nil && !e.operationRegex.MatchString(tfError.OperationName) {
+		return false
+	}
+	if e.messageRegex != nil && !e.messageRegex.MatchString(tfError.Message) && !e.messageRegex.MatchString(tfError.OriginalMessage) {
+		return false
+	}
+	return true
+}
+
+// specificity is the number of keys of the entry
+func (e *suggestionEntry) specificity() int {
+	specificity := 0
+	for _, set := range []bool{e.Service != "", e.Status != 0, e.Code != "", e.operationRegex != nil, e.messageRegex != nil} {
+		if set {
+			specificity++
+		}
+	}
+	return specificity
+}
+
+// suggestionFor returns the suggestion of the most specific entry matching the error, or "" if there is none. The
+// earlier entries win the ties.
+func (c *suggestionCatalog) suggestionFor(tfError customError) string {
+	var match *suggestionEntry
+	for _, entry := range c.Suggestions {
+		if entry.matches(tfError) && (match == nil || entry.specificity() > match.specificity()) {
+			match = entry
+		}
+	}
+	if match == nil {
+		return ""
+	}
+
+	var suggestion bytes.Buffer
+	data := suggestionData{customError: tfError, PolicyVerb: policyVerb(tfError.OperationName), LimitName: limitName(tfError)}
+	if err := match.template.Execute(&suggestion, data); err != nil {
+		log.Printf("[WARN] invalid suggestion for error code '%s': %v", tfError.ErrorCodeName, err)
+		return ""
+	}
+	return strings.TrimSpace(suggestion.String())
+}
+
+// normalizeServiceName makes the service names of the catalog, e.g. `objectstorage`, comparable to those of the
+// errors, e.g. `Object Storage Bucket`
+func normalizeServiceName(service string) string {
+	return strings.ToLower(strings.ReplaceAll(service, " ", ""))
+}
+
+// policyVerb returns the IAM verb that allows the operation
+func policyVerb(operationName string) string {
+	switch {
+	case strings.HasPrefix(operationName, "List"):
+		return "inspect"
+	case strings.HasPrefix(operationName, "Get"), strings.HasPrefix(operationName, "Head"):
+		return "read"
+	case strings.HasPrefix(operationName, "Update"), strings.HasPrefix(operationName, "Attach"), strings.HasPrefix(operationName, "Detach"):
+		if !strings.HasSuffix(operationName, "Compartment") {
+			return "use"
+		}
+	}
+	return "manage"
+}
+
+// limitName returns the name of the limit or quota the error names, e.g. `vcn-count`
+func limitName(tfError customError) string {
+	for _, message := range []string{tfError.Message, tfError.OriginalMessage} {
+		for _, limitNameRegex := range limitNameRegexes {
+			if match := limitNameRegex.FindStringSubmatch(message); match != nil {
+				return match[1]
+			}
+		}
+	}
+	return ""
+}
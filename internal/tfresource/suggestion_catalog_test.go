@@ -0,0 +1,176 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+func TestUnitSuggestionCatalog_suggestionFor(t *testing.T) {
+	catalog, err := parseSuggestionCatalog(embeddedSuggestions)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tests := []struct {
+		name    string
+		tfError customError
+		want    []string
+	}{
+		{
+			name:    "Test policy of the network resources",
+			tfError: customError{ErrorCode: 404, ErrorCodeName: "NotAuthorizedOrNotFound", Service: "Core Vcn", OperationName: "CreateVcn"},
+			want:    []string{"'Allow group <group> to manage virtual-network-family in compartment <compartment>'", "CreateVcn"},
+		},
+		{
+			name:    "Test policy of the compute resources",
+			tfError: customError{ErrorCode: 404, ErrorCodeName: "NotAuthorizedOrNotFound", Service: "Core Instance", OperationName: "ListInstances"},
+			want:    []string{"inspect instance-family"},
+		},
+		{
+			name:    "Test policy of another service",
+			tfError: customError{ErrorCode: 404, ErrorCodeName: "NotAuthorizedOrNotFound", Service: "Object Storage Bucket", OperationName: "GetBucket"},
+			want:    []string{"read object-family"},
+		},
+		{
+			name:    "Test policy of a service without entry",
+			tfError: customError{ErrorCode: 404, ErrorCodeName: "NotAuthorizedOrNotFound", Service: "Apm Domain", OperationName: "UpdateApmDomain"},
+			want:    []string{"service Apm Domain need policy", "to use <resource-type>"},
+		},
+		{
+			name:    "Test name of the limit to raise",
+			tfError: customError{ErrorCode: 400, ErrorCodeName: "LimitExceeded", Service: "Core Vcn", Message: "The following service limits were exceeded: vcn-count. Request a service limit increase from the service limits page in the console."},
+			want:    []string{"Request a service limit increase for this resource Core Vcn: raise the 'vcn-count' limit."},
+		},
+		{
+			name:    "Test limit without name",
+			tfError: customError{ErrorCode: 400, ErrorCodeName: "LimitExceeded", Service: "Core Vcn", Message: "LimitExceeded"},
+			want:    []string{"Request a service limit increase for this resource Core Vcn. Limits"},
+		},
+		{
+			name:    "Test pattern of the operation",
+			tfError: customError{ErrorCode: 409, ErrorCodeName: "IncorrectState", Service: "Core Subnet", OperationName: "DeleteSubnet"},
+			want:    []string{"The network resource is still in use"},
+		},
+		{
+			name:    "Test pattern of the message",
+			tfError: customError{ErrorCode: 500, ErrorCodeName: "InternalError", Service: "Core Instance", Message: "Out of host capacity."},
+			want:    []string{"There is not enough capacity"},
+		},
+		{
+			name:    "Test code without entry",
+			tfError: customError{ErrorCode: 400, ErrorCodeName: "InvalidParameter", Service: "Core Vcn", Message: "Invalid cidrBlock"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suggestion := catalog.suggestionFor(tt.tfError)
+			if len(tt.want) == 0 {
+				assert.Empty(t, suggestion)
+			}
+			for _, want := range tt.want {
+				assert.Contains(t, suggestion, want)
+			}
+		})
+	}
+}
+
+func TestUnitParseSuggestionCatalog(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "Test valid catalog",
+			content: "suggestions:\n  - code: LimitExceeded\n    suggestion: Ask the platform team to raise {{.LimitName}}\n",
+		},
+		{
+			name:    "Test unknown key",
+			content: "suggestions:\n  - errorCode: LimitExceeded\n    suggestion: Ask the platform team\n",
+			wantErr: "errorCode",
+		},
+		{
+			name:    "Test invalid pattern",
+			content: "suggestions:\n  - message: (out of capacity\n    suggestion: Try later\n",
+			wantErr: "suggestion 1: invalid message pattern",
+		},
+		{
+			name:    "Test invalid template",
+			content: "suggestions:\n  - code: LimitExceeded\n    suggestion: Raise {{.LimitName\n",
+			wantErr: "suggestion 1: invalid suggestion",
+		},
+		{
+			name:    "Test suggestion not set",
+			content: "suggestions:\n  - code: LimitExceeded\n",
+			wantErr: "suggestion 1: the suggestion is not set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseSuggestionCatalog([]byte(tt.content))
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnitGetSuggestionCatalog_userFile(t *testing.T) {
+	defer func(catalog *suggestionCatalog) {
+		suggestionCatalogVar = catalog
+	}(getSuggestionCatalog())
+
+	path := filepath.Join(t.TempDir(), "suggestions.yaml")
+	content := "suggestions:\n  - code: LimitExceeded\n    suggestion: Ask the platform team to raise the '{{.LimitName}}' limit\n"
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	os.Setenv(globalvar.EnvOCITFSuggestionsPath, path)
+	defer os.Unsetenv(globalvar.EnvOCITFSuggestionsPath)
+
+	suggestionCatalogOnce = sync.Once{}
+	suggestionCatalogVar = nil
+	tfError := customError{TypeOfError: ServiceError, ErrorCode: 400, ErrorCodeName: "LimitExceeded", Message: "The following service limits were exceeded: vcn-count."}
+
+	// The entries of the user take precedence over the embedded ones, and the others are still used
+	assert.Equal(t, "Ask the platform team to raise the 'vcn-count' limit", getSuggestionFromError(tfError))
+	tfError.ErrorCodeName = "QuotaExceeded"
+	assert.Contains(t, getSuggestionFromError(tfError), "Contact your administrator")
+
+	// An invalid file is ignored
+	assert.NoError(t, os.WriteFile(path, []byte("suggestions: ["), 0644))
+	suggestionCatalogOnce = sync.Once{}
+	suggestionCatalogVar = nil
+	tfError.ErrorCodeName = "LimitExceeded"
+	assert.Contains(t, getSuggestionFromError(tfError), "Request a service limit increase")
+}
+
+func TestUnitPolicyVerb(t *testing.T) {
+	tests := []struct {
+		operationName string
+		want          string
+	}{
+		{operationName: "ListVcns", want: "inspect"},
+		{operationName: "GetBucket", want: "read"},
+		{operationName: "HeadObject", want: "read"},
+		{operationName: "UpdateInstance", want: "use"},
+		{operationName: "AttachVolume", want: "use"},
+		{operationName: "CreateVcn", want: "manage"},
+		{operationName: "ChangeVcnCompartment", want: "manage"},
+		{operationName: "", want: "manage"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.operationName, func(t *testing.T) {
+			assert.Equal(t, tt.want, policyVerb(tt.operationName))
+		})
+	}
+}
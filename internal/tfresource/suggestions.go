@@ -3,9 +3,15 @@
 
 package tfresource
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 func getSuggestionFromError(tfError customError) string {
+	if suggestion := getSuggestionCatalog().suggestionFor(tfError); suggestion != "" {
+		return suggestion
+	}
 	switch tfError.TypeOfError {
 	case ServiceError:
 		return getSuggestionForServiceError(tfError)
@@ -76,3 +82,14 @@ func getSuggestionForTimeoutError() string {
 func getSuggestionForUnexpectedState(tfError customError) string {
 	return fmt.Sprintf("Please retry or contact support for help with service: %s", tfError.Service)
 }
+
+func getSuggestionForWarning(warning Warning) string {
+	if strings.Contains(strings.ToLower(warning.Summary), "deprecated") {
+		return fmt.Sprintf("Remove '%s' from the Terraform config, or replace it as described in the documentation of the resource", warning.Attribute)
+	}
+	return "Review the Terraform config of the resource"
+}
+
+func getSuggestionForPlaceholderValue(attribute string) string {
+	return fmt.Sprintf("Replace the value of '%s' in the Terraform config with an actual one", attribute)
+}
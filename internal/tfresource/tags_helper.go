@@ -4,15 +4,28 @@
 package tfresource
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var DefinedTagsToSuppress []string
 
+// Tags set in the provider block, which are merged into the tags of every resource that supports tagging
+var (
+	DefaultFreeformTags map[string]interface{}
+	DefaultDefinedTags  map[string]interface{}
+)
+
+const (
+	freeformTagsAttrName = "freeform_tags"
+	definedTagsAttrName  = "defined_tags"
+)
+
 func DefinedTagsToMap(definedTags map[string]map[string]interface{}) map[string]interface{} {
 	var tags = make(map[string]interface{})
 	if len(definedTags) > 0 {
@@ -85,6 +98,143 @@ func DefinedTagsDiffSuppressFunction(key string, old string, new string, d *sche
 	return false
 }
 
+// FreeformTagsDiffSuppressFunction suppresses the diffs on freeform tags that only come from the provider's default tags
+func FreeformTagsDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	return defaultTagsDiffSuppress(key, old, new, d, DefaultFreeformTags)
+}
+
+// defaultTagsDiffSuppress returns true if the tags in the state only differ from the tags in the configuration by the
+// provider's default tags, which were merged into the tags sent to the service
+func defaultTagsDiffSuppress(key string, old string, new string, d schemaResourceData, defaultTags map[string]interface{}) bool {
+	if len(defaultTags) == 0 {
+		return false
+	}
+	keyParts := strings.SplitN(key, ".", 2)
+	if len(keyParts) != 2 {
+		return false
+	}
+
+	if keyParts[1] == "%" {
+		oldRaw, newRaw := d.GetChange(keyParts[0])
+		oldValue, oldValueOk := oldRaw.(map[string]interface{})
+		newValue, newValueOk := newRaw.(map[string]interface{})
+		if !oldValueOk || !newValueOk {
+			return false
+		}
+		return reflect.DeepEqual(ToLowerCaseKeyMap(oldValue), ToLowerCaseKeyMap(MergeDefaultTags(defaultTags, newValue)))
+	}
+
+	if new != "" {
+		return false
+	}
+	for defaultKey, defaultValue := range defaultTags {
+		if strings.EqualFold(defaultKey, keyParts[1]) {
+			return fmt.Sprintf("%v", defaultValue) == old
+		}
+	}
+	return false
+}
+
+// MergeDefaultTags returns the given tags with the default tags added. Tags that are set on the resource take
+// precedence over the default tags with the same key.
+func MergeDefaultTags(defaultTags map[string]interface{}, tags map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(defaultTags)+len(tags))
+	lowerCaseKeys := make(map[string]bool, len(tags))
+	for key, value := range tags {
+		merged[key] = value
+		lowerCaseKeys[strings.ToLower(key)] = true
+	}
+	for key, value := range defaultTags {
+		if !lowerCaseKeys[strings.ToLower(key)] {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// addDefaultTags makes the resource send the provider's default tags on create and update, if it supports tagging
+func addDefaultTags(resourceSchema *schema.Resource) {
+	if resourceSchema == nil || resourceSchema.Schema == nil {
+		return
+	}
+
+	var tagAttributes []string
+	for tagAttribute, defaultTags := range map[string]func() map[string]interface{}{
+		freeformTagsAttrName: func() map[string]interface{} { return DefaultFreeformTags },
+		definedTagsAttrName:  func() map[string]interface{} { return DefaultDefinedTags },
+	} {
+		tagSchema, exists := resourceSchema.Schema[tagAttribute]
+		if !exists || tagSchema.Type != schema.TypeMap || !tagSchema.Optional {
+			continue
+		}
+		tagSchema.DiffSuppressFunc = withDefaultTagsDiffSuppress(tagSchema.DiffSuppressFunc, defaultTags)
+		tagAttributes = append(tagAttributes, tagAttribute)
+	}
+	if len(tagAttributes) == 0 {
+		return
+	}
+
+	resourceSchema.Create = withDefaultTags(resourceSchema.Create, tagAttributes)
+	resourceSchema.Update = withDefaultTags(resourceSchema.Update, tagAttributes)
+	resourceSchema.CreateContext = withDefaultTagsContext(resourceSchema.CreateContext, tagAttributes)
+	resourceSchema.UpdateContext = withDefaultTagsContext(resourceSchema.UpdateContext, tagAttributes)
+	resourceSchema.CreateWithoutTimeout = withDefaultTagsContext(resourceSchema.CreateWithoutTimeout, tagAttributes)
+	resourceSchema.UpdateWithoutTimeout = withDefaultTagsContext(resourceSchema.UpdateWithoutTimeout, tagAttributes)
+}
+
+// withDefaultTagsDiffSuppress chains the diff suppress function of a tags attribute, if any, with the one suppressing
+// the diffs that only come from the provider's default tags
+func withDefaultTagsDiffSuppress(diffSuppressFunc schema.SchemaDiffSuppressFunc, defaultTags func() map[string]interface{}) schema.SchemaDiffSuppressFunc {
+	return func(key string, old string, new string, d *schema.ResourceData) bool {
+		if diffSuppressFunc != nil && diffSuppressFunc(key, old, new, d) {
+			return true
+		}
+		return defaultTagsDiffSuppress(key, old, new, d, defaultTags())
+	}
+}
+
+func withDefaultTags(fn func(*schema.ResourceData, interface{}) error, tagAttributes []string) func(*schema.ResourceData, interface{}) error {
+	if fn == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		if err := mergeDefaultTags(d, tagAttributes); err != nil {
+			return err
+		}
+		return fn(d, m)
+	}
+}
+
+func withDefaultTagsContext(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, tagAttributes []string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := mergeDefaultTags(d, tagAttributes); err != nil {
+			return diag.FromErr(err)
+		}
+		return fn(ctx, d, m)
+	}
+}
+
+// mergeDefaultTags sets the tags attributes of the resource to their tags merged with the provider's default tags
+func mergeDefaultTags(d *schema.ResourceData, tagAttributes []string) error {
+	for _, tagAttribute := range tagAttributes {
+		defaultTags := DefaultFreeformTags
+		if tagAttribute == definedTagsAttrName {
+			defaultTags = DefaultDefinedTags
+		}
+		if len(defaultTags) == 0 {
+			continue
+		}
+		tags, _ := d.Get(tagAttribute).(map[string]interface{})
+		if err := d.Set(tagAttribute, MergeDefaultTags(defaultTags, tags)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func ToLowerCaseKeyMap(original map[string]interface{}) map[string]interface{} {
 	lowercaseKeyMap := make(map[string]interface{}, len(original))
 	for key, value := range original {
@@ -1,10 +1,13 @@
 package tfresource
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestUnitDefinedTagsToMap(t *testing.T) {
@@ -268,3 +271,174 @@ func TestUnitDefinedTagsDiffSuppressFunction(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitMergeDefaultTags(t *testing.T) {
+	tests := []struct {
+		name        string
+		defaultTags map[string]interface{}
+		tags        map[string]interface{}
+		want        map[string]interface{}
+	}{
+		{
+			name:        "Test default tags are added",
+			defaultTags: map[string]interface{}{"CostCenter": "42"},
+			tags:        map[string]interface{}{"Department": "Finance"},
+			want:        map[string]interface{}{"CostCenter": "42", "Department": "Finance"},
+		},
+		{
+			name:        "Test resource tags take precedence",
+			defaultTags: map[string]interface{}{"Oracle-Tags.CreatedBy": "terraform"},
+			tags:        map[string]interface{}{"oracle-tags.createdby": "someone"},
+			want:        map[string]interface{}{"oracle-tags.createdby": "someone"},
+		},
+		{
+			name:        "Test no resource tags",
+			defaultTags: map[string]interface{}{"CostCenter": "42"},
+			tags:        nil,
+			want:        map[string]interface{}{"CostCenter": "42"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MergeDefaultTags(tt.defaultTags, tt.tags))
+		})
+	}
+}
+
+func TestUnitFreeformTagsDiffSuppressFunction(t *testing.T) {
+	DefaultFreeformTags = map[string]interface{}{"CostCenter": "42"}
+	defer func() { DefaultFreeformTags = nil }()
+
+	resourceSchema := map[string]*schema.Schema{
+		"freeform_tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     schema.TypeString,
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"freeform_tags": map[string]interface{}{"Department": "Finance"},
+	})
+
+	tests := []struct {
+		name string
+		key  string
+		old  string
+		new  string
+		want bool
+	}{
+		{
+			name: "Test default tag missing from config is suppressed",
+			key:  "freeform_tags.CostCenter",
+			old:  "42",
+			new:  "",
+			want: true,
+		},
+		{
+			name: "Test default tag with changed value is not suppressed",
+			key:  "freeform_tags.CostCenter",
+			old:  "41",
+			new:  "",
+			want: false,
+		},
+		{
+			name: "Test other tag is not suppressed",
+			key:  "freeform_tags.Owner",
+			old:  "me",
+			new:  "",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FreeformTagsDiffSuppressFunction(tt.key, tt.old, tt.new, d))
+		})
+	}
+}
+
+func TestUnitRegisterResource_defaultTags(t *testing.T) {
+	DefaultFreeformTags = map[string]interface{}{"CostCenter": "42"}
+	DefaultDefinedTags = map[string]interface{}{"Operations.CostCenter": "42"}
+	defer func() {
+		DefaultFreeformTags = nil
+		DefaultDefinedTags = nil
+	}()
+
+	var freeformTags, definedTags interface{}
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"defined_tags": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: DefinedTagsDiffSuppressFunction,
+				Elem:             schema.TypeString,
+			},
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			freeformTags, _ = d.GetOkExists("freeform_tags")
+			definedTags, _ = d.GetOkExists("defined_tags")
+			return nil
+		},
+	}
+	RegisterResource("oci_test_default_tags", testResource)
+	assert.NotNil(t, testResource.Schema["freeform_tags"].DiffSuppressFunc)
+
+	d := schema.TestResourceDataRaw(t, testResource.Schema, map[string]interface{}{
+		"freeform_tags": map[string]interface{}{"Department": "Finance"},
+	})
+	assert.NoError(t, testResource.Create(d, nil))
+	assert.Equal(t, map[string]interface{}{"CostCenter": "42", "Department": "Finance"}, freeformTags)
+	assert.Equal(t, map[string]interface{}{"Operations.CostCenter": "42"}, definedTags)
+
+	// The diff suppress function of the resource is chained with the one of the default tags
+	DefinedTagsToSuppress = []string{"Oracle-Tags.CreatedBy"}
+	defer func() { DefinedTagsToSuppress = nil }()
+	diffSuppressFunc := testResource.Schema["defined_tags"].DiffSuppressFunc
+	d = schema.TestResourceDataRaw(t, testResource.Schema, map[string]interface{}{
+		"defined_tags": map[string]interface{}{"Operations.Department": "Finance"},
+	})
+	assert.True(t, diffSuppressFunc("defined_tags.Oracle-Tags.CreatedBy", "me", "", d))
+	assert.True(t, diffSuppressFunc("defined_tags.Operations.CostCenter", "42", "", d))
+	assert.False(t, diffSuppressFunc("defined_tags.Operations.Owner", "me", "", d))
+}
+
+func TestUnitRegisterResource_defaultTagsContextCrud(t *testing.T) {
+	DefaultFreeformTags = map[string]interface{}{"CostCenter": "42"}
+	defer func() { DefaultFreeformTags = nil }()
+
+	var createdTags, updatedTags interface{}
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			createdTags = d.Get("freeform_tags")
+			return nil
+		},
+		UpdateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			updatedTags = d.Get("freeform_tags")
+			return nil
+		},
+	}
+	RegisterResource("oci_test_default_tags_context", testResource)
+
+	d := schema.TestResourceDataRaw(t, testResource.Schema, map[string]interface{}{
+		"freeform_tags": map[string]interface{}{"Department": "Finance"},
+	})
+	assert.Empty(t, testResource.CreateContext(context.Background(), d, nil))
+	assert.Equal(t, map[string]interface{}{"CostCenter": "42", "Department": "Finance"}, createdTags)
+	assert.Empty(t, testResource.UpdateWithoutTimeout(context.Background(), d, nil))
+	assert.Equal(t, map[string]interface{}{"CostCenter": "42", "Department": "Finance"}, updatedTags)
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+const (
+	workRequestFailureMaxRetriesAttrName     = "max_retries"
+	workRequestFailureBackoffSecondsAttrName = "backoff_seconds"
+	workRequestFailureRetriableCodesAttrName = "retriable_codes"
+
+	defaultWorkRequestFailureBackoff = 30 * time.Second
+)
+
+// WorkRequestFailureRetryPolicyFromConfig is the policy set in the provider block, used by the resources that do not
+// set one of their own
+var WorkRequestFailureRetryPolicyFromConfig *WorkRequestFailureRetryPolicy
+
+var workRequestFailureRetrySleepVar = sleepWithContext
+
+// WorkRequestFailureRetryPolicy creates a resource again when the work request that creates it fails
+type WorkRequestFailureRetryPolicy struct {
+	MaxRetries int
+	// Backoff before the first retry, doubled for each of the following ones
+	Backoff time.Duration
+	// Error codes, or parts of the error messages, of the failures to retry. All failures are retried if empty.
+	RetriableCodes []string
+}
+
+// WorkRequestFailedError is returned when a work request did not succeed, along with the codes of its errors
+type WorkRequestFailedError struct {
+	WorkRequestId string
+	Codes         []string
+	Message       string
+	// ID of the resource the work request was for, if the work request reported it
+	ResourceId string
+}
+
+func (e *WorkRequestFailedError) Error() string {
+	return e.Message
+}
+
+// WorkRequestFailureRetriesSchema is the schema of the `work_request_failure_retries` block, of the provider and of
+// the resources whose creation waits on a work request
+func WorkRequestFailureRetriesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				workRequestFailureMaxRetriesAttrName: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of times to create the resource again after its work request failed.",
+				},
+				workRequestFailureBackoffSecondsAttrName: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      int(defaultWorkRequestFailureBackoff / time.Second),
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of seconds to wait before the first retry, doubled for each of the following ones.",
+				},
+				workRequestFailureRetriableCodesAttrName: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The error codes, or parts of the error messages, of the work request failures to retry, e.g. `InternalError`. All failures are retried if not set.",
+				},
+			},
+		},
+	}
+}
+
+// WorkRequestFailureRetryPolicyFromData returns the policy set in the `work_request_failure_retries` block, or nil
+func WorkRequestFailureRetryPolicyFromData(d schemaResourceData) *WorkRequestFailureRetryPolicy {
+	retries, ok := d.GetOkExists(globalvar.WorkRequestFailureRetriesAttrName)
+	if !ok {
+		return nil
+	}
+	retriesList, ok := retries.([]interface{})
+	if !ok || len(retriesList) == 0 {
+		return nil
+	}
+	retriesMap, ok := retriesList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	policy := &WorkRequestFailureRetryPolicy{Backoff: defaultWorkRequestFailureBackoff}
+	if maxRetries, ok := retriesMap[workRequestFailureMaxRetriesAttrName].(int); ok {
+		policy.MaxRetries = maxRetries
+	}
+	if backoffSeconds, ok := retriesMap[workRequestFailureBackoffSecondsAttrName].(int); ok {
+		policy.Backoff = time.Duration(backoffSeconds) * time.Second
+	}
+	if codes, ok := retriesMap[workRequestFailureRetriableCodesAttrName].([]interface{}); ok {
+		for _, code := range codes {
+			if code != nil && code.(string) != "" {
+				policy.RetriableCodes = append(policy.RetriableCodes, code.(string))
+			}
+		}
+	}
+	return policy
+}
+
+// workRequestFailureRetryPolicy returns the policy of the resource, or the one of the provider if it has none
+func workRequestFailureRetryPolicy(d schemaResourceData) *WorkRequestFailureRetryPolicy {
+	if policy := WorkRequestFailureRetryPolicyFromData(d); policy != nil {
+		return policy
+	}
+	return WorkRequestFailureRetryPolicyFromConfig
+}
+
+func (p *WorkRequestFailureRetryPolicy) isRetriable(failure error) bool {
+	if len(p.RetriableCodes) == 0 {
+		return true
+	}
+
+	var workRequestFailedError *WorkRequestFailedError
+	errors.As(failure, &workRequestFailedError)
+	message := strings.ToLower(failure.Error())
+	for _, retriableCode := range p.RetriableCodes {
+		if workRequestFailedError != nil {
+			for _, code := range workRequestFailedError.Codes {
+				if strings.EqualFold(code, retriableCode) {
+					return true
+				}
+			}
+		}
+		if strings.Contains(message, strings.ToLower(retriableCode)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *WorkRequestFailureRetryPolicy) backoff(retry int) time.Duration {
+	return p.Backoff * time.Duration(1<<uint(retry-1))
+}
+
+// isWorkRequestFailure returns true if the error is returned because the work request of an operation did not succeed
+func isWorkRequestFailure(err error) bool {
+	var workRequestFailedError *WorkRequestFailedError
+	return errors.As(err, &workRequestFailedError) || strings.Contains(err.Error(), "work request did not succeed")
+}
+
+// addWorkRequestFailureRetries adds the `work_request_failure_retries` block to the resource, whose creation waits on
+// a work request. It is only used on creation, so changes to it are ignored once the resource exists.
+func addWorkRequestFailureRetries(resourceSchema *schema.Resource) {
+	if resourceSchema == nil || resourceSchema.Schema == nil || !hasCreate(resourceSchema) {
+		return
+	}
+	if _, exists := resourceSchema.Schema[globalvar.WorkRequestFailureRetriesAttrName]; exists {
+		return
+	}
+
+	retriesSchema := WorkRequestFailureRetriesSchema("(Optional) Create the resource again when the work request that creates it fails. Overrides the `work_request_failure_retries` of the provider.")
+	suppressOnceCreated := func(k string, old string, new string, d *schema.ResourceData) bool {
+		return d.Id() != ""
+	}
+	retriesSchema.DiffSuppressFunc = suppressOnceCreated
+	for _, attrSchema := range retriesSchema.Elem.(*schema.Resource).Schema {
+		attrSchema.DiffSuppressFunc = suppressOnceCreated
+	}
+	// Resources that can not be updated require all of their attributes to force a new resource
+	retriesSchema.ForceNew = !hasUpdate(resourceSchema)
+	resourceSchema.Schema[globalvar.WorkRequestFailureRetriesAttrName] = retriesSchema
+}
+
+// failedCreation is a creation whose work request failed
+type failedCreation struct {
+	// ID of the resource that failed to be created, if it exists
+	resourceId string
+	cause      error
+}
+
+// setFailedResourceId sets the ID of the resource the failed work request was for, as it may have been created even
+// though the work request failed, and must then be deleted before creating it again
+func setFailedResourceId(err error, resources []oci_work_requests.WorkRequestResource, entityType string) {
+	var workRequestFailedError *WorkRequestFailedError
+	if !errors.As(err, &workRequestFailedError) || workRequestFailedError.ResourceId != "" {
+		return
+	}
+	for _, res := range resources {
+		if res.EntityType == nil || res.Identifier == nil || *res.Identifier == "" {
+			continue
+		}
+		if strings.Contains(strings.ToLower(*res.EntityType), strings.ToLower(entityType)) {
+			workRequestFailedError.ResourceId = *res.Identifier
+			return
+		}
+	}
+}
+
+// failedResourceId returns the ID of the resource that failed to be created, if it exists: the one reported by its work
+// request, or else the one of the CRUD type. The CRUD types whose creation returns a work request are identified by it
+// until the resource is created, so the ID of a work request is ignored.
+func failedResourceId(d schemaResourceData, sync ResourceCreatorWithContext, failure error) (id string) {
+	var workRequestFailedError *WorkRequestFailedError
+	if errors.As(failure, &workRequestFailedError) && workRequestFailedError.ResourceId != "" {
+		return workRequestFailedError.ResourceId
+	}
+	if id = resourceDataId(d); id != "" && !isWorkRequestId(id) {
+		return id
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			log.Println("[WARN] ID() function panic recovered!", r)
+			id = ""
+		}
+	}()
+	if id = sync.ID(); isWorkRequestId(id) {
+		return ""
+	}
+	return id
+}
+
+func isWorkRequestId(id string) bool {
+	return strings.Contains(strings.ToLower(id), "workrequest")
+}
+
+// cleanUpFailedCreation deletes the resource that failed to be created, if it exists, before creating it again
+func cleanUpFailedCreation(ctx context.Context, d schemaResourceData, sync ResourceCreatorWithContext, failure *failedCreation) error {
+	deleter, ok := asResourceDeleterWithContext(sync)
+	if !ok || failure.resourceId == "" {
+		sync.VoidState()
+		return nil
+	}
+
+	log.Printf("[INFO] deleting resource '%s' that failed to be created", failure.resourceId)
+	d.SetId(failure.resourceId)
+	if err := deleteResource(ctx, d, deleter); err != nil {
+		return err
+	}
+	sync.VoidState()
+	return nil
+}
+
+func describeWorkRequestFailureAttempts(attempts []string) string {
+	return fmt.Sprintf("creating the resource failed %d time(s):\n%s", len(attempts), strings.Join(attempts, "\n"))
+}
@@ -0,0 +1,320 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+type workRequestFailureResourceData struct {
+	mockResourceData
+	id      string
+	retries []interface{}
+}
+
+func (d *workRequestFailureResourceData) GetOkExists(key string) (interface{}, bool) {
+	if key == globalvar.WorkRequestFailureRetriesAttrName {
+		return d.retries, d.retries != nil
+	}
+	return nil, false
+}
+
+func (d *workRequestFailureResourceData) SetId(id string) {
+	d.id = id
+}
+
+func (d *workRequestFailureResourceData) Id() string {
+	return d.id
+}
+
+// workRequestFailureResourceCrud fails to be created until it has been created `failures` times. When
+// `failureReportsId` is set, the ID of the failed resource is only reported by its work request.
+type workRequestFailureResourceCrud struct {
+	d                *workRequestFailureResourceData
+	failures         int
+	failure          error
+	failureReportsId bool
+	creations        int
+	deleted          []string
+}
+
+func (s *workRequestFailureResourceCrud) Create() error {
+	s.creations++
+	id := fmt.Sprintf("ocid1.instance.%d", s.creations)
+	if s.creations > s.failures {
+		s.d.SetId(id)
+		return nil
+	}
+	if workRequestFailedError, ok := s.failure.(*WorkRequestFailedError); ok && s.failureReportsId {
+		failure := *workRequestFailedError
+		failure.ResourceId = id
+		return &failure
+	}
+	s.d.SetId(id)
+	return s.failure
+}
+
+func (s *workRequestFailureResourceCrud) ID() string {
+	return s.d.Id()
+}
+
+func (s *workRequestFailureResourceCrud) SetData() error {
+	return nil
+}
+
+func (s *workRequestFailureResourceCrud) VoidState() {
+	s.d.SetId("")
+}
+
+func (s *workRequestFailureResourceCrud) Delete() error {
+	s.deleted = append(s.deleted, s.d.Id())
+	return nil
+}
+
+func TestUnitCreateResource_workRequestFailureRetries(t *testing.T) {
+	capacityFailure := &WorkRequestFailedError{
+		WorkRequestId: "ocid1.workrequest.1",
+		Codes:         []string{"OutOfHostCapacity"},
+		Message:       "work request did not succeed, workId: ocid1.workrequest.1, entity: instance, action: CREATED. Message: Out of host capacity.",
+	}
+	retries := func(maxRetries int, codes ...interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{"max_retries": maxRetries, "backoff_seconds": 10, "retriable_codes": codes}}
+	}
+
+	tests := []struct {
+		name             string
+		retries          []interface{}
+		providerPolicy   *WorkRequestFailureRetryPolicy
+		failures         int
+		failure          error
+		failureReportsId bool
+		wantErr          bool
+		wantAttempts     []string
+		wantWarning      []string
+		wantCreations    int
+		wantDeleted      []string
+		wantBackoffs     []time.Duration
+		wantId           string
+	}{
+		{
+			name:          "Test no retries by default",
+			failures:      1,
+			failure:       capacityFailure,
+			wantErr:       true,
+			wantCreations: 1,
+		},
+		{
+			name:          "Test failed resource is deleted and created again",
+			retries:       retries(2),
+			failures:      2,
+			failure:       capacityFailure,
+			wantCreations: 3,
+			wantDeleted:   []string{"ocid1.instance.1", "ocid1.instance.2"},
+			wantBackoffs:  []time.Duration{10 * time.Second, 20 * time.Second},
+			wantWarning:   []string{"creating the resource failed 2 time(s):", "attempt 1: work request did not succeed", "attempt 2: work request did not succeed", "attempt 3: succeeded"},
+			wantId:        "ocid1.instance.3",
+		},
+		{
+			name:             "Test failed resource reported by the work request is deleted and created again",
+			retries:          retries(1),
+			failures:         1,
+			failure:          capacityFailure,
+			failureReportsId: true,
+			wantCreations:    2,
+			wantDeleted:      []string{"ocid1.instance.1"},
+			wantBackoffs:     []time.Duration{10 * time.Second},
+			wantWarning:      []string{"creating the resource failed 1 time(s):", "attempt 1: work request did not succeed", "attempt 2: succeeded"},
+			wantId:           "ocid1.instance.2",
+		},
+		{
+			name:          "Test retries exhausted",
+			retries:       retries(1),
+			failures:      3,
+			failure:       capacityFailure,
+			wantErr:       true,
+			wantAttempts:  []string{"creating the resource failed 2 time(s):", "attempt 1: work request did not succeed", "attempt 2: work request did not succeed"},
+			wantCreations: 2,
+			wantDeleted:   []string{"ocid1.instance.1"},
+			wantBackoffs:  []time.Duration{10 * time.Second},
+		},
+		{
+			name:          "Test retriable code",
+			retries:       retries(1, "outofhostcapacity"),
+			failures:      1,
+			failure:       capacityFailure,
+			wantCreations: 2,
+			wantDeleted:   []string{"ocid1.instance.1"},
+			wantBackoffs:  []time.Duration{10 * time.Second},
+			wantId:        "ocid1.instance.2",
+		},
+		{
+			name:          "Test retriable part of message of another service's work request",
+			retries:       retries(1, "host capacity"),
+			failures:      1,
+			failure:       errors.New("work request did not succeed, workId: ocid1.workrequest.1, entity: cluster, action: CREATED. Message: Out of host capacity."),
+			wantCreations: 2,
+			wantDeleted:   []string{"ocid1.instance.1"},
+			wantBackoffs:  []time.Duration{10 * time.Second},
+			wantId:        "ocid1.instance.2",
+		},
+		{
+			name:          "Test code that is not retriable",
+			retries:       retries(1, "InternalError"),
+			failures:      1,
+			failure:       capacityFailure,
+			wantErr:       true,
+			wantCreations: 1,
+		},
+		{
+			name:          "Test error other than a work request failure",
+			retries:       retries(1),
+			failures:      1,
+			failure:       errors.New("InvalidParameter"),
+			wantErr:       true,
+			wantCreations: 1,
+		},
+		{
+			name:           "Test policy of the provider",
+			providerPolicy: &WorkRequestFailureRetryPolicy{MaxRetries: 1, Backoff: time.Second},
+			failures:       1,
+			failure:        capacityFailure,
+			wantCreations:  2,
+			wantDeleted:    []string{"ocid1.instance.1"},
+			wantBackoffs:   []time.Duration{time.Second},
+			wantId:         "ocid1.instance.2",
+		},
+		{
+			name:           "Test policy of the resource overrides the one of the provider",
+			retries:        retries(0),
+			providerPolicy: &WorkRequestFailureRetryPolicy{MaxRetries: 1, Backoff: time.Second},
+			failures:       1,
+			failure:        capacityFailure,
+			wantErr:        true,
+			wantCreations:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var backoffs []time.Duration
+			workRequestFailureRetrySleepVar = func(ctx context.Context, d time.Duration) { backoffs = append(backoffs, d) }
+			defer func() { workRequestFailureRetrySleepVar = sleepWithContext }()
+			WorkRequestFailureRetryPolicyFromConfig = tt.providerPolicy
+			defer func() { WorkRequestFailureRetryPolicyFromConfig = nil }()
+
+			d := &workRequestFailureResourceData{retries: tt.retries}
+			sync := &workRequestFailureResourceCrud{d: d, failures: tt.failures, failure: tt.failure, failureReportsId: tt.failureReportsId}
+
+			diags := CreateResourceWithContext(context.Background(), d, AdaptResourceCreator(sync))
+			if tt.wantErr {
+				if assert.True(t, diags.HasError()) {
+					for _, wantAttempt := range tt.wantAttempts {
+						assert.Contains(t, diags[0].Summary, wantAttempt)
+					}
+				}
+			} else {
+				assert.False(t, diags.HasError())
+			}
+			if !tt.wantErr {
+				// The failed attempts are listed in a warning, even when a single one failed
+				if assert.Len(t, diags, 1) {
+					assert.Equal(t, diag.Warning, diags[0].Severity)
+					assert.Contains(t, diags[0].Summary, "failed attempt(s)")
+					for _, wantAttempt := range tt.wantWarning {
+						assert.Contains(t, diags[0].Detail, wantAttempt)
+					}
+				}
+			}
+			assert.Equal(t, tt.wantCreations, sync.creations)
+			assert.Equal(t, tt.wantDeleted, sync.deleted)
+			assert.Equal(t, tt.wantBackoffs, backoffs)
+			if tt.wantId != "" {
+				assert.Equal(t, tt.wantId, d.Id())
+			}
+		})
+	}
+}
+
+// failedWorkRequestClient returns a failed work request, for which the resource was created in the meantime
+type failedWorkRequestClient struct{}
+
+func (client *failedWorkRequestClient) GetWorkRequest(_ context.Context, request oci_work_requests.GetWorkRequestRequest) (oci_work_requests.GetWorkRequestResponse, error) {
+	return oci_work_requests.GetWorkRequestResponse{
+		WorkRequest: oci_work_requests.WorkRequest{
+			Id:     request.WorkRequestId,
+			Status: oci_work_requests.WorkRequestStatusFailed,
+			Resources: []oci_work_requests.WorkRequestResource{
+				{
+					EntityType: oci_common.String("instance"),
+					ActionType: oci_work_requests.WorkRequestResourceActionTypeInProgress,
+					Identifier: oci_common.String("ocid1.instance.1"),
+				},
+			},
+		},
+	}, nil
+}
+
+func (client *failedWorkRequestClient) ListWorkRequestErrors(context.Context, oci_work_requests.ListWorkRequestErrorsRequest) (oci_work_requests.ListWorkRequestErrorsResponse, error) {
+	return oci_work_requests.ListWorkRequestErrorsResponse{}, nil
+}
+
+func TestUnitWaitForWorkRequest_failedResourceId(t *testing.T) {
+	defer func() { getWorkRequestErrorsVar = getWorkRequestErrors }()
+	getWorkRequestErrorsVar = func(ctx context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+		return &WorkRequestFailedError{WorkRequestId: *wId, Codes: []string{"OutOfHostCapacity"}, Message: "Out of host capacity."}
+	}
+
+	_, err := WaitForWorkRequestWithContext(context.Background(), &failedWorkRequestClient{}, oci_common.String("ocid1.workrequest.1"), "instance",
+		oci_work_requests.WorkRequestResourceActionTypeCreated, time.Minute, false, true)
+	var workRequestFailedError *WorkRequestFailedError
+	if assert.True(t, errors.As(err, &workRequestFailedError)) {
+		assert.Equal(t, "ocid1.instance.1", workRequestFailedError.ResourceId)
+	}
+
+	// The ID of the work request is not taken for the one of the resource
+	d := &workRequestFailureResourceData{id: "ocid1.workrequest.1"}
+	assert.Equal(t, "", failedResourceId(d, AdaptResourceCreator(&workRequestFailureResourceCrud{d: d}), errors.New("work request did not succeed")))
+	assert.Equal(t, "ocid1.instance.1", failedResourceId(d, AdaptResourceCreator(&workRequestFailureResourceCrud{d: d}), err))
+}
+
+func TestUnitAddWorkRequestFailureRetries(t *testing.T) {
+	create := func(*schema.ResourceData, interface{}) error { return nil }
+	update := func(*schema.ResourceData, interface{}) error { return nil }
+
+	updatable := &schema.Resource{Create: create, Update: update, Schema: map[string]*schema.Schema{}}
+	addWorkRequestFailureRetries(updatable)
+	if assert.Contains(t, updatable.Schema, globalvar.WorkRequestFailureRetriesAttrName) {
+		assert.False(t, updatable.Schema[globalvar.WorkRequestFailureRetriesAttrName].ForceNew)
+		assert.NotNil(t, updatable.Schema[globalvar.WorkRequestFailureRetriesAttrName].DiffSuppressFunc)
+	}
+
+	notUpdatable := &schema.Resource{Create: create, Schema: map[string]*schema.Schema{}}
+	addWorkRequestFailureRetries(notUpdatable)
+	if assert.Contains(t, notUpdatable.Schema, globalvar.WorkRequestFailureRetriesAttrName) {
+		assert.True(t, notUpdatable.Schema[globalvar.WorkRequestFailureRetriesAttrName].ForceNew)
+	}
+
+	notCreatable := &schema.Resource{Schema: map[string]*schema.Schema{}}
+	addWorkRequestFailureRetries(notCreatable)
+	assert.NotContains(t, notCreatable.Schema, globalvar.WorkRequestFailureRetriesAttrName)
+
+	// Only the resources whose creation waits on a work request get the block
+	createdByWorkRequest := &schema.Resource{Create: create, Update: update, Schema: map[string]*schema.Schema{}}
+	RegisterWorkRequestResource("oci_test_created_by_work_request", createdByWorkRequest)
+	assert.Contains(t, createdByWorkRequest.Schema, globalvar.WorkRequestFailureRetriesAttrName)
+	notCreatedByWorkRequest := &schema.Resource{Create: create, Update: update, Schema: map[string]*schema.Schema{}}
+	RegisterResource("oci_test_not_created_by_work_request", notCreatedByWorkRequest)
+	assert.NotContains(t, notCreatedByWorkRequest.Schema, globalvar.WorkRequestFailureRetriesAttrName)
+}
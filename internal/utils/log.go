@@ -123,6 +123,16 @@ func (l defaultTFProviderLogger) LogLevel() int {
 }
 
 func (l defaultTFProviderLogger) Log(logLevel int, format string, v ...interface{}) error {
+	if StructuredLoggingEnabled() {
+		if logLevel > NONE && logLevel <= l.currentLoggingLevel {
+			level := "INFO"
+			if logLevel == DEBUG {
+				level = "DEBUG"
+			}
+			LogStructured(StructuredLogEntry{Level: level, Message: strings.TrimSuffix(fmt.Sprintf(format, v...), "\n")})
+		}
+		return nil
+	}
 	logger := l.getLoggerForLevel(logLevel)
 	_ = logger.Output(4, fmt.Sprintf(format, v...))
 	return nil
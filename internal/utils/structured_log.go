@@ -0,0 +1,184 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+// StructuredLogEntry is a single JSON line written by the structured logger
+type StructuredLogEntry struct {
+	Timestamp       string            `json:"timestamp"`
+	Level           string            `json:"level"`
+	Message         string            `json:"message,omitempty"`
+	ResourceType    string            `json:"resource_type,omitempty"`
+	ResourceId      string            `json:"resource_id,omitempty"`
+	Phase           string            `json:"phase,omitempty"`
+	Method          string            `json:"method,omitempty"`
+	Path            string            `json:"path,omitempty"`
+	Status          int               `json:"status,omitempty"`
+	LatencyMs       int64             `json:"latency_ms,omitempty"`
+	OpcRequestId    string            `json:"opc_request_id,omitempty"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	RequestBody     interface{}       `json:"request_body,omitempty"`
+	ResponseBody    interface{}       `json:"response_body,omitempty"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// LogContext identifies the resource operation that SDK calls are made for
+type LogContext struct {
+	ResourceType string
+	Phase        string
+	// The ID is read when a line is logged, since it is only known after the resource has been created
+	ResourceData interface {
+		Id() string
+	}
+}
+
+func (c *LogContext) ResourceId() string {
+	if c == nil || c.ResourceData == nil {
+		return ""
+	}
+	return c.ResourceData.Id()
+}
+
+const redactedValue = "<redacted>"
+
+var (
+	structuredLogWriter     io.Writer
+	structuredLogWriterOnce sync.Once
+	structuredLogLock       sync.Mutex
+
+	logContextKey = &struct{ name string }{"oci-log-context"}
+
+	sensitiveHeaders = []string{"authorization", "opc-obo-token", "x-content-sha256", "cookie", "set-cookie", "opc-security-token"}
+	// The lower case names of the fields of the request and response bodies whose values are redacted
+	sensitiveFields = map[string]bool{
+		"password": true, "adminpassword": true, "userpassword": true, "clusteradminpassword": true, "databaseadminpassword": true,
+		"pdbadminpassword": true, "replicationpassword": true, "keystorepassword": true, "truststorepassword": true,
+		"sslkeypassword": true, "walletpassword": true, "tdewalletpassword": true, "msaapppassword": true,
+		"secret": true, "secretkey": true, "secretaccesskey": true, "secretcontent": true, "clientsecret": true, "sharedsecret": true,
+		"privatekey": true, "private_key": true, "privatekeypassphrase": true, "passphrase": true, "keymaterial": true,
+		"token": true, "accesstoken": true, "authtoken": true, "usertoken": true, "idcsaccesstoken": true,
+		"content": true, "wallet": true,
+	}
+)
+
+// StructuredLoggingEnabled returns true if structured JSON logging is enabled through the OCI_TF_LOG_FORMAT environment variable
+func StructuredLoggingEnabled() bool {
+	return strings.EqualFold(os.Getenv(globalvar.EnvOCITFLogFormat), globalvar.StructuredLogFormatJson)
+}
+
+// StructuredLogBodiesEnabled returns true if headers and bodies should be added to the structured logs, which is
+// only the case in debug mode
+func StructuredLogBodiesEnabled() bool {
+	return defaultTFLogger != nil && defaultTFLogger.LogLevel() == DEBUG
+}
+
+// LogStructured writes the entry as a JSON line to the file set in OCI_TF_LOG_PATH, or to stderr, as the messages of
+// the TFProviderLogger, which are written as JSON lines too when structured logging is enabled
+func LogStructured(entry StructuredLogEntry) {
+	structuredLogWriterOnce.Do(func() {
+		structuredLogWriter = os.Stderr
+		if logPath := os.Getenv(globalvar.EnvOCITFLogFile); logPath != "" {
+			if logFile, err := os.OpenFile(logPath, syscall.O_CREAT|syscall.O_RDWR|syscall.O_APPEND, 0666); err == nil {
+				structuredLogWriter = logFile
+			}
+		}
+	})
+
+	if entry.Timestamp == "" {
+		entry.Timestamp = time.Now().UTC().Format(time.RFC3339Nano)
+	}
+	if entry.Level == "" {
+		entry.Level = "INFO"
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	structuredLogLock.Lock()
+	defer structuredLogLock.Unlock()
+	_, _ = structuredLogWriter.Write(append(line, '\n'))
+}
+
+// WithLogContext returns a copy of ctx that carries the log context
+func WithLogContext(ctx context.Context, logContext *LogContext) context.Context {
+	return context.WithValue(ctx, logContextKey, logContext)
+}
+
+// LogContextFrom returns the log context carried by ctx, if any. The resource operations pass their context, with
+// the log context, to the requests of their SDK clients.
+func LogContextFrom(ctx context.Context) *LogContext {
+	if ctx == nil {
+		return nil
+	}
+	logContext, _ := ctx.Value(logContextKey).(*LogContext)
+	return logContext
+}
+
+// RedactHeaders returns the headers as a map, with the values of the sensitive ones redacted
+func RedactHeaders(headers map[string][]string) map[string]string {
+	result := make(map[string]string, len(headers))
+	for name, values := range headers {
+		value := strings.Join(values, ",")
+		for _, sensitiveHeader := range sensitiveHeaders {
+			if strings.EqualFold(name, sensitiveHeader) {
+				value = redactedValue
+				break
+			}
+		}
+		result[name] = value
+	}
+	return result
+}
+
+// RedactJsonBody parses a JSON body and redacts the values of the fields whose names look sensitive. Bodies that
+// are not JSON are redacted completely.
+func RedactJsonBody(body []byte) interface{} {
+	if len(body) == 0 {
+		return nil
+	}
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return redactedValue
+	}
+	return redactValue(parsed)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range v {
+			if isSensitiveField(field) {
+				v[field] = redactedValue
+			} else {
+				v[field] = redactValue(fieldValue)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveField(field string) bool {
+	return sensitiveFields[strings.ToLower(field)]
+}
@@ -0,0 +1,326 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+// Kinds and status codes of spans, as defined by OTLP
+const (
+	SpanKindInternal = 1
+	SpanKindClient   = 3
+
+	spanStatusCodeOk    = 1
+	spanStatusCodeError = 2
+
+	tracerName = "terraform-provider-oci"
+)
+
+// Span is a timed operation of a trace, e.g. a CRUD operation, an SDK call or a wait for a resource state
+type Span struct {
+	TraceId       string
+	SpanId        string
+	ParentSpanId  string
+	Name          string
+	Kind          int
+	StartTime     time.Time
+	EndTime       time.Time
+	Attributes    map[string]interface{}
+	StatusCode    int
+	StatusMessage string
+}
+
+// SpanExporter exports the spans of a trace once its root span has ended
+type SpanExporter interface {
+	ExportSpans(spans []*Span) error
+}
+
+// InMemorySpanExporter keeps the exported spans in memory, mainly for tests
+type InMemorySpanExporter struct {
+	mutex sync.Mutex
+	spans []*Span
+}
+
+func (e *InMemorySpanExporter) ExportSpans(spans []*Span) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+// GetSpans returns the spans exported so far
+func (e *InMemorySpanExporter) GetSpans() []*Span {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return append([]*Span{}, e.spans...)
+}
+
+// The traces are written as OTLP/JSON by hand rather than with the OpenTelemetry SDK, which the provider does not
+// depend on: the provider only needs to export finished traces, and the JSON encoding of OTLP is accepted as is by
+// the collectors on their /v1/traces endpoint.
+
+// otlpJsonFileExporter appends each trace as an OTLP/JSON line to a file
+type otlpJsonFileExporter struct {
+	mutex sync.Mutex
+	path  string
+}
+
+func (e *otlpJsonFileExporter) ExportSpans(spans []*Span) error {
+	content, err := json.Marshal(toOtlpTracesData(spans))
+	if err != nil {
+		return err
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	file, err := os.OpenFile(e.path, syscall.O_CREAT|syscall.O_WRONLY|syscall.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(append(content, '\n'))
+	return err
+}
+
+// otlpHttpExporter sends each trace as OTLP/JSON to the traces endpoint of a collector
+type otlpHttpExporter struct {
+	endpoint string
+	client   *http.Client
+}
+
+func (e *otlpHttpExporter) ExportSpans(spans []*Span) error {
+	content, err := json.Marshal(toOtlpTracesData(spans))
+	if err != nil {
+		return err
+	}
+	response, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("collector at %s returned status %d", e.endpoint, response.StatusCode)
+	}
+	return nil
+}
+
+var (
+	spanExporter     SpanExporter
+	spanExporterOnce sync.Once
+	spanExporterLock sync.RWMutex
+
+	spanContextKey = &struct{ name string }{"oci-span"}
+
+	// Spans that have ended, by trace, until the root span of their trace ends
+	pendingTraceSpans = make(map[string][]*Span)
+	spansLock         sync.Mutex
+)
+
+// SetSpanExporter sets the exporter of the traces, which enables tracing. A nil exporter disables tracing.
+func SetSpanExporter(exporter SpanExporter) {
+	spanExporterOnce.Do(func() {})
+	spanExporterLock.Lock()
+	defer spanExporterLock.Unlock()
+	spanExporter = exporter
+}
+
+func getSpanExporter() SpanExporter {
+	spanExporterOnce.Do(func() {
+		if tracePath := os.Getenv(globalvar.EnvOCITFTracePath); tracePath != "" {
+			spanExporter = &otlpJsonFileExporter{path: tracePath}
+		} else if traceEndpoint := os.Getenv(globalvar.EnvOCITFTraceEndpoint); traceEndpoint != "" {
+			spanExporter = &otlpHttpExporter{
+				endpoint: strings.TrimSuffix(traceEndpoint, "/") + "/v1/traces",
+				client:   &http.Client{Timeout: 10 * time.Second},
+			}
+		}
+	})
+	spanExporterLock.RLock()
+	defer spanExporterLock.RUnlock()
+	return spanExporter
+}
+
+// TracingEnabled returns true if the spans are exported, either to the file set in OCI_TF_TRACE_PATH or to the
+// collector set in OCI_TF_TRACE_ENDPOINT
+func TracingEnabled() bool {
+	return getSpanExporter() != nil
+}
+
+// SpanFromContext returns the span carried by ctx, if any
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanContextKey).(*Span)
+	return span
+}
+
+// StartSpan starts a span, as a child of the span carried by ctx if any, and returns a copy of ctx that carries the
+// new span. It returns nil if tracing is disabled; all the methods of Span can be called on nil.
+func StartSpan(ctx context.Context, name string, kind int) (context.Context, *Span) {
+	if !TracingEnabled() {
+		return ctx, nil
+	}
+
+	span := &Span{
+		SpanId:     newTraceId(8),
+		Name:       name,
+		Kind:       kind,
+		StartTime:  time.Now(),
+		Attributes: make(map[string]interface{}),
+	}
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.TraceId = parent.TraceId
+		span.ParentSpanId = parent.SpanId
+	} else {
+		span.TraceId = newTraceId(16)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, spanContextKey, span), span
+}
+
+// StartChildSpan starts a span like StartSpan, only if ctx carries a span to be its parent
+func StartChildSpan(ctx context.Context, name string, kind int) (context.Context, *Span) {
+	if SpanFromContext(ctx) == nil {
+		return ctx, nil
+	}
+	return StartSpan(ctx, name, kind)
+}
+
+// RecordSpan records a span that has already ended, or that is known to end at endTime, e.g. a retry backoff
+func RecordSpan(ctx context.Context, name string, kind int, endTime time.Time, attributes map[string]interface{}) {
+	_, span := StartSpan(ctx, name, kind)
+	if span == nil {
+		return
+	}
+	for key, value := range attributes {
+		span.SetAttribute(key, value)
+	}
+	span.end(endTime, nil)
+}
+
+// SetAttribute sets an attribute of the span
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	spansLock.Lock()
+	defer spansLock.Unlock()
+	s.Attributes[key] = value
+}
+
+// End ends the span, with an error status if err is not nil. The spans of a trace are exported when its root span ends.
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	s.end(time.Now(), err)
+}
+
+func (s *Span) end(endTime time.Time, err error) {
+	spansLock.Lock()
+	s.EndTime = endTime
+	s.StatusCode = spanStatusCodeOk
+	if err != nil {
+		s.StatusCode = spanStatusCodeError
+		s.StatusMessage = err.Error()
+	}
+
+	pendingTraceSpans[s.TraceId] = append(pendingTraceSpans[s.TraceId], s)
+	var traceSpans []*Span
+	if s.ParentSpanId == "" {
+		traceSpans = pendingTraceSpans[s.TraceId]
+		delete(pendingTraceSpans, s.TraceId)
+	}
+	spansLock.Unlock()
+
+	if len(traceSpans) > 0 {
+		if exporter := getSpanExporter(); exporter != nil {
+			if err := exporter.ExportSpans(traceSpans); err != nil {
+				Debugf("[WARN] unable to export trace %s: %v", s.TraceId, err)
+			}
+		}
+	}
+}
+
+func newTraceId(length int) string {
+	id := make([]byte, length)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// toOtlpTracesData converts the spans to the OTLP/JSON representation of TracesData
+func toOtlpTracesData(spans []*Span) map[string]interface{} {
+	otlpSpans := make([]map[string]interface{}, 0, len(spans))
+	for _, span := range spans {
+		otlpSpan := map[string]interface{}{
+			"traceId":           span.TraceId,
+			"spanId":            span.SpanId,
+			"name":              span.Name,
+			"kind":              span.Kind,
+			"startTimeUnixNano": strconv.FormatInt(span.StartTime.UnixNano(), 10),
+			"endTimeUnixNano":   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+			"attributes":        toOtlpAttributes(span.Attributes),
+			"status":            map[string]interface{}{"code": span.StatusCode, "message": span.StatusMessage},
+		}
+		if span.ParentSpanId != "" {
+			otlpSpan["parentSpanId"] = span.ParentSpanId
+		}
+		otlpSpans = append(otlpSpans, otlpSpan)
+	}
+
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": toOtlpAttributes(map[string]interface{}{"service.name": tracerName, "service.version": globalvar.Version}),
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": tracerName, "version": globalvar.Version},
+						"spans": otlpSpans,
+					},
+				},
+			},
+		},
+	}
+}
+
+func toOtlpAttributes(attributes map[string]interface{}) []map[string]interface{} {
+	otlpAttributes := make([]map[string]interface{}, 0, len(attributes))
+	for key, value := range attributes {
+		var otlpValue map[string]interface{}
+		switch v := value.(type) {
+		case bool:
+			otlpValue = map[string]interface{}{"boolValue": v}
+		case int:
+			otlpValue = map[string]interface{}{"intValue": strconv.Itoa(v)}
+		case int64:
+			otlpValue = map[string]interface{}{"intValue": strconv.FormatInt(v, 10)}
+		case float64:
+			otlpValue = map[string]interface{}{"doubleValue": v}
+		default:
+			otlpValue = map[string]interface{}{"stringValue": fmt.Sprintf("%v", v)}
+		}
+		otlpAttributes = append(otlpAttributes, map[string]interface{}{"key": key, "value": otlpValue})
+	}
+	return otlpAttributes
+}
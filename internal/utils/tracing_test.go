@@ -0,0 +1,237 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+func TestUnitStartSpan_disabled(t *testing.T) {
+	SetSpanExporter(nil)
+
+	ctx, span := StartSpan(context.Background(), "CreateResource", SpanKindInternal)
+	assert.Nil(t, span)
+	assert.Nil(t, SpanFromContext(ctx))
+	span.SetAttribute("key", "value")
+	span.End(errors.New("ignored"))
+	RecordSpan(ctx, "RetryAttempt", SpanKindInternal, time.Now(), nil)
+}
+
+func TestUnitStartSpan_nesting(t *testing.T) {
+	exporter := &InMemorySpanExporter{}
+	SetSpanExporter(exporter)
+	defer SetSpanExporter(nil)
+
+	ctx, root := StartSpan(context.Background(), "CreateResource", SpanKindInternal)
+	assert.Same(t, root, SpanFromContext(ctx))
+	_, child := StartSpan(ctx, "POST /vcns", SpanKindClient)
+	child.SetAttribute("http.status_code", 429)
+	child.End(errors.New("TooManyRequests"))
+	RecordSpan(ctx, "RetryAttempt", SpanKindInternal, time.Now().Add(time.Second), map[string]interface{}{"oci.retry.attempt": 1})
+
+	// Spans are only exported once the root span has ended
+	assert.Empty(t, exporter.GetSpans())
+	root.End(nil)
+
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 3) {
+		assert.Equal(t, "POST /vcns", spans[0].Name)
+		assert.Equal(t, root.SpanId, spans[0].ParentSpanId)
+		assert.Equal(t, spanStatusCodeError, spans[0].StatusCode)
+		assert.Equal(t, "TooManyRequests", spans[0].StatusMessage)
+		assert.Equal(t, "RetryAttempt", spans[1].Name)
+		assert.Equal(t, root.SpanId, spans[1].ParentSpanId)
+		assert.True(t, spans[1].EndTime.Sub(spans[1].StartTime) >= time.Second-10*time.Millisecond)
+		assert.Equal(t, root, spans[2])
+		assert.Equal(t, spanStatusCodeOk, root.StatusCode)
+		for _, span := range spans {
+			assert.Equal(t, root.TraceId, span.TraceId)
+		}
+	}
+}
+
+func TestUnitStartChildSpan(t *testing.T) {
+	exporter := &InMemorySpanExporter{}
+	SetSpanExporter(exporter)
+	defer SetSpanExporter(nil)
+
+	// No span is started without a parent
+	ctx, span := StartChildSpan(context.Background(), "WaitForStateRefresh", SpanKindInternal)
+	assert.Nil(t, span)
+	assert.Equal(t, context.Background(), ctx)
+
+	ctx, root := StartSpan(context.Background(), "oci_core_vcn create", SpanKindInternal)
+	_, span = StartChildSpan(ctx, "WaitForStateRefresh", SpanKindInternal)
+	if assert.NotNil(t, span) {
+		assert.Equal(t, root.SpanId, span.ParentSpanId)
+	}
+	span.End(nil)
+	root.End(nil)
+	assert.Len(t, exporter.GetSpans(), 2)
+}
+
+func TestUnitStartSpan_concurrentOperations(t *testing.T) {
+	exporter := &InMemorySpanExporter{}
+	SetSpanExporter(exporter)
+	defer SetSpanExporter(nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		ctx, root := StartSpan(context.Background(), "ReadResource", SpanKindInternal)
+		// The SDK calls of an operation can be made on other goroutines, with its context
+		go func() {
+			defer wg.Done()
+			_, span := StartSpan(ctx, "GET /instances", SpanKindClient)
+			span.End(nil)
+			root.End(nil)
+		}()
+	}
+	wg.Wait()
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 10)
+	traces := map[string]int{}
+	for _, span := range spans {
+		traces[span.TraceId]++
+	}
+	assert.Len(t, traces, 5, "expected each operation to have its own trace")
+	for _, count := range traces {
+		assert.Equal(t, 2, count)
+	}
+}
+
+func TestUnitOtlpJsonFileExporter(t *testing.T) {
+	tracePath := filepath.Join(t.TempDir(), "traces.json")
+	SetSpanExporter(&otlpJsonFileExporter{path: tracePath})
+	defer SetSpanExporter(nil)
+
+	ctx, root := StartSpan(context.Background(), "DeleteResource", SpanKindInternal)
+	root.SetAttribute("oci.resource.id", "ocid1.vcn.oc1..test")
+	_, span := StartSpan(ctx, "DELETE /vcns/ocid1.vcn.oc1..test", SpanKindClient)
+	span.End(nil)
+	root.End(nil)
+
+	content, err := ioutil.ReadFile(tracePath)
+	assert.NoError(t, err)
+
+	var tracesData struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []struct {
+					TraceId           string `json:"traceId"`
+					SpanId            string `json:"spanId"`
+					ParentSpanId      string `json:"parentSpanId"`
+					Name              string `json:"name"`
+					Kind              int    `json:"kind"`
+					StartTimeUnixNano string `json:"startTimeUnixNano"`
+					Attributes        []struct {
+						Key   string `json:"key"`
+						Value struct {
+							StringValue string `json:"stringValue"`
+						} `json:"value"`
+					} `json:"attributes"`
+				} `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	assert.NoError(t, json.Unmarshal(content, &tracesData))
+	spans := tracesData.ResourceSpans[0].ScopeSpans[0].Spans
+	if assert.Len(t, spans, 2) {
+		assert.Len(t, spans[1].TraceId, 32)
+		assert.Len(t, spans[1].SpanId, 16)
+		assert.Equal(t, spans[1].SpanId, spans[0].ParentSpanId)
+		assert.Equal(t, SpanKindClient, spans[0].Kind)
+		assert.NotEmpty(t, spans[0].StartTimeUnixNano)
+		assert.Equal(t, "oci.resource.id", spans[1].Attributes[0].Key)
+		assert.Equal(t, "ocid1.vcn.oc1..test", spans[1].Attributes[0].Value.StringValue)
+	}
+	_ = os.Remove(tracePath)
+}
+
+func TestUnitOtlpHttpExporter(t *testing.T) {
+	var requests []*http.Request
+	var bodies [][]byte
+	status := http.StatusOK
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r)
+		bodies = append(bodies, body)
+		w.WriteHeader(status)
+	}))
+	defer collector.Close()
+
+	os.Setenv(globalvar.EnvOCITFTraceEndpoint, collector.URL+"/")
+	defer os.Unsetenv(globalvar.EnvOCITFTraceEndpoint)
+	spanExporterOnce = sync.Once{}
+	defer SetSpanExporter(nil)
+	assert.True(t, TracingEnabled())
+
+	ctx, root := StartSpan(context.Background(), "oci_core_vcn create", SpanKindInternal)
+	_, span := StartSpan(ctx, "POST /20160918/vcns", SpanKindClient)
+	span.SetAttribute("http.status_code", 200)
+	span.End(nil)
+	root.End(errors.New("failed"))
+
+	if assert.Len(t, requests, 1) {
+		assert.Equal(t, http.MethodPost, requests[0].Method)
+		assert.Equal(t, "/v1/traces", requests[0].URL.Path)
+		assert.Equal(t, "application/json", requests[0].Header.Get("Content-Type"))
+
+		var tracesData struct {
+			ResourceSpans []struct {
+				Resource struct {
+					Attributes []struct {
+						Key string `json:"key"`
+					} `json:"attributes"`
+				} `json:"resource"`
+				ScopeSpans []struct {
+					Spans []struct {
+						TraceId      string `json:"traceId"`
+						ParentSpanId string `json:"parentSpanId"`
+						Attributes   []struct {
+							Key   string `json:"key"`
+							Value struct {
+								IntValue string `json:"intValue"`
+							} `json:"value"`
+						} `json:"attributes"`
+						Status struct {
+							Code    int    `json:"code"`
+							Message string `json:"message"`
+						} `json:"status"`
+					} `json:"spans"`
+				} `json:"scopeSpans"`
+			} `json:"resourceSpans"`
+		}
+		assert.NoError(t, json.Unmarshal(bodies[0], &tracesData))
+		assert.NotEmpty(t, tracesData.ResourceSpans[0].Resource.Attributes)
+		spans := tracesData.ResourceSpans[0].ScopeSpans[0].Spans
+		if assert.Len(t, spans, 2) {
+			assert.Equal(t, spans[1].TraceId, spans[0].TraceId)
+			assert.Equal(t, root.SpanId, spans[0].ParentSpanId)
+			// Integers are encoded as strings, as required by OTLP/JSON
+			assert.Equal(t, "200", spans[0].Attributes[0].Value.IntValue)
+			assert.Equal(t, spanStatusCodeError, spans[1].Status.Code)
+			assert.Equal(t, "failed", spans[1].Status.Message)
+		}
+	}
+
+	// A trace rejected by the collector is reported
+	status = http.StatusBadRequest
+	assert.Error(t, getSpanExporter().ExportSpans([]*Span{root}))
+}
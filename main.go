@@ -10,12 +10,13 @@ import (
 	"strings"
 
 	"github.com/fatih/color"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
 	"github.com/oracle/terraform-provider-oci/internal/provider"
 	"github.com/oracle/terraform-provider-oci/internal/resourcediscovery"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 )
 
 var filterFlag tf_export.Filter
@@ -38,6 +39,13 @@ func main() {
 	var excludeServices = flag.String("exclude_services", "", "[export] [experimental] Comma-separated list of service resources to exclude from export. If a service is present in both 'services' and 'exclude_services' argument, it will be excluded.")
 	var ids = flag.String("ids", "", "[export] Comma-separated list of tuples <resource Type:resource ID> for resources to export. The ID could either be an OCID or a Terraform import ID. By default, all resources are exported.")
 	var generateStateFile = flag.Bool("generate_state", false, "[export][experimental] Set this to import the discovered resources into a state file along with the Terraform configuration")
+	var importMode = flag.String("import_mode", "state", "[export] How to import the discovered resources. The allowed values are :\n * state - import them into a state file with `terraform import` when 'generate_state' is set\n * blocks - write an `import` block for each of them in imports.tf, for Terraform v1.5+ to import them on plan and apply")
+	var baselinePath = flag.String("baseline_path", "", "[export] Path to a previous export, or to its state file, to compare the discovered resources with. A report of the added, removed and changed resources is written to baseline_diff.json")
+	var baselineNewResourcesOnly = flag.Bool("baseline_new_resources_only", false, "[export] Set this flag to only generate the configuration of the resources that are not in the export at 'baseline_path'")
+	var outputLayout = flag.String("output_layout", "flat", "[export] How to lay out the generated configuration under 'output_path'. The allowed values are :\n * flat - a <service>.tf file per service\n * modules - a child module per service, or per compartment with 'module_grouping', under modules/ and a root module calling them in main.tf")
+	var moduleGrouping = flag.String("module_grouping", "service", "[export] How to group the resources into child modules with 'output_layout=modules'. The allowed values are :\n * service - a module per service\n * compartment - a module per compartment of the resources")
+	var recursive = flag.Bool("recursive", false, "[export] Set this flag to also export the sub-compartments of the exported compartment, each into its own directory under 'output_path'. The references to the resources of other compartments are read from their terraform_remote_state")
+	var outputFormat = flag.String("output_format", "hcl", "[export] The format to write the discovered resources in. The allowed values are :\n * hcl - the Terraform configuration only\n * json - also an inventory of the resources, their parent/child edges and references in inventory.json\n * yaml - also the inventory in inventory.yaml\nWith 'list_export_resources', 'json' prints the resource graphs as JSON")
 	var help = flag.Bool("help", false, "Prints usage options")
 	var tfVersion = flag.String("tf_version", "0.12", "The version of terraform syntax to generate for configurations. The state file will be written in v0.12 only. The allowed values are :\n * 0.11\n * 0.12")
 	var retryTimeout = flag.String("retry_timeout", "15s", "[export] The time duration for which API calls will wait and retry operation in case of API errors. By default, the retry timeout duration is 15s")
@@ -56,10 +64,13 @@ func main() {
 	if command == nil || *command == "" {
 		log.Println("Executable runs in Terraform plugin mode by default. For additional usage options, please run with the '-help' flag.")
 		plugin.Serve(&plugin.ServeOpts{
-			ProviderFunc: func() *schema.Provider {
-				return provider.Provider()
+			GRPCProviderFunc: func() tfprotov5.ProviderServer {
+				return provider.NewGRPCProviderServer(provider.Provider())
 			},
 		})
+		// Serve returns once Terraform closes the provider at the end of the run, e.g. of the apply. Terraform keeps
+		// reading the logs of the provider until it exits.
+		tfresource.LogServiceHealthSummary()
 	} else {
 		switch *command {
 		case "export":
@@ -88,6 +99,13 @@ func main() {
 				RetryTimeout:                 retryTimeout,
 				IsExportWithRelatedResources: *includeRelatedResources,
 				Parallelism:                  *parallelism,
+				ImportMode:                   tf_export.ImportModeEnum(*importMode),
+				BaselinePath:                 *baselinePath,
+				BaselineNewResourcesOnly:     *baselineNewResourcesOnly,
+				OutputLayout:                 tf_export.OutputLayoutEnum(*outputLayout),
+				ModuleGrouping:               tf_export.ModuleGroupingEnum(*moduleGrouping),
+				Recursive:                    *recursive,
+				OutputFormat:                 tf_export.OutputFormatEnum(*outputFormat),
 			}
 
 			if services != nil && *services != "" {
@@ -121,7 +139,7 @@ func main() {
 			os.Exit(int(status))
 
 		case "list_export_resources":
-			if err := resourcediscovery.RunListExportableResourcesCommand(); err != nil {
+			if err := resourcediscovery.RunListExportableResourcesCommand(tf_export.OutputFormatEnum(*outputFormat)); err != nil {
 				color.Red("%v", err)
 				os.Exit(1)
 			}